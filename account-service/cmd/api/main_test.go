@@ -39,6 +39,7 @@ func TestApiServerStart(t *testing.T) {
 	initTestConfigFile()
 
 	server := server.NewApiServer(8080)
+	server.SetupValidators()
 	server.SetupMiddlewares()
 	server.SetupRoutes()
 
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/configs"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/infrastructure/broker"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/logger"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	configs.InitConfigFile()
+	logger.SetupLogger(viper.GetString("serviceName") + "-worker")
+
+	dbConnection := repositories.NewDBConnection()
+	defer dbConnection.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	relayOutboxMessagesUseCase := usecases.NewRelayOutboxMessagesUseCase(
+		repositories.NewOutboxRepository(dbConnection),
+		broker.NewBroker(broker.BuildConnectionUrl()),
+		viper.GetInt("outboxRelay.batchSize"),
+		viper.GetInt("outboxRelay.maxAttempts"),
+		viper.GetDuration("outboxRelay.retryDelay"))
+
+	go runEvery(ctx, "outbox relay", viper.GetDuration("outboxRelay.interval"), func() error {
+		_, err := relayOutboxMessagesUseCase.Handle()
+		return err
+	})
+
+	purgeExpiredIdempotencyKeysUseCase := usecases.NewPurgeExpiredIdempotencyKeysUseCase(
+		repositories.NewIdempotencyKeysRepository(dbConnection),
+		viper.GetDuration("idempotencyKeys.ttl"))
+
+	go runEvery(ctx, "idempotency keys purge", viper.GetDuration("idempotencyKeys.purgeInterval"), func() error {
+		_, err := purgeExpiredIdempotencyKeysUseCase.Handle()
+		return err
+	})
+
+	verifyLedgerUseCase := usecases.NewVerifyLedgerUseCase(repositories.NewLedgerRepository(dbConnection))
+
+	go runEvery(ctx, "ledger verification", viper.GetDuration("ledgerVerification.interval"), func() error {
+		_, err := verifyLedgerUseCase.Handle()
+		return err
+	})
+
+	executeScheduledTransfersUseCase := usecases.NewExecuteScheduledTransfersUseCase(
+		repositories.NewScheduledTransfersRepository(dbConnection),
+		usecases.NewTransferAccountUseCase(repositories.NewAccountRepository(dbConnection), repositories.NewPixKeysRepository(dbConnection), repositories.NewPayeesRepository(dbConnection), configs.DefaultTransferLimits(), configs.TransferApprovalPolicy(), configs.PayeePolicy(), configs.RiskRules(), configs.FxRates(), configs.FeeSchedule()),
+		viper.GetInt("scheduledTransfers.batchSize"),
+		viper.GetInt("scheduledTransfers.maxAttempts"),
+		viper.GetDuration("scheduledTransfers.retryDelay"))
+
+	go runEvery(ctx, "scheduled transfers", viper.GetDuration("scheduledTransfers.interval"), func() error {
+		_, err := executeScheduledTransfersUseCase.Handle()
+		return err
+	})
+
+	expireHoldsUseCase := usecases.NewExpireHoldsUseCase(
+		repositories.NewAccountRepository(dbConnection),
+		repositories.NewHoldsRepository(dbConnection),
+		viper.GetInt("holds.batchSize"))
+
+	go runEvery(ctx, "holds expiry", viper.GetDuration("holds.expiryInterval"), func() error {
+		_, err := expireHoldsUseCase.Handle()
+		return err
+	})
+
+	expireTransferRequestsUseCase := usecases.NewExpireTransferRequestsUseCase(
+		repositories.NewAccountRepository(dbConnection),
+		repositories.NewTransferRequestsRepository(dbConnection),
+		viper.GetInt("transferApproval.batchSize"))
+
+	go runEvery(ctx, "transfer requests expiry", viper.GetDuration("transferApproval.expiryInterval"), func() error {
+		_, err := expireTransferRequestsUseCase.Handle()
+		return err
+	})
+
+	accrueInterestUseCase := usecases.NewAccrueInterestUseCase(
+		repositories.NewAccountRepository(dbConnection),
+		configs.SavingsInterestRate(),
+		viper.GetInt("savingsInterest.batchSize"))
+
+	go runEvery(ctx, "savings interest accrual", viper.GetDuration("savingsInterest.interval"), func() error {
+		_, err := accrueInterestUseCase.Handle()
+		return err
+	})
+
+	chargeMaintenanceFeesUseCase := usecases.NewChargeMaintenanceFeesUseCase(
+		repositories.NewAccountRepository(dbConnection),
+		configs.FeeSchedule(),
+		viper.GetInt("fees.batchSize"))
+
+	go runEvery(ctx, "maintenance fees", viper.GetDuration("fees.interval"), func() error {
+		_, err := chargeMaintenanceFeesUseCase.Handle()
+		return err
+	})
+
+	slog.Info("worker started")
+
+	<-ctx.Done()
+
+	slog.Info("worker stopped")
+}
+
+// runEvery executes job on every tick of interval until ctx is cancelled. Errors are logged
+// and the job is retried on the next tick.
+func runEvery(ctx context.Context, name string, interval time.Duration, job func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := job()
+			if err != nil {
+				slog.Error("error running job", "job", name, "error", err)
+			}
+		}
+	}
+}
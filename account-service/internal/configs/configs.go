@@ -0,0 +1,155 @@
+package configs
+
+import (
+	"fmt"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/spf13/viper"
+)
+
+func InitConfigFile() {
+	viper.AddConfigPath("configs")
+	viper.SetConfigName(".env")
+	viper.SetConfigType("env")
+
+	err := viper.ReadInConfig()
+	if err != nil {
+		panic(err)
+	}
+
+	environment := viper.GetString("environment")
+
+	viper.SetConfigName(fmt.Sprint("configs", ".", environment))
+	viper.SetConfigType("json")
+	viper.AddConfigPath("configs")
+
+	err = viper.MergeInConfig()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// AccountNumberPolicy reads the branch and legacy numbers of the account numbers, panicking on
+// an invalid branch so accounts are never opened with a malformed number.
+func AccountNumberPolicy() domain.AccountNumberPolicy {
+	policy := domain.AccountNumberPolicy{
+		Branch:          viper.GetString("accountNumber.branch"),
+		LegacyMaxNumber: viper.GetInt64("accountNumber.legacyMaxNumber"),
+	}
+
+	err := policy.Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	return policy
+}
+
+// DefaultTransferLimits reads the transfer limits applied to the accounts of each tier that
+// have no limits of their own.
+func DefaultTransferLimits() domain.DefaultTransferLimits {
+	return domain.DefaultTransferLimits{
+		domain.DocumentTypePerson:  transferLimits("transferLimits.person"),
+		domain.DocumentTypeCompany: transferLimits("transferLimits.company"),
+	}
+}
+
+func transferLimits(key string) domain.TransferLimits {
+	return domain.TransferLimits{
+		PerTransaction: viper.GetInt64(key + ".perTransaction"),
+		Daily:          viper.GetInt64(key + ".daily"),
+		Nightly:        viper.GetInt64(key + ".nightly"),
+	}
+}
+
+// TransferApprovalPolicy reads the threshold above which transfers wait for approval.
+func TransferApprovalPolicy() domain.TransferApprovalPolicy {
+	return domain.TransferApprovalPolicy{
+		Threshold: viper.GetInt64("transferApproval.threshold"),
+		TTL:       viper.GetDuration("transferApproval.ttl"),
+	}
+}
+
+// PayeePolicy reads the limit above which transfers only go to payees registered before the
+// cooling off period.
+func PayeePolicy() domain.PayeePolicy {
+	return domain.PayeePolicy{
+		RequiredAbove: viper.GetInt64("payees.requiredAbove"),
+		CoolingOff:    viper.GetDuration("payees.coolingOff"),
+	}
+}
+
+// RiskRules reads the rules screening deposits and transfers, panicking on an invalid rule so a
+// typo does not silently disable it.
+func RiskRules() domain.RiskRules {
+	var rules domain.RiskRules
+
+	err := viper.UnmarshalKey("riskRules", &rules)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, rule := range rules {
+		err = rule.Validate()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return rules
+}
+
+// FxRates reads the rates converting transfers between accounts of different currencies,
+// panicking on an invalid rate.
+func FxRates() *domain.FxRates {
+	var rates []domain.FxRate
+
+	err := viper.UnmarshalKey("fxRates", &rates)
+	if err != nil {
+		panic(err)
+	}
+
+	table, err := domain.NewFxRates(rates)
+	if err != nil {
+		panic(err)
+	}
+
+	return table
+}
+
+// SavingsInterestRate reads the annual rate paid to savings accounts, panicking on an invalid rate.
+func SavingsInterestRate() *domain.InterestRate {
+	rate, err := domain.NewInterestRate(viper.GetString("savingsInterest.annualRate"))
+	if err != nil {
+		panic(err)
+	}
+
+	return rate
+}
+
+// FeeSchedule reads the fees charged on deposits and transfers and the monthly maintenance fee
+// of each account tier, in `fees.currency` (BRL when not set) converted by the fx rates, panicking
+// on an invalid rule.
+func FeeSchedule() *domain.FeeSchedule {
+	var rules []domain.FeeRule
+
+	err := viper.UnmarshalKey("fees.operations", &rules)
+	if err != nil {
+		panic(err)
+	}
+
+	currency := domain.Currency(viper.GetString("fees.currency"))
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+
+	schedule, err := domain.NewFeeSchedule(currency, rules, map[domain.DocumentType]int64{
+		domain.DocumentTypePerson:  viper.GetInt64("fees.maintenance.person"),
+		domain.DocumentTypeCompany: viper.GetInt64("fees.maintenance.company"),
+	}, FxRates())
+	if err != nil {
+		panic(err)
+	}
+
+	return schedule
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"time"
-	"unicode/utf8"
 )
 
 const (
@@ -15,79 +14,247 @@ const (
 	CNPJLength = 14
 )
 
-var ErrInsufficientFunds = errors.New("insufficient funds")
+type AccountStatus string
+
+const (
+	AccountStatusActive  AccountStatus = "active"
+	AccountStatusBlocked AccountStatus = "blocked"
+	AccountStatusClosed  AccountStatus = "closed"
+)
+
+type AccountType string
+
+const (
+	AccountTypeChecking AccountType = "checking"
+	AccountTypeSavings  AccountType = "savings"
+)
+
+var (
+	ErrInvalidAccountType      = errors.New("invalid account type, should be checking or savings")
+	ErrNotSavingsAccount       = errors.New("account is not a savings account")
+	ErrInsufficientFunds       = errors.New("insufficient funds")
+	ErrTransferToSameAccount   = errors.New("transfer source and destination must be different accounts")
+	ErrAccountNotActive        = errors.New("account is not active")
+	ErrInvalidStatusTransition = errors.New("invalid account status transition")
+	ErrCloseAccountWithBalance = errors.New("account balance must be zero to close it")
+	ErrCloseAccountWithHolds   = errors.New("account must not have active holds to close it")
+	ErrInvalidOverdraftLimit   = errors.New("overdraft limit must not be negative nor lower than the amount already used")
+)
 
 type Account struct {
-	Id        string
-	Number    string
-	Name      string
-	Document  string
-	Balance   int64
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id                      string
+	Number                  string
+	Name                    string
+	Document                string
+	Holders                 []*AccountHolder
+	Email                   string
+	Phone                   string
+	Address                 string
+	Type                    AccountType
+	Currency                Currency
+	Balance                 int64
+	HeldBalance             int64
+	PocketBalance           int64
+	OverdraftLimit          int64
+	TransferLimits          TransferLimits
+	AccruedInterest         int64
+	InterestAccruedOn       time.Time
+	MaintenanceFeeChargedOn time.Time
+	Status                  AccountStatus
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
 }
 
 func NewAccount(number string, document string, name string) *Account {
 	return &Account{
 		Number:    number,
-		Document:  document,
+		Document:  NormalizeDocument(document),
 		Name:      name,
+		Type:      AccountTypeChecking,
+		Currency:  DefaultCurrency,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		Balance:   0,
+		Status:    AccountStatusActive,
 	}
 }
 
 func (acc *Account) Validate() error {
-	nameLength := utf8.RuneCountInString(acc.Name)
-	if nameLength < MinimumLengthName || nameLength > MaximumLengthName {
-		return fmt.Errorf("invalid name, should be between %v and %v characters", MinimumLengthName, MaximumLengthName)
+	_, err := validateName(acc.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = ValidateDocument(acc.Document)
+	if err != nil {
+		return err
 	}
 
-	documentLength := utf8.RuneCountInString(acc.Document)
-	if documentLength != CPFLength && documentLength != CNPJLength {
-		return fmt.Errorf("invalid document, should be CPF with %v or CNPJ with %v characters", CPFLength, CNPJLength)
+	if len(acc.Holders) > 0 {
+		_, err = validateHolders(acc.Holders)
+		if err != nil {
+			return err
+		}
 	}
 
-	return nil
+	if acc.Type != AccountTypeChecking && acc.Type != AccountTypeSavings {
+		return ErrInvalidAccountType
+	}
+
+	return acc.Currency.Validate()
+}
+
+// DocumentType tells whether the account belongs to a person (CPF) or a company (CNPJ).
+func (acc *Account) DocumentType() DocumentType {
+	if len(acc.Document) == CNPJLength {
+		return DocumentTypeCompany
+	}
+
+	return DocumentTypePerson
 }
 
-func (acc *Account) Deposit(value int64) error {
-	if value <= 0 {
+// Deposit credits value, which must be in the account currency like every amount of the account.
+func (acc *Account) Deposit(value Money) error {
+	if value.Amount <= 0 {
 		return errors.New("for a deposit the value must be greater than zero")
 	}
 
-	acc.Balance += value
+	if value.Currency != acc.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	acc.Balance += value.Amount
 	acc.UpdatedAt = time.Now()
 
 	return nil
 }
 
-func (acc *Account) withdraw(value int64) error {
-	if value <= 0 {
+func (acc *Account) Withdraw(value Money) error {
+	if value.Amount <= 0 {
 		return errors.New("for a withdraw the value must be greater than zero")
 	}
 
-	if value > acc.Balance {
+	if value.Currency != acc.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	if value.Amount > acc.AvailableBalance() {
 		return ErrInsufficientFunds
 	}
 
-	acc.Balance -= value
+	acc.Balance -= value.Amount
 	acc.UpdatedAt = time.Now()
 
 	return nil
 }
 
-func (from *Account) Transfer(value int64, to *Account) error {
-	err := from.withdraw(value)
+func (from *Account) Transfer(value Money, to *Account) error {
+	return from.TransferConverted(value, to, value)
+}
+
+// TransferConverted debits value from the account and credits received, the value converted
+// to the currency of the receiver.
+func (from *Account) TransferConverted(value Money, to *Account, received Money) error {
+	if from.Number == to.Number {
+		return ErrTransferToSameAccount
+	}
+
+	if received.Currency != to.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	if received.Amount <= 0 {
+		return errors.New("for a transfer the received value must be greater than zero")
+	}
+
+	err := from.Withdraw(value)
 	if err != nil {
 		return err
 	}
 
-	err = to.Deposit(value)
+	err = to.Deposit(received)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// Money returns amount in the account currency.
+func (acc *Account) Money(amount int64) Money {
+	return NewMoney(amount, acc.Currency)
+}
+
+// EnsureActive rejects money movements on blocked or closed accounts.
+func (acc *Account) EnsureActive() error {
+	if acc.Status != AccountStatusActive {
+		return ErrAccountNotActive
+	}
+
+	return nil
+}
+
+// ChangeStatus moves the account to status. Active and blocked accounts can switch between
+// each other or be closed, closing requires a zero balance with nothing held nor in pockets,
+// and a closed account is final. Interest accrued and not credited yet is paid out on closing.
+func (acc *Account) ChangeStatus(status AccountStatus) error {
+	switch {
+	case status != AccountStatusActive && status != AccountStatusBlocked && status != AccountStatusClosed:
+		return fmt.Errorf("invalid account status %v", status)
+	case acc.Status == AccountStatusClosed || acc.Status == status:
+		return ErrInvalidStatusTransition
+	case status == AccountStatusClosed && acc.Balance != 0:
+		return ErrCloseAccountWithBalance
+	case status == AccountStatusClosed && acc.HeldBalance != 0:
+		return ErrCloseAccountWithHolds
+	case status == AccountStatusClosed && acc.PocketBalance != 0:
+		return ErrCloseAccountWithPockets
+	}
+
+	acc.Status = status
+	acc.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// AvailableBalance is the amount that can be withdrawn, the balance plus the overdraft limit
+// minus what is reserved by active holds and separated in pockets.
+func (acc *Account) AvailableBalance() int64 {
+	return acc.Balance + acc.OverdraftLimit - acc.HeldBalance - acc.PocketBalance
+}
+
+// AvailableOverdraftLimit is the part of the overdraft limit not used yet. Money held or moved to
+// pockets is not available, so it is left out of the balance the limit is compared to.
+func (acc *Account) AvailableOverdraftLimit() int64 {
+	if acc.ownBalance() >= 0 {
+		return acc.OverdraftLimit
+	}
+
+	return acc.OverdraftLimit + acc.ownBalance()
+}
+
+func (acc *Account) InOverdraft() bool {
+	return acc.ownBalance() < 0
+}
+
+// ownBalance is the main balance not reserved by holds, negative when the account uses its overdraft.
+func (acc *Account) ownBalance() int64 {
+	return acc.MainBalance() - acc.HeldBalance
+}
+
+// SetOverdraftLimit changes the overdraft limit, it cannot be lowered below what the
+// account already owes.
+func (acc *Account) SetOverdraftLimit(limit int64) error {
+	if acc.Status == AccountStatusClosed {
+		return ErrAccountNotActive
+	}
+
+	if limit < 0 || limit < -acc.ownBalance() {
+		return ErrInvalidOverdraftLimit
+	}
+
+	acc.OverdraftLimit = limit
+	acc.UpdatedAt = time.Now()
+
+	return nil
+}
@@ -36,6 +36,18 @@ func TestAccountValidate(t *testing.T) {
 			name:          "me",
 			expectedError: errors.New("invalid name, should be between 5 and 120 characters"),
 		},
+		{
+			testName:      "given invalid document should return error",
+			document:      "00000000000",
+			name:          "John Doe",
+			expectedError: ErrInvalidDocument,
+		},
+		{
+			testName:      "given formatted document should be valid",
+			document:      "012.345.678-90",
+			name:          "John Doe",
+			expectedError: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -56,7 +68,7 @@ func TestDeposit_NegativeValue(t *testing.T) {
 	acc.Balance = 0
 
 	// act
-	err := acc.Deposit(-1)
+	err := acc.Deposit(acc.Money(-1))
 
 	// assert
 	assert.Equal(t, err, errors.New("for a deposit the value must be greater than zero"))
@@ -69,7 +81,7 @@ func TestDeposit_ValidValue(t *testing.T) {
 	acc.Balance = 50
 
 	// act
-	err := acc.Deposit(100)
+	err := acc.Deposit(acc.Money(100))
 
 	// assert
 	assert.Nil(t, err)
@@ -84,7 +96,7 @@ func TestWithdraw_NegativeValue(t *testing.T) {
 	acc.Balance = initialBalance
 
 	// act
-	err := acc.withdraw(-10)
+	err := acc.Withdraw(acc.Money(-10))
 
 	// assert
 	assert.NotNil(t, err)
@@ -99,7 +111,7 @@ func TestWithdraw_InsufficientFunds(t *testing.T) {
 	acc.Balance = initialBalance
 
 	// act
-	err := acc.withdraw(100)
+	err := acc.Withdraw(acc.Money(100))
 
 	// assert
 	assert.NotNil(t, err)
@@ -115,7 +127,7 @@ func TestWithdraw_Success(t *testing.T) {
 	acc.Balance = initialBalance
 
 	// act
-	err := acc.withdraw(10)
+	err := acc.Withdraw(acc.Money(10))
 
 	// assert
 	assert.Nil(t, err)
@@ -130,7 +142,7 @@ func TestTransfer_ErrorWithdrawValueFromAccount(t *testing.T) {
 	to := NewAccount("2", "12345678901", "Jenny")
 
 	// act
-	err := from.Transfer(25, to)
+	err := from.Transfer(from.Money(25), to)
 
 	// assert
 	assert.NotNil(t, ErrInsufficientFunds, err)
@@ -144,7 +156,7 @@ func TestTransfer_ErrorValueZero(t *testing.T) {
 	to := NewAccount("2", "12345678901", "Jenny")
 
 	// act
-	err := from.Transfer(0, to)
+	err := from.Transfer(from.Money(0), to)
 
 	// assert
 	assert.NotNil(t, err)
@@ -159,10 +171,199 @@ func TestTransfer_Success(t *testing.T) {
 	to.Balance = 0
 
 	// act
-	err := from.Transfer(25, to)
+	err := from.Transfer(from.Money(25), to)
 
 	// assert
 	assert.Nil(t, err)
 	assert.Equal(t, int64(0), from.Balance)
 	assert.Equal(t, int64(25), to.Balance)
 }
+
+func TestTransfer_CurrencyMismatch(t *testing.T) {
+	// arrange
+	from := NewAccount("1", "01234567890", "John")
+	from.Balance = 25
+
+	to := NewAccount("2", "12345678901", "Jenny")
+	to.Currency = "USD"
+
+	// act
+	err := from.Transfer(from.Money(25), to)
+
+	// assert
+	assert.Equal(t, ErrCurrencyMismatch, err)
+	assert.Equal(t, int64(25), from.Balance)
+	assert.Equal(t, int64(0), to.Balance)
+}
+
+func TestTransfer_SameAccount(t *testing.T) {
+	// arrange
+	acc := NewAccount("1", "01234567890", "John")
+	acc.Balance = 25
+
+	// act
+	err := acc.Transfer(acc.Money(25), acc)
+
+	// assert
+	assert.Equal(t, ErrTransferToSameAccount, err)
+	assert.Equal(t, int64(25), acc.Balance)
+}
+
+func TestTransferConverted_Success(t *testing.T) {
+	// arrange
+	from := NewAccount("1", "01234567890", "John")
+	from.Currency = "USD"
+	from.Balance = 100
+
+	to := NewAccount("2", "12345678901", "Jenny")
+
+	// act
+	err := from.TransferConverted(NewMoney(100, "USD"), to, NewMoney(525, "BRL"))
+
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), from.Balance)
+	assert.Equal(t, int64(525), to.Balance)
+}
+
+func TestDeposit_CurrencyMismatch(t *testing.T) {
+	// arrange
+	acc := NewAccount("1", "01234567890", "John")
+
+	// act
+	err := acc.Deposit(NewMoney(100, "USD"))
+
+	// assert
+	assert.Equal(t, ErrCurrencyMismatch, err)
+	assert.Equal(t, int64(0), acc.Balance)
+}
+
+func TestAccountChangeStatus(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		from        AccountStatus
+		balance     int64
+		to          AccountStatus
+		expectedErr error
+	}{
+		{"block active account", AccountStatusActive, 100, AccountStatusBlocked, nil},
+		{"unblock blocked account", AccountStatusBlocked, 100, AccountStatusActive, nil},
+		{"close active account", AccountStatusActive, 0, AccountStatusClosed, nil},
+		{"close blocked account", AccountStatusBlocked, 0, AccountStatusClosed, nil},
+		{"close account with balance", AccountStatusActive, 100, AccountStatusClosed, ErrCloseAccountWithBalance},
+		{"block blocked account", AccountStatusBlocked, 0, AccountStatusBlocked, ErrInvalidStatusTransition},
+		{"reopen closed account", AccountStatusClosed, 0, AccountStatusActive, ErrInvalidStatusTransition},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// arrange
+			acc := NewAccount("1", "01234567890", "John")
+			acc.Status = tc.from
+			acc.Balance = tc.balance
+
+			// act
+			err := acc.ChangeStatus(tc.to)
+
+			// assert
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.to, acc.Status)
+			} else {
+				assert.Equal(t, tc.from, acc.Status)
+			}
+		})
+	}
+}
+
+func TestAccountChangeStatus_UnknownStatus(t *testing.T) {
+	// arrange
+	acc := NewAccount("1", "01234567890", "John")
+
+	// act
+	err := acc.ChangeStatus("frozen")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, AccountStatusActive, acc.Status)
+}
+
+func TestAccountEnsureActive(t *testing.T) {
+	// arrange
+	acc := NewAccount("1", "01234567890", "John")
+
+	// act & assert
+	assert.Nil(t, acc.EnsureActive())
+
+	acc.Status = AccountStatusBlocked
+	assert.Equal(t, ErrAccountNotActive, acc.EnsureActive())
+}
+
+func TestWithdraw_WithinOverdraftLimit(t *testing.T) {
+	// arrange
+	acc := NewAccount("1", "01234567890", "John")
+	acc.Balance = 50
+	acc.OverdraftLimit = 100
+
+	// act
+	err := acc.Withdraw(acc.Money(120))
+
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-70), acc.Balance)
+	assert.True(t, acc.InOverdraft())
+	assert.Equal(t, int64(30), acc.AvailableOverdraftLimit())
+	assert.Equal(t, int64(30), acc.AvailableBalance())
+}
+
+func TestWithdraw_ExceedsOverdraftLimit(t *testing.T) {
+	// arrange
+	acc := NewAccount("1", "01234567890", "John")
+	acc.Balance = 50
+	acc.OverdraftLimit = 100
+
+	// act
+	err := acc.Withdraw(acc.Money(151))
+
+	// assert
+	assert.Equal(t, ErrInsufficientFunds, err)
+	assert.Equal(t, int64(50), acc.Balance)
+}
+
+func TestAccountSetOverdraftLimit(t *testing.T) {
+	testCases := []struct {
+		name    string
+		balance int64
+		status  AccountStatus
+		limit   int64
+		err     error
+	}{
+		{name: "positive balance", balance: 100, status: AccountStatusActive, limit: 500},
+		{name: "remove limit", balance: 0, status: AccountStatusActive, limit: 0},
+		{name: "blocked account", balance: 0, status: AccountStatusBlocked, limit: 500},
+		{name: "negative limit", balance: 0, status: AccountStatusActive, limit: -1, err: ErrInvalidOverdraftLimit},
+		{name: "limit below used overdraft", balance: -300, status: AccountStatusActive, limit: 200, err: ErrInvalidOverdraftLimit},
+		{name: "closed account", balance: 0, status: AccountStatusClosed, limit: 500, err: ErrAccountNotActive},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			acc := NewAccount("1", "01234567890", "John")
+			acc.Balance = tc.balance
+			acc.OverdraftLimit = 300
+			acc.Status = tc.status
+
+			// act
+			err := acc.SetOverdraftLimit(tc.limit)
+
+			// assert
+			assert.Equal(t, tc.err, err)
+			if tc.err == nil {
+				assert.Equal(t, tc.limit, acc.OverdraftLimit)
+			} else {
+				assert.Equal(t, int64(300), acc.OverdraftLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const MaximumHolders = 4
+
+type AccountHolderRole string
+
+const (
+	AccountHolderRolePrimary   AccountHolderRole = "primary"
+	AccountHolderRoleSecondary AccountHolderRole = "secondary"
+)
+
+var (
+	ErrInvalidHolders    = fmt.Errorf("an account should have one primary holder and at most %v holders", MaximumHolders)
+	ErrInvalidHolderRole = errors.New("invalid holder role, should be primary or secondary")
+	ErrHolderDuplicated  = errors.New("holder document informed more than once")
+	ErrDocumentInUse     = errors.New("document in use by another account")
+)
+
+// AccountHolder is a person or company holding an account. Every holder can operate the
+// account and look it up by their document, the primary holder is the one whose name and
+// document identify the account.
+type AccountHolder struct {
+	Id            string
+	AccountNumber string
+	Document      string
+	Name          string
+	Role          AccountHolderRole
+	CreatedAt     time.Time
+}
+
+func NewAccountHolder(document string, name string, role AccountHolderRole) *AccountHolder {
+	return &AccountHolder{
+		Document:  NormalizeDocument(document),
+		Name:      strings.TrimSpace(name),
+		Role:      role,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewJointAccount creates an account held by holders, named after the primary holder.
+func NewJointAccount(number string, holders []*AccountHolder) (*Account, error) {
+	primary, err := validateHolders(holders)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := NewAccount(number, primary.Document, primary.Name)
+	for _, holder := range holders {
+		holder.AccountNumber = number
+	}
+
+	acc.Holders = holders
+
+	return acc, nil
+}
+
+// AccountHolders returns the holders of the account, only the primary holder taken from the
+// account itself when the holders were not loaded.
+func (acc *Account) AccountHolders() []*AccountHolder {
+	if len(acc.Holders) > 0 {
+		return acc.Holders
+	}
+
+	return []*AccountHolder{{
+		AccountNumber: acc.Number,
+		Document:      acc.Document,
+		Name:          acc.Name,
+		Role:          AccountHolderRolePrimary,
+		CreatedAt:     acc.CreatedAt,
+	}}
+}
+
+// validateHolders checks every holder and returns the primary one.
+func validateHolders(holders []*AccountHolder) (*AccountHolder, error) {
+	if len(holders) == 0 || len(holders) > MaximumHolders {
+		return nil, ErrInvalidHolders
+	}
+
+	var primary *AccountHolder
+	documents := map[string]bool{}
+
+	for _, holder := range holders {
+		if holder.Role != AccountHolderRolePrimary && holder.Role != AccountHolderRoleSecondary {
+			return nil, ErrInvalidHolderRole
+		}
+
+		if holder.Role == AccountHolderRolePrimary {
+			if primary != nil {
+				return nil, ErrInvalidHolders
+			}
+
+			primary = holder
+		}
+
+		_, err := validateName(holder.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = ValidateDocument(holder.Document)
+		if err != nil {
+			return nil, err
+		}
+
+		if documents[holder.Document] {
+			return nil, ErrHolderDuplicated
+		}
+
+		documents[holder.Document] = true
+	}
+
+	if primary == nil {
+		return nil, ErrInvalidHolders
+	}
+
+	return primary, nil
+}
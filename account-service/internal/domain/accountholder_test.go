@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJointAccount(t *testing.T) {
+	// arrange
+	holders := []*AccountHolder{
+		NewAccountHolder("529.982.247-25", "Jane Doe", AccountHolderRoleSecondary),
+		NewAccountHolder("012.345.678-90", " John Doe ", AccountHolderRolePrimary),
+	}
+
+	// act
+	acc, err := NewJointAccount("19", holders)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "01234567890", acc.Document)
+	assert.Equal(t, "John Doe", acc.Name)
+	assert.Len(t, acc.Holders, 2)
+	assert.Equal(t, "19", acc.Holders[0].AccountNumber)
+	assert.Equal(t, "52998224725", acc.Holders[0].Document)
+	assert.NoError(t, acc.Validate())
+}
+
+func TestNewJointAccount_InvalidHolders(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		holders       []*AccountHolder
+		expectedError error
+	}{
+		{
+			testName:      "no holders",
+			holders:       []*AccountHolder{},
+			expectedError: ErrInvalidHolders,
+		},
+		{
+			testName: "no primary holder",
+			holders: []*AccountHolder{
+				NewAccountHolder("01234567890", "John Doe", AccountHolderRoleSecondary),
+			},
+			expectedError: ErrInvalidHolders,
+		},
+		{
+			testName: "two primary holders",
+			holders: []*AccountHolder{
+				NewAccountHolder("01234567890", "John Doe", AccountHolderRolePrimary),
+				NewAccountHolder("52998224725", "Jane Doe", AccountHolderRolePrimary),
+			},
+			expectedError: ErrInvalidHolders,
+		},
+		{
+			testName: "above maximum holders",
+			holders: []*AccountHolder{
+				NewAccountHolder("01234567890", "John Doe", AccountHolderRolePrimary),
+				NewAccountHolder("52998224725", "Jane Doe", AccountHolderRoleSecondary),
+				NewAccountHolder("11144477735", "Mary Doe", AccountHolderRoleSecondary),
+				NewAccountHolder("11222333000181", "Doe Company", AccountHolderRoleSecondary),
+				NewAccountHolder("12345678909", "Peter Doe", AccountHolderRoleSecondary),
+			},
+			expectedError: ErrInvalidHolders,
+		},
+		{
+			testName: "invalid role",
+			holders: []*AccountHolder{
+				NewAccountHolder("01234567890", "John Doe", "owner"),
+			},
+			expectedError: ErrInvalidHolderRole,
+		},
+		{
+			testName: "duplicated document",
+			holders: []*AccountHolder{
+				NewAccountHolder("01234567890", "John Doe", AccountHolderRolePrimary),
+				NewAccountHolder("012.345.678-90", "John Doe", AccountHolderRoleSecondary),
+			},
+			expectedError: ErrHolderDuplicated,
+		},
+		{
+			testName: "invalid document",
+			holders: []*AccountHolder{
+				NewAccountHolder("01234567890", "John Doe", AccountHolderRolePrimary),
+				NewAccountHolder("01234567891", "Jane Doe", AccountHolderRoleSecondary),
+			},
+			expectedError: ErrInvalidDocument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// act
+			acc, err := NewJointAccount("19", tc.holders)
+
+			// assert
+			assert.ErrorIs(t, err, tc.expectedError)
+			assert.Nil(t, acc)
+		})
+	}
+}
+
+func TestNewJointAccount_InvalidHolderName(t *testing.T) {
+	// arrange
+	holders := []*AccountHolder{
+		NewAccountHolder("01234567890", "John Doe", AccountHolderRolePrimary),
+		NewAccountHolder("52998224725", "Jo", AccountHolderRoleSecondary),
+	}
+
+	// act
+	_, err := NewJointAccount("19", holders)
+
+	// assert
+	assert.EqualError(t, err, "invalid name, should be between 5 and 120 characters")
+}
+
+func TestAccountHolders_NotLoaded(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+
+	// act
+	holders := acc.AccountHolders()
+
+	// assert
+	assert.Len(t, holders, 1)
+	assert.Equal(t, "01234567890", holders[0].Document)
+	assert.Equal(t, "John Doe", holders[0].Name)
+	assert.Equal(t, AccountHolderRolePrimary, holders[0].Role)
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const maxAccountBranchLength = 4
+
+var (
+	ErrInvalidAccountNumber       = errors.New("invalid account number")
+	ErrInvalidAccountBranch       = errors.New("account branch must have up to 4 digits")
+	ErrInvalidLegacyAccountNumber = errors.New("legacy max account number must not be negative")
+)
+
+// AccountNumberPolicy has the branch prefixed to new account numbers and the highest number
+// allocated before check digits were introduced. Numbers up to LegacyMaxNumber were plain
+// sequential values, so they are accepted without a check digit and new numbers are allocated
+// above them.
+type AccountNumberPolicy struct {
+	Branch          string
+	LegacyMaxNumber int64
+}
+
+func (p AccountNumberPolicy) Validate() error {
+	if len(p.Branch) > maxAccountBranchLength || !onlyDigits(p.Branch) {
+		return ErrInvalidAccountBranch
+	}
+
+	if p.LegacyMaxNumber < 0 {
+		return ErrInvalidLegacyAccountNumber
+	}
+
+	return nil
+}
+
+// NewNumber builds the account number of sequence, offset by the legacy numbers so they are
+// never handed out again.
+func (p AccountNumberPolicy) NewNumber(sequence int64) string {
+	return NewAccountNumber(p.Branch, sequence+p.LegacyMaxNumber)
+}
+
+// ValidateNumber accepts numbers with a valid check digit and legacy numbers.
+func (p AccountNumberPolicy) ValidateNumber(number string) error {
+	if p.isLegacyNumber(number) {
+		return nil
+	}
+
+	return ValidateAccountNumber(number)
+}
+
+func (p AccountNumberPolicy) isLegacyNumber(number string) bool {
+	if number == "" || number[0] == '0' || !onlyDigits(number) {
+		return false
+	}
+
+	value, err := strconv.ParseInt(number, 10, 64)
+
+	return err == nil && value <= p.LegacyMaxNumber
+}
+
+// NewAccountNumber builds an account number from the optional branch prefix and the next value
+// of the account sequence, followed by a check digit.
+func NewAccountNumber(branch string, sequence int64) string {
+	base := fmt.Sprint(branch, sequence)
+
+	return base + string(accountNumberCheckDigit(base))
+}
+
+// ValidateAccountNumber checks that number only has digits and ends with its check digit, so
+// mistyped numbers are rejected without looking them up.
+func ValidateAccountNumber(number string) error {
+	if len(number) < 2 || !onlyDigits(number) {
+		return ErrInvalidAccountNumber
+	}
+
+	base := number[:len(number)-1]
+	if accountNumberCheckDigit(base) != number[len(number)-1] {
+		return ErrInvalidAccountNumber
+	}
+
+	return nil
+}
+
+// accountNumberCheckDigit computes the mod 11 check digit, weighting the digits from right to
+// left with 2 to 9 and using 0 when the result is 10 or 11.
+func accountNumberCheckDigit(base string) byte {
+	sum := 0
+	weight := 2
+
+	for i := len(base) - 1; i >= 0; i-- {
+		sum += int(base[i]-'0') * weight
+
+		weight++
+		if weight > 9 {
+			weight = 2
+		}
+	}
+
+	digit := 11 - sum%11
+	if digit >= 10 {
+		return '0'
+	}
+
+	return byte('0' + digit)
+}
+
+func onlyDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAccountNumber(t *testing.T) {
+	assert.Equal(t, "19", NewAccountNumber("", 1))
+	assert.Equal(t, "27", NewAccountNumber("", 2))
+	assert.Equal(t, "000116", NewAccountNumber("0001", 1))
+	assert.NoError(t, ValidateAccountNumber(NewAccountNumber("0001", 123456789)))
+}
+
+func TestValidateAccountNumber(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		number        string
+		expectedError error
+	}{
+		{testName: "valid number", number: "19"},
+		{testName: "valid number with branch", number: "000116"},
+		{testName: "wrong check digit", number: "18", expectedError: ErrInvalidAccountNumber},
+		{testName: "without check digit", number: "1", expectedError: ErrInvalidAccountNumber},
+		{testName: "empty", number: "", expectedError: ErrInvalidAccountNumber},
+		{testName: "letters", number: "1a9", expectedError: ErrInvalidAccountNumber},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			err := ValidateAccountNumber(tc.number)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestAccountNumberPolicy_Validate(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		policy        AccountNumberPolicy
+		expectedError error
+	}{
+		{testName: "without branch", policy: AccountNumberPolicy{}},
+		{testName: "with branch and legacy numbers", policy: AccountNumberPolicy{Branch: "0001", LegacyMaxNumber: 500}},
+		{testName: "branch with letters", policy: AccountNumberPolicy{Branch: "00a1"}, expectedError: ErrInvalidAccountBranch},
+		{testName: "branch too long", policy: AccountNumberPolicy{Branch: "00001"}, expectedError: ErrInvalidAccountBranch},
+		{testName: "negative legacy number", policy: AccountNumberPolicy{LegacyMaxNumber: -1}, expectedError: ErrInvalidLegacyAccountNumber},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			err := tc.policy.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestAccountNumberPolicy_NewNumber(t *testing.T) {
+	assert.Equal(t, "19", AccountNumberPolicy{}.NewNumber(1))
+	assert.Equal(t, "1015", AccountNumberPolicy{LegacyMaxNumber: 100}.NewNumber(1))
+	assert.Equal(t, "00011010", AccountNumberPolicy{Branch: "0001", LegacyMaxNumber: 100}.NewNumber(1))
+}
+
+func TestAccountNumberPolicy_ValidateNumber(t *testing.T) {
+	policy := AccountNumberPolicy{LegacyMaxNumber: 100}
+
+	testCases := []struct {
+		testName      string
+		number        string
+		expectedError error
+	}{
+		{testName: "number with check digit", number: "1015"},
+		{testName: "legacy number", number: "1"},
+		{testName: "highest legacy number", number: "100"},
+		{testName: "legacy number with check digit", number: "19"},
+		{testName: "above the legacy numbers", number: "101", expectedError: ErrInvalidAccountNumber},
+		{testName: "legacy number with leading zero", number: "01", expectedError: ErrInvalidAccountNumber},
+		{testName: "zero", number: "0", expectedError: ErrInvalidAccountNumber},
+		{testName: "letters", number: "1a", expectedError: ErrInvalidAccountNumber},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			err := policy.ValidateNumber(tc.number)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestAccountNumberPolicy_ValidateNumber_WithoutLegacyNumbers(t *testing.T) {
+	assert.Equal(t, ErrInvalidAccountNumber, AccountNumberPolicy{}.ValidateNumber("1"))
+	assert.NoError(t, AccountNumberPolicy{}.ValidateNumber("19"))
+}
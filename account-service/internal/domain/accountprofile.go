@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaximumLengthEmail   = 254
+	MaximumLengthAddress = 200
+)
+
+const (
+	AccountProfileFieldName    = "name"
+	AccountProfileFieldEmail   = "email"
+	AccountProfileFieldPhone   = "phone"
+	AccountProfileFieldAddress = "address"
+)
+
+var ErrEmptyProfileUpdate = errors.New("at least one of name, email, phone or address must be informed")
+
+// AccountProfileUpdate holds the profile fields to change, nil fields are kept as they are and
+// an empty contact field removes it.
+type AccountProfileUpdate struct {
+	Name    *string
+	Email   *string
+	Phone   *string
+	Address *string
+}
+
+// AccountProfileChange records a field of the profile changed, kept as the history of the account.
+type AccountProfileChange struct {
+	Id            string
+	AccountNumber string
+	Field         string
+	OldValue      string
+	NewValue      string
+	ChangedBy     string
+	ChangedAt     time.Time
+}
+
+// UpdateProfile validates and applies update, returning a change for each field whose value
+// actually changed. Nothing is applied when any field is invalid.
+func (acc *Account) UpdateProfile(update AccountProfileUpdate, changedBy string) ([]*AccountProfileChange, error) {
+	if acc.Status == AccountStatusClosed {
+		return nil, ErrAccountNotActive
+	}
+
+	if update.Name == nil && update.Email == nil && update.Phone == nil && update.Address == nil {
+		return nil, ErrEmptyProfileUpdate
+	}
+
+	name, err := normalizeProfileField(update.Name, acc.Name, validateName)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := normalizeProfileField(update.Email, acc.Email, validateEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	phone, err := normalizeProfileField(update.Phone, acc.Phone, validatePhone)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := normalizeProfileField(update.Address, acc.Address, validateAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	changes := []*AccountProfileChange{}
+
+	for _, field := range []struct {
+		name     string
+		current  *string
+		newValue string
+	}{
+		{AccountProfileFieldName, &acc.Name, name},
+		{AccountProfileFieldEmail, &acc.Email, email},
+		{AccountProfileFieldPhone, &acc.Phone, phone},
+		{AccountProfileFieldAddress, &acc.Address, address},
+	} {
+		if *field.current == field.newValue {
+			continue
+		}
+
+		changes = append(changes, &AccountProfileChange{
+			AccountNumber: acc.Number,
+			Field:         field.name,
+			OldValue:      *field.current,
+			NewValue:      field.newValue,
+			ChangedBy:     changedBy,
+			ChangedAt:     now,
+		})
+
+		*field.current = field.newValue
+	}
+
+	if len(changes) > 0 {
+		acc.UpdatedAt = now
+	}
+
+	return changes, nil
+}
+
+// normalizeProfileField returns the value the field should have, the current one when it is
+// not being updated.
+func normalizeProfileField(value *string, current string, validate func(string) (string, error)) (string, error) {
+	if value == nil {
+		return current, nil
+	}
+
+	return validate(strings.TrimSpace(*value))
+}
+
+func validateName(name string) (string, error) {
+	nameLength := utf8.RuneCountInString(name)
+	if nameLength < MinimumLengthName || nameLength > MaximumLengthName {
+		return "", fmt.Errorf("invalid name, should be between %v and %v characters", MinimumLengthName, MaximumLengthName)
+	}
+
+	return name, nil
+}
+
+func validateEmail(email string) (string, error) {
+	if email == "" {
+		return "", nil
+	}
+
+	email = strings.ToLower(email)
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || len(email) > MaximumLengthEmail {
+		return "", errors.New("invalid email")
+	}
+
+	return email, nil
+}
+
+func validatePhone(phone string) (string, error) {
+	if phone == "" {
+		return "", nil
+	}
+
+	phone = NormalizePixKey(phone)
+	if !validPhoneKey(phone) {
+		return "", errors.New("invalid phone, should be in the international format like +5511912345678")
+	}
+
+	return phone, nil
+}
+
+func validateAddress(address string) (string, error) {
+	if utf8.RuneCountInString(address) > MaximumLengthAddress {
+		return "", fmt.Errorf("invalid address, should have at most %v characters", MaximumLengthAddress)
+	}
+
+	return address, nil
+}
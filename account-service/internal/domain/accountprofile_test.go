@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func profileValue(value string) *string {
+	return &value
+}
+
+func TestUpdateProfile_ReturnsChangedFields(t *testing.T) {
+	// arrange
+	acc := NewAccount("123456", "01234567890", "John Yos Bidden")
+	acc.Phone = "+5511912345678"
+
+	update := AccountProfileUpdate{
+		Name:  profileValue("  John Bidden  "),
+		Email: profileValue("John@Example.com"),
+		Phone: profileValue("+55 (11) 91234-5678"),
+	}
+
+	// act
+	changes, err := acc.UpdateProfile(update, "client")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, AccountProfileFieldName, changes[0].Field)
+	assert.Equal(t, "John Yos Bidden", changes[0].OldValue)
+	assert.Equal(t, "John Bidden", changes[0].NewValue)
+	assert.Equal(t, "client", changes[0].ChangedBy)
+	assert.Equal(t, "123456", changes[0].AccountNumber)
+	assert.Equal(t, AccountProfileFieldEmail, changes[1].Field)
+	assert.Equal(t, "", changes[1].OldValue)
+	assert.Equal(t, "john@example.com", changes[1].NewValue)
+	assert.Equal(t, "John Bidden", acc.Name)
+	assert.Equal(t, "john@example.com", acc.Email)
+	assert.Equal(t, "+5511912345678", acc.Phone)
+}
+
+func TestUpdateProfile_EmptyValueRemovesContact(t *testing.T) {
+	// arrange
+	acc := NewAccount("123456", "01234567890", "John Yos Bidden")
+	acc.Address = "Rua Augusta, 100"
+
+	// act
+	changes, err := acc.UpdateProfile(AccountProfileUpdate{Address: profileValue("")}, "client")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "", acc.Address)
+}
+
+func TestUpdateProfile_InvalidFields(t *testing.T) {
+	testCases := []struct {
+		testName string
+		update   AccountProfileUpdate
+		expected string
+	}{
+		{
+			testName: "given no field should return error",
+			update:   AccountProfileUpdate{},
+			expected: ErrEmptyProfileUpdate.Error(),
+		},
+		{
+			testName: "given short name should return error",
+			update:   AccountProfileUpdate{Name: profileValue("me")},
+			expected: "invalid name, should be between 5 and 120 characters",
+		},
+		{
+			testName: "given empty name should return error",
+			update:   AccountProfileUpdate{Name: profileValue("")},
+			expected: "invalid name, should be between 5 and 120 characters",
+		},
+		{
+			testName: "given invalid email should return error",
+			update:   AccountProfileUpdate{Email: profileValue("John Doe <john@example.com>")},
+			expected: "invalid email",
+		},
+		{
+			testName: "given phone without country code should return error",
+			update:   AccountProfileUpdate{Phone: profileValue("11912345678")},
+			expected: "invalid phone, should be in the international format like +5511912345678",
+		},
+		{
+			testName: "given long address should return error",
+			update:   AccountProfileUpdate{Address: profileValue(strings.Repeat("a", MaximumLengthAddress+1))},
+			expected: "invalid address, should have at most 200 characters",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// arrange
+			acc := NewAccount("123456", "01234567890", "John Yos Bidden")
+
+			// act
+			changes, err := acc.UpdateProfile(tc.update, "client")
+
+			// assert
+			assert.EqualError(t, err, tc.expected)
+			assert.Nil(t, changes)
+			assert.Equal(t, "John Yos Bidden", acc.Name)
+		})
+	}
+}
+
+func TestUpdateProfile_InvalidFieldAppliesNothing(t *testing.T) {
+	// arrange
+	acc := NewAccount("123456", "01234567890", "John Yos Bidden")
+
+	update := AccountProfileUpdate{
+		Name:  profileValue("John Bidden"),
+		Phone: profileValue("123"),
+	}
+
+	// act
+	_, err := acc.UpdateProfile(update, "client")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "John Yos Bidden", acc.Name)
+}
+
+func TestUpdateProfile_ClosedAccount(t *testing.T) {
+	// arrange
+	acc := NewAccount("123456", "01234567890", "John Yos Bidden")
+	acc.Status = AccountStatusClosed
+
+	// act
+	_, err := acc.UpdateProfile(AccountProfileUpdate{Name: profileValue("John Bidden")}, "client")
+
+	// assert
+	assert.ErrorIs(t, err, ErrAccountNotActive)
+}
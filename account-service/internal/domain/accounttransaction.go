@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	DepositAccountTransaction     = "deposit"
+	WithdrawAccountTransaction    = "withdraw"
+	TransferInAccountTransaction  = "transfer_in"
+	TransferOutAccountTransaction = "transfer_out"
+	ReversalInAccountTransaction  = "reversal_in"
+	ReversalOutAccountTransaction = "reversal_out"
+	InterestAccountTransaction    = "interest"
+	FeeAccountTransaction         = "fee"
+
+	DefaultAccountTransactionsLimit = 20
+	MaximumAccountTransactionsLimit = 100
+)
+
+type accountTransactionKind struct {
+	ledgerType string
+	credit     bool
+}
+
+var accountTransactionKinds = map[string]accountTransactionKind{
+	DepositAccountTransaction:     {ledgerType: DepositLedgerTransaction, credit: true},
+	WithdrawAccountTransaction:    {ledgerType: WithdrawLedgerTransaction, credit: false},
+	TransferInAccountTransaction:  {ledgerType: TransferLedgerTransaction, credit: true},
+	TransferOutAccountTransaction: {ledgerType: TransferLedgerTransaction, credit: false},
+	ReversalInAccountTransaction:  {ledgerType: TransferReversalLedgerTransaction, credit: true},
+	ReversalOutAccountTransaction: {ledgerType: TransferReversalLedgerTransaction, credit: false},
+	InterestAccountTransaction:    {ledgerType: InterestLedgerTransaction, credit: true},
+	FeeAccountTransaction:         {ledgerType: FeeLedgerTransaction, credit: false},
+}
+
+// AccountTransaction is a ledger entry seen from the account it belongs to.
+type AccountTransaction struct {
+	EntryId       int64
+	TransactionId string
+	Type          string
+	Counterparty  string
+	Amount        int64
+	Balance       int64
+	CreatedAt     time.Time
+}
+
+type AccountTransactionsFilter struct {
+	AccountNumber string
+	From          *time.Time
+	To            *time.Time
+	Types         []string
+	Cursor        int64
+	Limit         int
+}
+
+type AccountTransactionsPage struct {
+	Transactions []*AccountTransaction
+	NextCursor   int64
+}
+
+// GetAccountTransactionType names an entry from the account perspective, a transfer entry
+// crediting the account is a transfer in and one debiting it is a transfer out.
+func GetAccountTransactionType(ledgerType string, amount int64) string {
+	for transactionType, kind := range accountTransactionKinds {
+		if kind.ledgerType == ledgerType && kind.credit == (amount > 0) {
+			return transactionType
+		}
+	}
+
+	return ledgerType
+}
+
+// GetAccountTransactionLedgerType returns the ledger transaction type and direction a
+// transaction type filter matches.
+func GetAccountTransactionLedgerType(transactionType string) (ledgerType string, credit bool, err error) {
+	kind, ok := accountTransactionKinds[transactionType]
+	if !ok {
+		return "", false, fmt.Errorf("invalid transaction type %v", transactionType)
+	}
+
+	return kind.ledgerType, kind.credit, nil
+}
+
+func (f *AccountTransactionsFilter) Validate() error {
+	if f.Limit < 0 || f.Limit > MaximumAccountTransactionsLimit {
+		return fmt.Errorf("invalid limit, should be between 1 and %v", MaximumAccountTransactionsLimit)
+	}
+
+	if f.Cursor < 0 {
+		return errors.New("invalid cursor")
+	}
+
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return errors.New("invalid date range, from should be before to")
+	}
+
+	for _, transactionType := range f.Types {
+		_, _, err := GetAccountTransactionLedgerType(transactionType)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
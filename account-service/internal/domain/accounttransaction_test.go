@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountTransactionType(t *testing.T) {
+	testCases := []struct {
+		ledgerType string
+		amount     int64
+		expected   string
+	}{
+		{DepositLedgerTransaction, 100, DepositAccountTransaction},
+		{WithdrawLedgerTransaction, -100, WithdrawAccountTransaction},
+		{TransferLedgerTransaction, 100, TransferInAccountTransaction},
+		{TransferLedgerTransaction, -100, TransferOutAccountTransaction},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			// act
+			transactionType := GetAccountTransactionType(tc.ledgerType, tc.amount)
+
+			// assert
+			assert.Equal(t, tc.expected, transactionType)
+		})
+	}
+}
+
+func TestAccountTransactionsFilterValidate(t *testing.T) {
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		testName string
+		filter   AccountTransactionsFilter
+		valid    bool
+	}{
+		{"empty filter", AccountTransactionsFilter{}, true},
+		{"known types", AccountTransactionsFilter{Types: []string{"deposit", "transfer_in"}}, true},
+		{"unknown type", AccountTransactionsFilter{Types: []string{"refund"}}, false},
+		{"limit above maximum", AccountTransactionsFilter{Limit: MaximumAccountTransactionsLimit + 1}, false},
+		{"negative cursor", AccountTransactionsFilter{Cursor: -1}, false},
+		{"from after to", AccountTransactionsFilter{From: &from, To: &to}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// act
+			err := tc.filter.Validate()
+
+			// assert
+			assert.Equal(t, tc.valid, err == nil)
+		})
+	}
+}
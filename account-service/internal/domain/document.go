@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+type DocumentType string
+
+const (
+	DocumentTypePerson  DocumentType = "person"
+	DocumentTypeCompany DocumentType = "company"
+)
+
+var (
+	ErrInvalidDocument = errors.New("invalid document, should be a valid CPF or CNPJ")
+
+	cpfWeights  = []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}
+	cnpjWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+// NormalizeDocument removes the formatting of a CPF or CNPJ, like "123.456.789-09" or
+// "12.345.678/0001-95", keeping anything else so it is rejected by the validation.
+func NormalizeDocument(document string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '-', '/', ' ':
+			return -1
+		}
+
+		return r
+	}, strings.TrimSpace(document))
+}
+
+// ValidateDocument checks the check digits of a normalized CPF or CNPJ and returns whether
+// it belongs to a person or a company.
+func ValidateDocument(document string) (DocumentType, error) {
+	for _, r := range document {
+		if r < '0' || r > '9' {
+			return "", ErrInvalidDocument
+		}
+	}
+
+	// sequences like 00000000000 pass the check digits but are never issued
+	if document == "" || strings.Count(document, document[:1]) == len(document) {
+		return "", ErrInvalidDocument
+	}
+
+	switch len(document) {
+	case CPFLength:
+		if !validCheckDigits(document, cpfWeights) {
+			return "", ErrInvalidDocument
+		}
+
+		return DocumentTypePerson, nil
+	case CNPJLength:
+		if !validCheckDigits(document, cnpjWeights) {
+			return "", ErrInvalidDocument
+		}
+
+		return DocumentTypeCompany, nil
+	default:
+		return "", ErrInvalidDocument
+	}
+}
+
+// validCheckDigits verifies the two trailing check digits with the modulo 11 algorithm shared
+// by CPF and CNPJ, the first digit uses the weights without their first element.
+func validCheckDigits(document string, weights []int) bool {
+	digits := len(weights) - 1
+
+	return checkDigit(document[:digits], weights[1:]) == document[digits] &&
+		checkDigit(document[:digits+1], weights) == document[digits+1]
+}
+
+func checkDigit(digits string, weights []int) byte {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(digits[i]-'0') * weight
+	}
+
+	rest := sum % 11
+	if rest < 2 {
+		return '0'
+	}
+
+	return byte('0' + 11 - rest)
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeDocument(t *testing.T) {
+	assert.Equal(t, "01234567890", NormalizeDocument(" 012.345.678-90 "))
+	assert.Equal(t, "11222333000181", NormalizeDocument("11.222.333/0001-81"))
+	assert.Equal(t, "aaaaaaaaaaa", NormalizeDocument("aaaaaaaaaaa"))
+}
+
+func TestValidateDocument(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		document      string
+		expectedType  DocumentType
+		expectedError error
+	}{
+		{testName: "valid CPF", document: "01234567890", expectedType: DocumentTypePerson},
+		{testName: "valid CPF with zero check digits", document: "52998224725", expectedType: DocumentTypePerson},
+		{testName: "valid CNPJ", document: "11222333000181", expectedType: DocumentTypeCompany},
+		{testName: "CPF with wrong check digit", document: "01234567891", expectedError: ErrInvalidDocument},
+		{testName: "CNPJ with wrong check digit", document: "11222333000182", expectedError: ErrInvalidDocument},
+		{testName: "repeated digits", document: "00000000000", expectedError: ErrInvalidDocument},
+		{testName: "repeated digits CNPJ", document: "11111111111111", expectedError: ErrInvalidDocument},
+		{testName: "letters", document: "aaaaaaaaaaa", expectedError: ErrInvalidDocument},
+		{testName: "formatted document", document: "012.345.678-90", expectedError: ErrInvalidDocument},
+		{testName: "invalid length", document: "0123456789", expectedError: ErrInvalidDocument},
+		{testName: "empty", document: "", expectedError: ErrInvalidDocument},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			documentType, err := ValidateDocument(tc.document)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedType, documentType)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+type FeeOperation string
+
+const (
+	FeeOperationDeposit     FeeOperation = "deposit"
+	FeeOperationTransfer    FeeOperation = "transfer"
+	FeeOperationMaintenance FeeOperation = "maintenance"
+)
+
+var ErrInvalidFeeRule = errors.New("invalid fee rule")
+
+// FeeRule is the fee of an operation, a flat amount plus a percentage of the value like
+// "0.005", kept between Min and Max when they are set. Amounts are in minor units of the
+// currency of the fee schedule.
+type FeeRule struct {
+	Operation  FeeOperation
+	Flat       int64
+	Percentage string
+	Min        int64
+	Max        int64
+}
+
+type feeRule struct {
+	FeeRule
+	percentage *big.Rat
+}
+
+// FeeSchedule holds the fees charged on deposits and transfers and the monthly maintenance
+// fee of each account tier. Operations without a rule are free. Its amounts are in currency
+// and converted by the fx rates to the currency of the account they are charged on.
+type FeeSchedule struct {
+	currency    Currency
+	rules       map[FeeOperation]feeRule
+	maintenance map[DocumentType]int64
+	fxRates     *FxRates
+}
+
+func NewFeeSchedule(currency Currency, rules []FeeRule, maintenance map[DocumentType]int64, fxRates *FxRates) (*FeeSchedule, error) {
+	if err := currency.Validate(); err != nil {
+		return nil, fmt.Errorf("%w, unsupported currency %v", ErrInvalidFeeRule, currency)
+	}
+
+	schedule := &FeeSchedule{
+		currency:    currency,
+		rules:       map[FeeOperation]feeRule{},
+		maintenance: maintenance,
+		fxRates:     fxRates,
+	}
+
+	for _, rule := range rules {
+		if rule.Operation != FeeOperationDeposit && rule.Operation != FeeOperationTransfer {
+			return nil, fmt.Errorf("%w, unknown operation %v", ErrInvalidFeeRule, rule.Operation)
+		}
+
+		if rule.Flat < 0 || rule.Min < 0 || rule.Max < 0 || (rule.Max > 0 && rule.Max < rule.Min) {
+			return nil, fmt.Errorf("%w, amounts of %v must not be negative and max must not be lower than min", ErrInvalidFeeRule, rule.Operation)
+		}
+
+		percentage := new(big.Rat)
+		if rule.Percentage != "" {
+			_, ok := percentage.SetString(rule.Percentage)
+			if !ok || percentage.Sign() < 0 {
+				return nil, fmt.Errorf("%w, invalid percentage %v of %v", ErrInvalidFeeRule, rule.Percentage, rule.Operation)
+			}
+		}
+
+		schedule.rules[rule.Operation] = feeRule{FeeRule: rule, percentage: percentage}
+	}
+
+	for tier, fee := range maintenance {
+		if fee < 0 {
+			return nil, fmt.Errorf("%w, maintenance fee of %v must not be negative", ErrInvalidFeeRule, tier)
+		}
+	}
+
+	return schedule, nil
+}
+
+// Fee returns the fee of operation over value in the currency of value, the percentage part
+// rounded half to even. It fails when the amounts of the rule cannot be converted to that
+// currency.
+func (s *FeeSchedule) Fee(operation FeeOperation, value Money) (Money, error) {
+	if s == nil {
+		return NewMoney(0, value.Currency), nil
+	}
+
+	rule, ok := s.rules[operation]
+	if !ok {
+		return NewMoney(0, value.Currency), nil
+	}
+
+	flat, err := s.convert(rule.Flat, value.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	minFee, err := s.convert(rule.Min, value.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	maxFee, err := s.convert(rule.Max, value.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	fee := flat + roundHalfEven(new(big.Rat).Mul(big.NewRat(value.Amount, 1), rule.percentage))
+
+	if fee < minFee {
+		fee = minFee
+	}
+
+	if rule.Max > 0 && fee > maxFee {
+		fee = maxFee
+	}
+
+	return NewMoney(fee, value.Currency), nil
+}
+
+// DepositFee returns the fee of a deposit of value, capped at the deposited value so a deposit
+// never lowers the balance.
+func (s *FeeSchedule) DepositFee(value Money) (Money, error) {
+	fee, err := s.Fee(FeeOperationDeposit, value)
+	if err != nil {
+		return Money{}, err
+	}
+
+	if fee.Amount > value.Amount {
+		fee.Amount = value.Amount
+	}
+
+	return fee, nil
+}
+
+// MaintenanceFee returns the monthly fee of the account tier in the account currency.
+func (s *FeeSchedule) MaintenanceFee(acc *Account) (Money, error) {
+	if s == nil {
+		return acc.Money(0), nil
+	}
+
+	fee, err := s.convert(s.maintenance[acc.DocumentType()], acc.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return acc.Money(fee), nil
+}
+
+// convert returns an amount of the schedule in minor units of currency.
+func (s *FeeSchedule) convert(amount int64, currency Currency) (int64, error) {
+	if amount == 0 {
+		return 0, nil
+	}
+
+	converted, err := s.fxRates.Convert(NewMoney(amount, s.currency), currency)
+	if err != nil {
+		return 0, err
+	}
+
+	return converted.Amount, nil
+}
+
+// ChargeFee debits fee from the account. The fee is owed regardless of the balance, so it may
+// overdraw the account like the maintenance fee does; transfers check their fee is affordable
+// beforehand and deposit fees are capped at the deposited value.
+func (acc *Account) ChargeFee(fee Money) error {
+	if fee.Amount <= 0 {
+		return errors.New("for a fee the value must be greater than zero")
+	}
+
+	if fee.Currency != acc.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	acc.Balance -= fee.Amount
+	acc.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// MaintenanceFeeDue tells whether the maintenance fee of the month of day is still to be
+// charged, accounts pay it from the month after they are opened.
+func (acc *Account) MaintenanceFeeDue(day time.Time) bool {
+	charged := acc.MaintenanceFeeChargedOn
+	if charged.IsZero() {
+		charged = acc.CreatedAt
+	}
+
+	return truncateToMonth(charged).Before(truncateToMonth(day))
+}
+
+// ChargeMaintenanceFee charges the maintenance fee of the month of day, a zero fee only marks
+// the month as charged.
+func (acc *Account) ChargeMaintenanceFee(fee Money, day time.Time) error {
+	if fee.Amount > 0 {
+		err := acc.ChargeFee(fee)
+		if err != nil {
+			return err
+		}
+	}
+
+	acc.MaintenanceFeeChargedOn = truncateToDay(day)
+	acc.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func truncateToMonth(t time.Time) time.Time {
+	year, month, _ := t.UTC().Date()
+
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFeeSchedule_InvalidRule(t *testing.T) {
+	testCases := []struct {
+		testName string
+		rule     FeeRule
+	}{
+		{"unknown operation", FeeRule{Operation: "withdrawal", Flat: 100}},
+		{"maintenance as operation", FeeRule{Operation: FeeOperationMaintenance, Flat: 100}},
+		{"negative flat", FeeRule{Operation: FeeOperationTransfer, Flat: -1}},
+		{"max lower than min", FeeRule{Operation: FeeOperationTransfer, Min: 100, Max: 50}},
+		{"invalid percentage", FeeRule{Operation: FeeOperationTransfer, Percentage: "abc"}},
+		{"negative percentage", FeeRule{Operation: FeeOperationTransfer, Percentage: "-0.01"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			_, err := NewFeeSchedule("BRL", []FeeRule{tc.rule}, nil, nil)
+			assert.ErrorIs(t, err, ErrInvalidFeeRule)
+		})
+	}
+
+	_, err := NewFeeSchedule("BRL", nil, map[DocumentType]int64{DocumentTypePerson: -1}, nil)
+	assert.ErrorIs(t, err, ErrInvalidFeeRule)
+
+	_, err = NewFeeSchedule("XYZ", nil, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidFeeRule)
+}
+
+func TestFeeSchedule_Fee(t *testing.T) {
+	schedule, err := NewFeeSchedule("USD", []FeeRule{
+		{Operation: FeeOperationTransfer, Percentage: "0.01", Min: 50, Max: 1000},
+		{Operation: FeeOperationDeposit, Flat: 100, Percentage: "0.005"},
+	}, nil, nil)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		testName  string
+		operation FeeOperation
+		amount    int64
+		expected  int64
+	}{
+		{"percentage", FeeOperationTransfer, 20000, 200},
+		{"raised to min", FeeOperationTransfer, 1000, 50},
+		{"capped at max", FeeOperationTransfer, 500000, 1000},
+		{"half rounded to even down", FeeOperationTransfer, 10050, 100},
+		{"half rounded to even up", FeeOperationTransfer, 10150, 102},
+		{"flat plus percentage", FeeOperationDeposit, 10000, 150},
+		{"operation without rule", FeeOperationMaintenance, 10000, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			fee, err := schedule.Fee(tc.operation, NewMoney(tc.amount, "USD"))
+
+			assert.NoError(t, err)
+			assert.Equal(t, NewMoney(tc.expected, "USD"), fee)
+		})
+	}
+}
+
+func TestFeeSchedule_DepositFeeCappedAtValue(t *testing.T) {
+	schedule, _ := NewFeeSchedule("BRL", []FeeRule{{Operation: FeeOperationDeposit, Flat: 100}}, nil, nil)
+
+	fee, err := schedule.DepositFee(NewMoney(1000, "BRL"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(100, "BRL"), fee)
+
+	fee, err = schedule.DepositFee(NewMoney(60, "BRL"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(60, "BRL"), fee)
+}
+
+func TestFeeSchedule_NilIsFree(t *testing.T) {
+	var schedule *FeeSchedule
+	acc := NewAccount("21", "01234567890", "John Doe")
+
+	fee, err := schedule.Fee(FeeOperationTransfer, NewMoney(10000, "BRL"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), fee.Amount)
+
+	fee, err = schedule.MaintenanceFee(acc)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), fee.Amount)
+}
+
+func TestFeeSchedule_MaintenanceFeeByTier(t *testing.T) {
+	schedule, err := NewFeeSchedule("BRL", nil, map[DocumentType]int64{
+		DocumentTypePerson:  1290,
+		DocumentTypeCompany: 4990,
+	}, nil)
+	assert.NoError(t, err)
+
+	person := NewAccount("21", "01234567890", "John Doe")
+	company := NewAccount("22", "12345678000190", "Acme")
+
+	fee, err := schedule.MaintenanceFee(person)
+	assert.NoError(t, err)
+	assert.Equal(t, person.Money(1290), fee)
+
+	fee, err = schedule.MaintenanceFee(company)
+	assert.NoError(t, err)
+	assert.Equal(t, company.Money(4990), fee)
+}
+
+func TestFeeSchedule_ConvertsAmountsToAccountCurrency(t *testing.T) {
+	fxRates, _ := NewFxRates([]FxRate{{From: "BRL", To: "USD", Rate: "0.19"}})
+	schedule, err := NewFeeSchedule("BRL", []FeeRule{
+		{Operation: FeeOperationTransfer, Percentage: "0.01", Min: 50, Max: 1000},
+	}, map[DocumentType]int64{DocumentTypePerson: 1290}, fxRates)
+	assert.NoError(t, err)
+
+	acc := NewAccount("21", "01234567890", "John Doe")
+	acc.Currency = "USD"
+
+	fee, err := schedule.MaintenanceFee(acc)
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(245, "USD"), fee)
+
+	fee, err = schedule.Fee(FeeOperationTransfer, NewMoney(100, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(10, "USD"), fee)
+
+	fee, err = schedule.Fee(FeeOperationTransfer, NewMoney(500000, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(190, "USD"), fee)
+
+	fee, err = schedule.Fee(FeeOperationTransfer, NewMoney(500000, "BRL"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(1000, "BRL"), fee)
+}
+
+func TestFeeSchedule_WithoutFxRateToAccountCurrency(t *testing.T) {
+	schedule, _ := NewFeeSchedule("BRL", nil, map[DocumentType]int64{DocumentTypePerson: 1290}, nil)
+
+	acc := NewAccount("21", "01234567890", "John Doe")
+	acc.Currency = "EUR"
+
+	_, err := schedule.MaintenanceFee(acc)
+
+	assert.ErrorIs(t, err, ErrFxRateNotFound)
+}
+
+func TestChargeFee_MayOverdraw(t *testing.T) {
+	acc := NewAccount("21", "01234567890", "John Doe")
+	acc.Balance = 30
+
+	err := acc.ChargeFee(acc.Money(50))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-20), acc.Balance)
+}
+
+func TestChargeFee_Invalid(t *testing.T) {
+	acc := NewAccount("21", "01234567890", "John Doe")
+	acc.Balance = 100
+
+	assert.Error(t, acc.ChargeFee(acc.Money(0)))
+	assert.ErrorIs(t, acc.ChargeFee(NewMoney(50, "USD")), ErrCurrencyMismatch)
+	assert.Equal(t, int64(100), acc.Balance)
+}
+
+func TestMaintenanceFeeDue(t *testing.T) {
+	acc := NewAccount("21", "01234567890", "John Doe")
+	acc.CreatedAt = time.Date(2024, 5, 20, 10, 0, 0, 0, time.UTC)
+
+	assert.False(t, acc.MaintenanceFeeDue(time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, acc.MaintenanceFeeDue(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)))
+
+	err := acc.ChargeMaintenanceFee(acc.Money(1290), time.Date(2024, 6, 1, 3, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(-1290), acc.Balance)
+	assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), acc.MaintenanceFeeChargedOn)
+	assert.False(t, acc.MaintenanceFeeDue(time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, acc.MaintenanceFeeDue(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestChargeMaintenanceFee_ZeroFeeOnlyMarksMonth(t *testing.T) {
+	acc := NewAccount("21", "01234567890", "John Doe")
+	acc.Balance = 100
+
+	err := acc.ChargeMaintenanceFee(acc.Money(0), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), acc.Balance)
+	assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), acc.MaintenanceFeeChargedOn)
+}
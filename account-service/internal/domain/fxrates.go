@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var ErrFxRateNotFound = errors.New("no fx rate between the currencies")
+
+// FxRate is how many units of To one unit of From buys, as a decimal like "5.25".
+type FxRate struct {
+	From Currency
+	To   Currency
+	Rate string
+}
+
+type fxPair struct {
+	from Currency
+	to   Currency
+}
+
+// FxRates is the table of rates converting transfers between accounts of different currencies.
+type FxRates struct {
+	rates map[fxPair]*big.Rat
+}
+
+func NewFxRates(rates []FxRate) (*FxRates, error) {
+	table := &FxRates{rates: map[fxPair]*big.Rat{}}
+
+	for _, rate := range rates {
+		if err := rate.From.Validate(); err != nil {
+			return nil, err
+		}
+
+		if err := rate.To.Validate(); err != nil {
+			return nil, err
+		}
+
+		value, ok := new(big.Rat).SetString(rate.Rate)
+		if !ok || value.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid fx rate %v from %v to %v", rate.Rate, rate.From, rate.To)
+		}
+
+		table.rates[fxPair{from: rate.From, to: rate.To}] = value
+	}
+
+	return table, nil
+}
+
+// Convert returns value in the currency to, rounding half away from zero to its minor unit.
+// Money already in that currency is returned as is.
+func (r *FxRates) Convert(value Money, to Currency) (Money, error) {
+	if value.Currency == to {
+		return value, nil
+	}
+
+	if r == nil {
+		return Money{}, ErrFxRateNotFound
+	}
+
+	rate, ok := r.rates[fxPair{from: value.Currency, to: to}]
+	if !ok {
+		return Money{}, fmt.Errorf("%w %v and %v", ErrFxRateNotFound, value.Currency, to)
+	}
+
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(value.Amount), rate)
+	converted.Mul(converted, new(big.Rat).SetFrac(pow10(to.MinorUnits()), pow10(value.Currency.MinorUnits())))
+
+	return NewMoney(roundHalfAwayFromZero(converted), to), nil
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+func roundHalfAwayFromZero(value *big.Rat) int64 {
+	numerator := new(big.Int).Abs(value.Num())
+	quotient, remainder := new(big.Int).QuoRem(numerator, value.Denom(), new(big.Int))
+
+	if new(big.Int).Mul(remainder, big.NewInt(2)).Cmp(value.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+
+	if value.Sign() < 0 {
+		quotient.Neg(quotient)
+	}
+
+	return quotient.Int64()
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFxRates_Invalid(t *testing.T) {
+	_, err := NewFxRates([]FxRate{{From: "USD", To: "BRL", Rate: "abc"}})
+	assert.Error(t, err)
+
+	_, err = NewFxRates([]FxRate{{From: "USD", To: "BRL", Rate: "0"}})
+	assert.Error(t, err)
+
+	_, err = NewFxRates([]FxRate{{From: "XYZ", To: "BRL", Rate: "1"}})
+	assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+}
+
+func TestFxRates_Convert(t *testing.T) {
+	rates, err := NewFxRates([]FxRate{
+		{From: "USD", To: "BRL", Rate: "5.25"},
+		{From: "BRL", To: "JPY", Rate: "27.3"},
+	})
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		testName string
+		value    Money
+		to       Currency
+		expected Money
+	}{
+		{"same currency", NewMoney(100, "BRL"), "BRL", NewMoney(100, "BRL")},
+		{"converted", NewMoney(1000, "USD"), "BRL", NewMoney(5250, "BRL")},
+		{"rounded half away from zero", NewMoney(3, "USD"), "BRL", NewMoney(16, "BRL")},
+		{"to currency without minor unit", NewMoney(1000, "BRL"), "JPY", NewMoney(273, "JPY")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			converted, err := rates.Convert(tc.value, tc.to)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, converted)
+		})
+	}
+
+	_, err = rates.Convert(NewMoney(100, "BRL"), "USD")
+	assert.ErrorIs(t, err, ErrFxRateNotFound)
+
+	_, err = (*FxRates)(nil).Convert(NewMoney(100, "BRL"), "USD")
+	assert.ErrorIs(t, err, ErrFxRateNotFound)
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type HoldStatus string
+
+const (
+	HoldStatusActive   HoldStatus = "active"
+	HoldStatusCaptured HoldStatus = "captured"
+	HoldStatusReleased HoldStatus = "released"
+	HoldStatusExpired  HoldStatus = "expired"
+)
+
+var (
+	ErrHoldNotActive           = errors.New("hold is not active")
+	ErrCaptureExceedsHold      = errors.New("capture value exceeds the hold amount")
+	ErrCaptureRequiresApproval = errors.New("capture into an account requiring approval is not allowed")
+)
+
+// Hold reserves an amount of an account without moving it, for example for a card
+// authorization. While active it lowers the available balance, it is then captured into a
+// withdrawal or transfer, released or expires.
+type Hold struct {
+	Id             string
+	AccountNumber  string
+	Amount         int64
+	CapturedAmount int64
+	Description    string
+	Status         HoldStatus
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// PlaceHold reserves amount of the available balance until ttl elapses.
+func (acc *Account) PlaceHold(amount int64, description string, ttl time.Duration) (*Hold, error) {
+	if amount <= 0 {
+		return nil, errors.New("for a hold the amount must be greater than zero")
+	}
+
+	err := acc.EnsureActive()
+	if err != nil {
+		return nil, err
+	}
+
+	if amount > acc.AvailableBalance() {
+		return nil, ErrInsufficientFunds
+	}
+
+	now := time.Now()
+
+	acc.HeldBalance += amount
+	acc.UpdatedAt = now
+
+	return &Hold{
+		AccountNumber: acc.Number,
+		Amount:        amount,
+		Description:   description,
+		Status:        HoldStatusActive,
+		ExpiresAt:     now.Add(ttl),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// ReleaseHold returns the amount of hold to the available balance, status tells whether it
+// was released on request or expired.
+func (acc *Account) ReleaseHold(hold *Hold, status HoldStatus) error {
+	if hold.Status != HoldStatusActive {
+		return ErrHoldNotActive
+	}
+
+	now := time.Now()
+
+	acc.HeldBalance -= hold.Amount
+	acc.UpdatedAt = now
+
+	hold.Status = status
+	hold.UpdatedAt = now
+
+	return nil
+}
+
+// CaptureHold withdraws value of the held amount out of the bank. A zero value captures the
+// whole hold and what is not captured returns to the available balance.
+func (acc *Account) CaptureHold(hold *Hold, value int64) (int64, error) {
+	captured, err := acc.captureHold(hold, value)
+	if err != nil {
+		return 0, err
+	}
+
+	err = acc.Withdraw(acc.Money(captured))
+	if err != nil {
+		return 0, err
+	}
+
+	return captured, nil
+}
+
+// CaptureHoldForTransfer captures value of the held amount without moving it, the whole hold
+// returns to the available balance and the caller transfers the captured value through the
+// same checks as any other transfer.
+func (acc *Account) CaptureHoldForTransfer(hold *Hold, value int64) (int64, error) {
+	return acc.captureHold(hold, value)
+}
+
+func (acc *Account) captureHold(hold *Hold, value int64) (int64, error) {
+	if hold.Status != HoldStatusActive || hold.Expired(time.Now()) {
+		return 0, ErrHoldNotActive
+	}
+
+	if value == 0 {
+		value = hold.Amount
+	}
+
+	if value < 0 {
+		return 0, errors.New("invalid value, should be greater than zero")
+	}
+
+	if value > hold.Amount {
+		return 0, ErrCaptureExceedsHold
+	}
+
+	err := acc.EnsureActive()
+	if err != nil {
+		return 0, err
+	}
+
+	err = acc.ReleaseHold(hold, HoldStatusCaptured)
+	if err != nil {
+		return 0, err
+	}
+
+	hold.CapturedAmount = value
+
+	return value, nil
+}
+
+// CaptureIdempotencyKey is the key of the transfer of the captured value, a hold being captured
+// only once.
+func (h *Hold) CaptureIdempotencyKey() string {
+	return fmt.Sprintf("hold-capture-%v", h.Id)
+}
+
+// Expired reports whether the hold is still active past its expiry, waiting to be released.
+func (h *Hold) Expired(now time.Time) bool {
+	return h.Status == HoldStatusActive && !now.Before(h.ExpiresAt)
+}
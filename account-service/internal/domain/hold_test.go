@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaceHold(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+
+	// act
+	hold, err := acc.PlaceHold(400, "card authorization", time.Hour)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(400), hold.Amount)
+	assert.Equal(t, HoldStatusActive, hold.Status)
+	assert.Equal(t, acc.Number, hold.AccountNumber)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), hold.ExpiresAt, time.Second)
+	assert.Equal(t, int64(1000), acc.Balance)
+	assert.Equal(t, int64(400), acc.HeldBalance)
+	assert.Equal(t, int64(600), acc.AvailableBalance())
+}
+
+func TestPlaceHold_Errors(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		status        AccountStatus
+		amount        int64
+		expectedError string
+	}{
+		{testName: "invalid amount", status: AccountStatusActive, amount: 0, expectedError: "for a hold the amount must be greater than zero"},
+		{testName: "above available balance", status: AccountStatusActive, amount: 701, expectedError: ErrInsufficientFunds.Error()},
+		{testName: "blocked account", status: AccountStatusBlocked, amount: 100, expectedError: ErrAccountNotActive.Error()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			acc := NewAccount("19", "01234567890", "John Doe")
+			acc.Balance = 1000
+			acc.HeldBalance = 300
+			acc.Status = tc.status
+
+			hold, err := acc.PlaceHold(tc.amount, "", time.Hour)
+
+			assert.Nil(t, hold)
+			assert.EqualError(t, err, tc.expectedError)
+			assert.Equal(t, int64(300), acc.HeldBalance)
+		})
+	}
+}
+
+func TestWithdraw_ChecksHeldBalance(t *testing.T) {
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.HeldBalance = 800
+
+	err := acc.Withdraw(acc.Money(300))
+
+	assert.Equal(t, ErrInsufficientFunds, err)
+	assert.NoError(t, acc.Withdraw(acc.Money(200)))
+}
+
+func TestReleaseHold(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+
+	// act
+	err := acc.ReleaseHold(hold, HoldStatusExpired)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, HoldStatusExpired, hold.Status)
+	assert.Equal(t, int64(0), acc.HeldBalance)
+	assert.Equal(t, ErrHoldNotActive, acc.ReleaseHold(hold, HoldStatusReleased))
+}
+
+func TestCaptureHold_Withdraw(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+
+	// act
+	captured, err := acc.CaptureHold(hold, 250)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(250), captured)
+	assert.Equal(t, int64(250), hold.CapturedAmount)
+	assert.Equal(t, HoldStatusCaptured, hold.Status)
+	assert.Equal(t, int64(750), acc.Balance)
+	assert.Equal(t, int64(0), acc.HeldBalance)
+}
+
+func TestCaptureHoldForTransfer(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+	hold.Id = "7"
+
+	// act
+	captured, err := acc.CaptureHoldForTransfer(hold, 0)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(400), captured)
+	assert.Equal(t, HoldStatusCaptured, hold.Status)
+	assert.Equal(t, int64(1000), acc.Balance)
+	assert.Equal(t, int64(0), acc.HeldBalance)
+	assert.Equal(t, "hold-capture-7", hold.CaptureIdempotencyKey())
+}
+
+func TestCaptureHold_Errors(t *testing.T) {
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+	_, err := acc.CaptureHold(hold, 401)
+	assert.Equal(t, ErrCaptureExceedsHold, err)
+
+	expiredHold, _ := acc.PlaceHold(100, "", -time.Minute)
+	_, err = acc.CaptureHold(expiredHold, 0)
+	assert.Equal(t, ErrHoldNotActive, err)
+
+	assert.Equal(t, int64(500), acc.HeldBalance)
+	assert.Equal(t, int64(1000), acc.Balance)
+}
+
+func TestChangeStatus_CloseWithHolds(t *testing.T) {
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.OverdraftLimit = 100
+	acc.PlaceHold(100, "", time.Hour)
+
+	err := acc.ChangeStatus(AccountStatusClosed)
+
+	assert.Equal(t, ErrCloseAccountWithHolds, err)
+}
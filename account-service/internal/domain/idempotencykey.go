@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// systemIdempotencyKeyPrefix marks the keys derived by the service for the operations it runs on
+// its own, like scheduled transfers. Clients cannot send keys with it, so theirs never collide.
+const systemIdempotencyKeyPrefix = "system:"
+
+// MaxIdempotencyKeyLength is the longest key a client can send, the size of the stored key.
+const MaxIdempotencyKeyLength = 40
+
+var (
+	ErrIdempotencyKeyReused   = errors.New("idempotency key already used with a different request")
+	ErrReservedIdempotencyKey = errors.New("idempotency key must not start with " + systemIdempotencyKeyPrefix)
+	ErrIdempotencyKeyTooLong  = fmt.Errorf("idempotency key must have at most %v characters", MaxIdempotencyKeyLength)
+)
+
+type IdempotencyKey struct {
+	Key         string
+	Scope       string
+	Subject     string
+	Operation   string
+	RequestHash string
+	StatusCode  int
+	Response    string
+	CreatedAt   time.Time
+}
+
+// NewIdempotencyKey fingerprints the request so a retry can be told apart from a
+// different request reusing the same key. Scope is the account the operation acts on and
+// subject the client that sent it, so clients of the same account never share their keys.
+func NewIdempotencyKey(scope, subject, key, operation string, request any) (*IdempotencyKey, error) {
+	if key == "" {
+		return nil, errors.New("idempotency key is required")
+	}
+
+	if len(key) > MaxIdempotencyKeyLength {
+		return nil, ErrIdempotencyKeyTooLong
+	}
+
+	if strings.HasPrefix(key, systemIdempotencyKeyPrefix) {
+		return nil, ErrReservedIdempotencyKey
+	}
+
+	idempotencyKey, err := newIdempotencyKey(scope, key, operation, request)
+	if err != nil {
+		return nil, err
+	}
+
+	idempotencyKey.Subject = subject
+
+	return idempotencyKey, nil
+}
+
+// NewSystemIdempotencyKey is the key of an operation run by the service itself, key is
+// prefixed so it cannot be taken by a client beforehand.
+func NewSystemIdempotencyKey(scope, key, operation string, request any) (*IdempotencyKey, error) {
+	return newIdempotencyKey(scope, systemIdempotencyKeyPrefix+key, operation, request)
+}
+
+func newIdempotencyKey(scope, key, operation string, request any) (*IdempotencyKey, error) {
+	requestSerialized, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(requestSerialized)
+
+	return &IdempotencyKey{
+		Key:         key,
+		Scope:       scope,
+		Operation:   operation,
+		RequestHash: hex.EncodeToString(hash[:]),
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+func (k *IdempotencyKey) Matches(other *IdempotencyKey) bool {
+	return k.Scope == other.Scope && k.Subject == other.Subject && k.Operation == other.Operation && k.RequestHash == other.RequestHash
+}
+
+func (k *IdempotencyKey) SetResponse(statusCode int, response any) error {
+	k.StatusCode = statusCode
+
+	if response == nil {
+		k.Response = ""
+		return nil
+	}
+
+	responseSerialized, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	k.Response = string(responseSerialized)
+
+	return nil
+}
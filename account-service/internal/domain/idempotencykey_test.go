@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIdempotencyKey(t *testing.T) {
+	// act
+	key, err := NewIdempotencyKey("123", "bob", "key-1", "deposit", map[string]int64{"value": 10})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "123", key.Scope)
+	assert.Equal(t, "bob", key.Subject)
+	assert.Equal(t, "key-1", key.Key)
+	assert.Equal(t, "deposit", key.Operation)
+	assert.Len(t, key.RequestHash, 64)
+}
+
+func TestNewIdempotencyKey_EmptyKey(t *testing.T) {
+	// act
+	key, err := NewIdempotencyKey("123", "bob", "", "deposit", map[string]int64{"value": 10})
+
+	// assert
+	assert.Error(t, err)
+	assert.Nil(t, key)
+}
+
+func TestNewIdempotencyKey_ReservedPrefix(t *testing.T) {
+	// act
+	key, err := NewIdempotencyKey("123", "bob", "system:scheduled-1-1", "transfer", map[string]int64{"value": 10})
+
+	// assert
+	assert.Equal(t, ErrReservedIdempotencyKey, err)
+	assert.Nil(t, key)
+}
+
+func TestNewIdempotencyKey_TooLong(t *testing.T) {
+	// act
+	key, err := NewIdempotencyKey("123", "bob", strings.Repeat("k", MaxIdempotencyKeyLength+1), "deposit", map[string]int64{"value": 10})
+
+	// assert
+	assert.Equal(t, ErrIdempotencyKeyTooLong, err)
+	assert.Nil(t, key)
+}
+
+func TestNewSystemIdempotencyKey(t *testing.T) {
+	// act
+	key, err := NewSystemIdempotencyKey("123", "scheduled-1-1", "transfer", map[string]int64{"value": 10})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "system:scheduled-1-1", key.Key)
+	assert.Equal(t, "123", key.Scope)
+}
+
+func TestIdempotencyKeyMatches(t *testing.T) {
+	testCases := []struct {
+		testName  string
+		scope     string
+		subject   string
+		operation string
+		request   any
+		expected  bool
+	}{
+		{"same request", "123", "bob", "deposit", map[string]int64{"value": 10}, true},
+		{"different value", "123", "bob", "deposit", map[string]int64{"value": 20}, false},
+		{"different operation", "123", "bob", "transfer", map[string]int64{"value": 10}, false},
+		{"different scope", "456", "bob", "deposit", map[string]int64{"value": 10}, false},
+		{"different subject", "123", "carol", "deposit", map[string]int64{"value": 10}, false},
+	}
+
+	recorded, _ := NewIdempotencyKey("123", "bob", "key-1", "deposit", map[string]int64{"value": 10})
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// arrange
+			key, _ := NewIdempotencyKey(tc.scope, tc.subject, "key-1", tc.operation, tc.request)
+
+			// act
+			matches := recorded.Matches(key)
+
+			// assert
+			assert.Equal(t, tc.expected, matches)
+		})
+	}
+}
+
+func TestIdempotencyKeySetResponse(t *testing.T) {
+	// arrange
+	key, _ := NewIdempotencyKey("123", "bob", "key-1", "deposit", map[string]int64{"value": 10})
+
+	// act
+	err := key.SetResponse(200, map[string]string{"number": "1"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 200, key.StatusCode)
+	assert.Equal(t, `{"number":"1"}`, key.Response)
+}
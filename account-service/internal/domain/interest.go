@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const daysInYear = 365
+
+var ErrInvalidInterestRate = errors.New("invalid interest rate")
+
+// InterestRate is the annual rate paid to savings accounts, compounded daily.
+type InterestRate struct {
+	daily *big.Rat
+}
+
+// NewInterestRate parses an annual rate like "0.065" for 6.5% a year.
+func NewInterestRate(annualRate string) (*InterestRate, error) {
+	annual, ok := new(big.Rat).SetString(annualRate)
+	if !ok || annual.Sign() < 0 {
+		return nil, fmt.Errorf("%w %v", ErrInvalidInterestRate, annualRate)
+	}
+
+	return &InterestRate{
+		daily: annual.Quo(annual, big.NewRat(daysInYear, 1)),
+	}, nil
+}
+
+// DailyInterest returns the interest of one day over amount, rounded half to even to the
+// minor unit so rounding does not favour the bank nor the customer over time.
+func (r *InterestRate) DailyInterest(amount int64) int64 {
+	interest := new(big.Rat).Mul(big.NewRat(amount, 1), r.daily)
+
+	return roundHalfEven(interest)
+}
+
+func roundHalfEven(value *big.Rat) int64 {
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+
+	doubled := remainder.Abs(remainder).Lsh(remainder, 1)
+	switch doubled.Cmp(value.Denom()) {
+	case 1:
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	case 0:
+		if quotient.Bit(0) == 1 {
+			quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+		}
+	}
+
+	return quotient.Int64()
+}
+
+// InterestAccruedThrough is the last day whose interest was accrued, the creation day for an
+// account that never accrued.
+func (acc *Account) InterestAccruedThrough() time.Time {
+	if acc.InterestAccruedOn.IsZero() {
+		return truncateToDay(acc.CreatedAt)
+	}
+
+	return truncateToDay(acc.InterestAccruedOn)
+}
+
+// AccrueInterest accrues the interest of day over the balance plus the interest not credited
+// yet, compounding it daily, overdrawn balances earn nothing. On the last day of a month the
+// accrued interest is credited to the balance and returned, otherwise the returned amount is zero.
+func (acc *Account) AccrueInterest(rate *InterestRate, day time.Time) (Money, error) {
+	if acc.Type != AccountTypeSavings {
+		return Money{}, ErrNotSavingsAccount
+	}
+
+	base := acc.Balance + acc.AccruedInterest
+	if base > 0 {
+		acc.AccruedInterest += rate.DailyInterest(base)
+	}
+
+	acc.InterestAccruedOn = truncateToDay(day)
+	acc.UpdatedAt = time.Now()
+
+	if day.AddDate(0, 0, 1).Month() == day.Month() {
+		return acc.Money(0), nil
+	}
+
+	return acc.CreditAccruedInterest()
+}
+
+// CreditAccruedInterest credits the interest accrued and not credited yet to the balance and
+// returns it, the returned amount is zero when there is none.
+func (acc *Account) CreditAccruedInterest() (Money, error) {
+	if acc.AccruedInterest == 0 {
+		return acc.Money(0), nil
+	}
+
+	credited := acc.Money(acc.AccruedInterest)
+
+	err := acc.Deposit(credited)
+	if err != nil {
+		return Money{}, err
+	}
+
+	acc.AccruedInterest = 0
+
+	return credited, nil
+}
+
+func truncateToDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInterestRate_Invalid(t *testing.T) {
+	_, err := NewInterestRate("abc")
+	assert.ErrorIs(t, err, ErrInvalidInterestRate)
+
+	_, err = NewInterestRate("-0.01")
+	assert.ErrorIs(t, err, ErrInvalidInterestRate)
+}
+
+func TestInterestRate_DailyInterest(t *testing.T) {
+	// 0.1% a day
+	rate, err := NewInterestRate("0.365")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		testName string
+		amount   int64
+		expected int64
+	}{
+		{"exact", 10000, 10},
+		{"rounded down", 1400, 1},
+		{"rounded up", 1600, 2},
+		{"half rounded to even down", 2500, 2},
+		{"half rounded to even up", 3500, 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, rate.DailyInterest(tc.amount))
+		})
+	}
+}
+
+func TestAccrueInterest_CompoundsDaily(t *testing.T) {
+	rate, _ := NewInterestRate("0.365")
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Type = AccountTypeSavings
+	acc.Balance = 100000
+
+	credited, err := acc.AccrueInterest(rate, time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), credited.Amount)
+
+	credited, err = acc.AccrueInterest(rate, time.Date(2024, 5, 11, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), credited.Amount)
+
+	assert.Equal(t, int64(200), acc.AccruedInterest)
+	assert.Equal(t, int64(100000), acc.Balance)
+	assert.Equal(t, time.Date(2024, 5, 11, 0, 0, 0, 0, time.UTC), acc.InterestAccruedOn)
+}
+
+func TestAccrueInterest_CreditsAtMonthEnd(t *testing.T) {
+	rate, _ := NewInterestRate("0.365")
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Type = AccountTypeSavings
+	acc.Balance = 100000
+	acc.AccruedInterest = 900
+
+	credited, err := acc.AccrueInterest(rate, time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC))
+
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(1001, "BRL"), credited)
+	assert.Equal(t, int64(101001), acc.Balance)
+	assert.Equal(t, int64(0), acc.AccruedInterest)
+}
+
+func TestAccrueInterest_OverdrawnBalance(t *testing.T) {
+	rate, _ := NewInterestRate("0.365")
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Type = AccountTypeSavings
+	acc.Balance = -5000
+
+	_, err := acc.AccrueInterest(rate, time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), acc.AccruedInterest)
+}
+
+func TestAccrueInterest_CheckingAccount(t *testing.T) {
+	rate, _ := NewInterestRate("0.365")
+	acc := NewAccount("19", "01234567890", "John Doe")
+
+	_, err := acc.AccrueInterest(rate, time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, ErrNotSavingsAccount, err)
+}
+
+func TestChangeStatus_CloseWithAccruedInterest(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Type = AccountTypeSavings
+	acc.AccruedInterest = 3
+
+	// act
+	err := acc.ChangeStatus(AccountStatusClosed)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, AccountStatusClosed, acc.Status)
+	assert.Equal(t, int64(3), acc.AccruedInterest)
+}
+
+func TestCreditAccruedInterest(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Type = AccountTypeSavings
+	acc.Balance = 100
+	acc.AccruedInterest = 3
+
+	// act
+	credited, err := acc.CreditAccruedInterest()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, acc.Money(3), credited)
+	assert.Equal(t, int64(103), acc.Balance)
+	assert.Equal(t, int64(0), acc.AccruedInterest)
+}
+
+func TestChangeStatus_CloseAfterInterestCredited(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Type = AccountTypeSavings
+	acc.AccruedInterest = 3
+	rate, _ := NewInterestRate("0")
+
+	credited, _ := acc.AccrueInterest(rate, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	_ = acc.Withdraw(credited)
+
+	// act
+	err := acc.ChangeStatus(AccountStatusClosed)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, AccountStatusClosed, acc.Status)
+}
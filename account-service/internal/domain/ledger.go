@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ExternalLedgerAccount is the counterparty of money entering or leaving the bank, so
+// deposits and withdrawals are recorded as balanced entries like transfers.
+const ExternalLedgerAccount = "external"
+
+// ExchangeLedgerAccount is the counterparty of transfers between currencies, buying the value
+// sent in one currency and selling the value received in the other.
+const ExchangeLedgerAccount = "exchange"
+
+const (
+	DepositLedgerTransaction  = "deposit"
+	WithdrawLedgerTransaction = "withdraw"
+	TransferLedgerTransaction = "transfer"
+	InterestLedgerTransaction = "interest"
+	FeeLedgerTransaction      = "fee"
+
+	TransferReversalLedgerTransaction = "transfer_reversal"
+)
+
+var (
+	ErrUnbalancedLedgerTransaction = errors.New("ledger transaction entries must sum to zero")
+	ErrTransferAlreadyReversed     = errors.New("transfer already reversed")
+	ErrReversalExceedsTransfer     = errors.New("reversal value exceeds the transfer value not reversed yet")
+	ErrConvertedTransferReversal   = errors.New("transfers between currencies cannot be reversed")
+)
+
+// LedgerEntry is a movement of an account in a ledger transaction. Credits are positive
+// and debits negative, so an account balance is the sum of its entries.
+type LedgerEntry struct {
+	Id            int64
+	TransactionId string
+	AccountNumber string
+	Amount        int64
+	Currency      Currency
+	CreatedAt     time.Time
+}
+
+// LedgerTransaction groups the entries of an operation. A reversal references the transfer
+// it compensates through ReversedTransactionId and a fee the operation it was charged on
+// through OriginTransactionId.
+type LedgerTransaction struct {
+	Id                    string
+	Type                  string
+	ReversedTransactionId string
+	OriginTransactionId   string
+	Entries               []*LedgerEntry
+	CreatedAt             time.Time
+}
+
+type LedgerBalanceMismatch struct {
+	AccountNumber string
+	Balance       int64
+	LedgerBalance int64
+}
+
+type LedgerVerification struct {
+	EntriesTotal           int64
+	UnbalancedTransactions []string
+	BalanceMismatches      []LedgerBalanceMismatch
+	VerifiedAt             time.Time
+}
+
+func NewLedgerTransaction(transactionType string) *LedgerTransaction {
+	return &LedgerTransaction{
+		Type:      transactionType,
+		Entries:   []*LedgerEntry{},
+		CreatedAt: time.Now(),
+	}
+}
+
+func NewDepositLedgerTransaction(number string, value Money) *LedgerTransaction {
+	transaction := NewLedgerTransaction(DepositLedgerTransaction)
+	transaction.AddEntry(ExternalLedgerAccount, value.Negate())
+	transaction.AddEntry(number, value)
+
+	return transaction
+}
+
+// NewInterestLedgerTransaction credits interest paid by the bank to a savings account.
+func NewInterestLedgerTransaction(number string, value Money) *LedgerTransaction {
+	transaction := NewLedgerTransaction(InterestLedgerTransaction)
+	transaction.AddEntry(ExternalLedgerAccount, value.Negate())
+	transaction.AddEntry(number, value)
+
+	return transaction
+}
+
+// NewFeeLedgerTransaction debits a fee from the account, linked to the transaction of the
+// operation charged when there is one.
+func NewFeeLedgerTransaction(number string, fee Money, originTransactionId string) *LedgerTransaction {
+	transaction := NewLedgerTransaction(FeeLedgerTransaction)
+	transaction.OriginTransactionId = originTransactionId
+	transaction.AddEntry(number, fee.Negate())
+	transaction.AddEntry(ExternalLedgerAccount, fee)
+
+	return transaction
+}
+
+func NewWithdrawLedgerTransaction(number string, value Money) *LedgerTransaction {
+	transaction := NewLedgerTransaction(WithdrawLedgerTransaction)
+	transaction.AddEntry(number, value.Negate())
+	transaction.AddEntry(ExternalLedgerAccount, value)
+
+	return transaction
+}
+
+func NewTransferLedgerTransaction(fromNumber string, toNumber string, value Money) *LedgerTransaction {
+	transaction := NewLedgerTransaction(TransferLedgerTransaction)
+	transaction.AddEntry(fromNumber, value.Negate())
+	transaction.AddEntry(toNumber, value)
+
+	return transaction
+}
+
+// NewConvertedTransferLedgerTransaction records a transfer between accounts of different
+// currencies through the exchange account, so the entries of each currency still sum to zero.
+func NewConvertedTransferLedgerTransaction(fromNumber string, toNumber string, value Money, received Money) *LedgerTransaction {
+	if value.Currency == received.Currency {
+		return NewTransferLedgerTransaction(fromNumber, toNumber, value)
+	}
+
+	transaction := NewLedgerTransaction(TransferLedgerTransaction)
+	transaction.AddEntry(fromNumber, value.Negate())
+	transaction.AddEntry(ExchangeLedgerAccount, value)
+	transaction.AddEntry(ExchangeLedgerAccount, received.Negate())
+	transaction.AddEntry(toNumber, received)
+
+	return transaction
+}
+
+// NewTransferReversalLedgerTransaction moves value back from the receiver to the sender of
+// transfer, a zero value reverses what was not reversed yet. reversedValue is the sum of the
+// previous reversals of the transfer, which together must not exceed its value.
+func NewTransferReversalLedgerTransaction(transfer *LedgerTransaction, value int64, reversedValue int64) (*LedgerTransaction, error) {
+	fromNumber, toNumber, transferValue, err := transfer.TransferParties()
+	if err != nil {
+		return nil, err
+	}
+
+	if reversedValue >= transferValue.Amount {
+		return nil, ErrTransferAlreadyReversed
+	}
+
+	if value == 0 {
+		value = transferValue.Amount - reversedValue
+	}
+
+	if value < 0 {
+		return nil, errors.New("invalid value, should be greater than zero")
+	}
+
+	if reversedValue+value > transferValue.Amount {
+		return nil, ErrReversalExceedsTransfer
+	}
+
+	reversalValue := NewMoney(value, transferValue.Currency)
+
+	transaction := NewLedgerTransaction(TransferReversalLedgerTransaction)
+	transaction.ReversedTransactionId = transfer.Id
+	transaction.AddEntry(toNumber, reversalValue.Negate())
+	transaction.AddEntry(fromNumber, reversalValue)
+
+	return transaction, nil
+}
+
+// TransferParties returns the sender, the receiver and the value of a transfer transaction
+// between accounts of the same currency.
+func (t *LedgerTransaction) TransferParties() (fromNumber string, toNumber string, value Money, err error) {
+	if t.Type != TransferLedgerTransaction {
+		return "", "", Money{}, errors.New("transaction is not a transfer")
+	}
+
+	if len(t.Entries) != 2 {
+		return "", "", Money{}, ErrConvertedTransferReversal
+	}
+
+	for _, entry := range t.Entries {
+		if entry.Amount < 0 {
+			fromNumber = entry.AccountNumber
+		} else {
+			toNumber = entry.AccountNumber
+			value = NewMoney(entry.Amount, entry.Currency)
+		}
+	}
+
+	return fromNumber, toNumber, value, nil
+}
+
+// ReversalValue returns the value a reversal transaction moved back to the sender.
+func (t *LedgerTransaction) ReversalValue() Money {
+	for _, entry := range t.Entries {
+		if entry.Amount > 0 {
+			return NewMoney(entry.Amount, entry.Currency)
+		}
+	}
+
+	return Money{}
+}
+
+func (t *LedgerTransaction) AddEntry(accountNumber string, amount Money) {
+	t.Entries = append(t.Entries, &LedgerEntry{
+		AccountNumber: accountNumber,
+		Amount:        amount.Amount,
+		Currency:      amount.Currency,
+		CreatedAt:     t.CreatedAt,
+	})
+}
+
+func (t *LedgerTransaction) Validate() error {
+	if len(t.Entries) < 2 {
+		return errors.New("ledger transaction must have at least two entries")
+	}
+
+	totals := map[Currency]int64{}
+	for _, entry := range t.Entries {
+		if entry.Amount == 0 {
+			return errors.New("ledger entry amount must not be zero")
+		}
+
+		totals[entry.Currency] += entry.Amount
+	}
+
+	for _, total := range totals {
+		if total != 0 {
+			return ErrUnbalancedLedgerTransaction
+		}
+	}
+
+	return nil
+}
+
+// IsBalanced reports whether the ledger sums to zero, every transaction is balanced and
+// every account balance matches its entries.
+func (v *LedgerVerification) IsBalanced() bool {
+	return v.EntriesTotal == 0 && len(v.UnbalancedTransactions) == 0 && len(v.BalanceMismatches) == 0
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTransferLedgerTransaction(t *testing.T) {
+	// act
+	transaction := NewTransferLedgerTransaction("1", "2", NewMoney(100, DefaultCurrency))
+
+	// assert
+	assert.Equal(t, TransferLedgerTransaction, transaction.Type)
+	assert.Len(t, transaction.Entries, 2)
+	assert.Equal(t, int64(-100), transaction.Entries[0].Amount)
+	assert.Equal(t, int64(100), transaction.Entries[1].Amount)
+	assert.Nil(t, transaction.Validate())
+}
+
+func TestNewConvertedTransferLedgerTransaction(t *testing.T) {
+	// act
+	transaction := NewConvertedTransferLedgerTransaction("1", "2", NewMoney(100, "USD"), NewMoney(525, "BRL"))
+
+	// assert
+	assert.Len(t, transaction.Entries, 4)
+	assert.Equal(t, ExchangeLedgerAccount, transaction.Entries[1].AccountNumber)
+	assert.Equal(t, Currency("BRL"), transaction.Entries[3].Currency)
+	assert.Equal(t, int64(525), transaction.Entries[3].Amount)
+	assert.Nil(t, transaction.Validate())
+
+	_, err := NewTransferReversalLedgerTransaction(transaction, 0, 0)
+	assert.Equal(t, ErrConvertedTransferReversal, err)
+}
+
+func TestLedgerTransactionValidate(t *testing.T) {
+	testCases := []struct {
+		testName string
+		amounts  []int64
+		valid    bool
+	}{
+		{"balanced", []int64{-50, 20, 30}, true},
+		{"unbalanced", []int64{-50, 40}, false},
+		{"single entry", []int64{100}, false},
+		{"zero amount", []int64{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// arrange
+			transaction := NewLedgerTransaction(TransferLedgerTransaction)
+			for i, amount := range tc.amounts {
+				transaction.AddEntry(string(rune('1'+i)), NewMoney(amount, DefaultCurrency))
+			}
+
+			// act
+			err := transaction.Validate()
+
+			// assert
+			assert.Equal(t, tc.valid, err == nil)
+		})
+	}
+}
+
+func TestLedgerVerificationIsBalanced(t *testing.T) {
+	// arrange
+	verification := LedgerVerification{EntriesTotal: 10}
+
+	// act & assert
+	assert.False(t, verification.IsBalanced())
+}
+
+func TestNewTransferReversalLedgerTransaction(t *testing.T) {
+	transfer := NewTransferLedgerTransaction("1", "2", NewMoney(100, DefaultCurrency))
+	transfer.Id = "10"
+
+	testCases := []struct {
+		name          string
+		value         int64
+		reversedValue int64
+		expectedValue int64
+		err           error
+	}{
+		{name: "full reversal", value: 0, reversedValue: 0, expectedValue: 100},
+		{name: "partial reversal", value: 40, reversedValue: 0, expectedValue: 40},
+		{name: "remaining after partial reversal", value: 0, reversedValue: 40, expectedValue: 60},
+		{name: "exceeds remaining value", value: 70, reversedValue: 40, err: ErrReversalExceedsTransfer},
+		{name: "already reversed", value: 0, reversedValue: 100, err: ErrTransferAlreadyReversed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// act
+			reversal, err := NewTransferReversalLedgerTransaction(transfer, tc.value, tc.reversedValue)
+
+			// assert
+			assert.Equal(t, tc.err, err)
+			if tc.err != nil {
+				assert.Nil(t, reversal)
+				return
+			}
+
+			assert.NoError(t, reversal.Validate())
+			assert.Equal(t, TransferReversalLedgerTransaction, reversal.Type)
+			assert.Equal(t, "10", reversal.ReversedTransactionId)
+			assert.Equal(t, "2", reversal.Entries[0].AccountNumber)
+			assert.Equal(t, -tc.expectedValue, reversal.Entries[0].Amount)
+			assert.Equal(t, "1", reversal.Entries[1].AccountNumber)
+			assert.Equal(t, NewMoney(tc.expectedValue, DefaultCurrency), reversal.ReversalValue())
+		})
+	}
+}
+
+func TestNewTransferReversalLedgerTransaction_NotATransfer(t *testing.T) {
+	// arrange
+	deposit := NewDepositLedgerTransaction("1", NewMoney(100, DefaultCurrency))
+
+	// act
+	reversal, err := NewTransferReversalLedgerTransaction(deposit, 0, 0)
+
+	// assert
+	assert.Error(t, err)
+	assert.Nil(t, reversal)
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+const DefaultCurrency Currency = "BRL"
+
+var (
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrCurrencyMismatch    = errors.New("value currency differs from the account currency")
+)
+
+// currencyMinorUnits are the currencies accounts can hold, with the number of digits of their
+// minor unit as defined by ISO 4217.
+var currencyMinorUnits = map[Currency]int{
+	"BRL": 2,
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"ARS": 2,
+	"CLP": 0,
+	"JPY": 0,
+}
+
+func (c Currency) Validate() error {
+	if _, ok := currencyMinorUnits[c]; !ok {
+		return fmt.Errorf("%w %v", ErrUnsupportedCurrency, c)
+	}
+
+	return nil
+}
+
+func (c Currency) MinorUnits() int {
+	return currencyMinorUnits[c]
+}
+
+// NormalizeCurrency upper cases the code, an empty code is the default currency.
+func NormalizeCurrency(currency string) Currency {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return DefaultCurrency
+	}
+
+	return Currency(currency)
+}
+
+// Money is an amount in the minor units of its currency, like cents. An empty currency stands
+// for the currency of the account the money moves in.
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+// OrCurrency returns the money in currency when it has none.
+func (m Money) OrCurrency(currency Currency) Money {
+	if m.Currency == "" {
+		m.Currency = currency
+	}
+
+	return m
+}
+
+func (m Money) Negate() Money {
+	m.Amount = -m.Amount
+	return m
+}
+
+func (m Money) String() string {
+	digits := m.Currency.MinorUnits()
+	if digits == 0 {
+		return fmt.Sprintf("%v %d", m.Currency, m.Amount)
+	}
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	scale := int64(1)
+	for range digits {
+		scale *= 10
+	}
+
+	return fmt.Sprintf("%v %v%d.%0*d", m.Currency, sign, amount/scale, digits, amount%scale)
+}
+
+// UnmarshalJSON accepts a bare amount, as values were sent before accounts had a currency,
+// besides the amount and currency object.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var amount int64
+	if json.Unmarshal(data, &amount) == nil {
+		*m = Money{Amount: amount}
+		return nil
+	}
+
+	type money Money
+	var value money
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	*m = Money(value)
+	m.Currency = Currency(strings.ToUpper(string(m.Currency)))
+
+	return nil
+}
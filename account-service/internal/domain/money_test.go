@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrency_Validate(t *testing.T) {
+	assert.NoError(t, Currency("USD").Validate())
+	assert.ErrorIs(t, Currency("XYZ").Validate(), ErrUnsupportedCurrency)
+	assert.ErrorIs(t, Currency("").Validate(), ErrUnsupportedCurrency)
+}
+
+func TestNormalizeCurrency(t *testing.T) {
+	assert.Equal(t, Currency("USD"), NormalizeCurrency(" usd "))
+	assert.Equal(t, DefaultCurrency, NormalizeCurrency(""))
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "BRL 12.05", NewMoney(1205, "BRL").String())
+	assert.Equal(t, "USD -0.50", NewMoney(-50, "USD").String())
+	assert.Equal(t, "JPY 1500", NewMoney(1500, "JPY").String())
+}
+
+func TestMoney_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		testName string
+		data     string
+		expected Money
+	}{
+		{"object", `{"amount":150,"currency":"usd"}`, NewMoney(150, "USD")},
+		{"bare amount", `150`, Money{Amount: 150}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var money Money
+			err := json.Unmarshal([]byte(tc.data), &money)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, money)
+		})
+	}
+}
+
+func TestMoney_OrCurrency(t *testing.T) {
+	assert.Equal(t, NewMoney(10, "BRL"), Money{Amount: 10}.OrCurrency("BRL"))
+	assert.Equal(t, NewMoney(10, "USD"), NewMoney(10, "USD").OrCurrency("BRL"))
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage is an event waiting to be published. EventId is sent along with the event so
+// consumers can drop the copies delivered again after a failure.
+type OutboxMessage struct {
+	Id             string
+	EventId        string
+	Topic          string
+	Type           string
+	Data           string
+	Attempts       int
+	LastError      string
+	CreatedAt      time.Time
+	NextAttemptAt  time.Time
+	SentAt         *time.Time
+	DeadLetteredAt *time.Time
+}
+
+func NewOutboxMessage(topic, eventType, data string) *OutboxMessage {
+	now := time.Now()
+
+	return &OutboxMessage{
+		EventId:       uuid.New().String(),
+		Topic:         topic,
+		Type:          eventType,
+		Data:          data,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+}
+
+func (m *OutboxMessage) SetAsSent() {
+	now := time.Now()
+
+	m.SentAt = &now
+	m.LastError = ""
+}
+
+// SetAsFailed retries the message after retryDelay, doubled on every attempt, until
+// maxAttempts is reached. Then the message is dead-lettered and not published anymore.
+func (m *OutboxMessage) SetAsFailed(err error, now time.Time, maxAttempts int, retryDelay time.Duration) {
+	m.Attempts++
+	m.LastError = err.Error()
+
+	if m.Attempts >= maxAttempts {
+		m.DeadLetteredAt = &now
+		return
+	}
+
+	m.NextAttemptAt = now.Add(retryDelay * time.Duration(1<<(m.Attempts-1)))
+}
+
+func (m *OutboxMessage) DeadLettered() bool {
+	return m.DeadLetteredAt != nil
+}
+
+func (m *OutboxMessage) DueForAttempt(now time.Time) bool {
+	return !m.NextAttemptAt.After(now)
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxMessage_SetAsFailed_RetriesWithBackoff(t *testing.T) {
+	// arrange
+	message := NewOutboxMessage("account", "FundsDeposited", "{}")
+	now := time.Date(2024, 5, 10, 10, 0, 0, 0, time.UTC)
+
+	// act
+	message.SetAsFailed(errors.New("broker unavailable"), now, 5, time.Second)
+	message.SetAsFailed(errors.New("broker unavailable"), now, 5, time.Second)
+
+	// assert
+	assert.Equal(t, 2, message.Attempts)
+	assert.Equal(t, "broker unavailable", message.LastError)
+	assert.Equal(t, now.Add(2*time.Second), message.NextAttemptAt)
+	assert.False(t, message.DeadLettered())
+	assert.False(t, message.DueForAttempt(now.Add(time.Second)))
+	assert.True(t, message.DueForAttempt(now.Add(2*time.Second)))
+}
+
+func TestOutboxMessage_SetAsFailed_DeadLettersAfterMaxAttempts(t *testing.T) {
+	// arrange
+	message := NewOutboxMessage("account", "FundsDeposited", "{}")
+	message.Attempts = 2
+	now := time.Date(2024, 5, 10, 10, 0, 0, 0, time.UTC)
+
+	// act
+	message.SetAsFailed(errors.New("broker unavailable"), now, 3, time.Second)
+
+	// assert
+	assert.Equal(t, 3, message.Attempts)
+	assert.True(t, message.DeadLettered())
+	assert.Equal(t, now, *message.DeadLetteredAt)
+}
+
+func TestNewOutboxMessage_HasEventId(t *testing.T) {
+	// act
+	first := NewOutboxMessage("account", "FundsDeposited", "{}")
+	second := NewOutboxMessage("account", "FundsDeposited", "{}")
+
+	// assert
+	assert.NotEmpty(t, first.EventId)
+	assert.NotEqual(t, first.EventId, second.EventId)
+	assert.True(t, first.DueForAttempt(time.Now()))
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const MaximumLengthPayeeNickname = 50
+
+var (
+	ErrPayeeAlreadyRegistered = errors.New("payee already registered for the destination")
+	ErrPayeeRequired          = errors.New("transfers above the limit only go to payees registered before the cooling off period")
+)
+
+// PayeePolicy tells which transfers may only go to a registered payee, the ones above
+// RequiredAbove, and how long a payee waits after registered before receiving them. A zero
+// RequiredAbove disables the policy.
+type PayeePolicy struct {
+	RequiredAbove int64
+	CoolingOff    time.Duration
+}
+
+func (p PayeePolicy) RequiresPayee(value int64) bool {
+	return p.RequiredAbove > 0 && value > p.RequiredAbove
+}
+
+// Payee is a destination saved by an account owner under a nickname, either an account
+// number or a pix key, so transfers can be addressed to it by id.
+type Payee struct {
+	Id            string
+	AccountNumber string
+	Nickname      string
+	ToNumber      string
+	PixKey        string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewPayee validates a payee of acc addressed to toNumber or to pixKey, exactly one of them.
+func NewPayee(acc *Account, nickname string, toNumber string, pixKey string) (*Payee, error) {
+	if (toNumber == "") == (pixKey == "") {
+		return nil, errors.New("payee should have either an account number or a pix key")
+	}
+
+	if toNumber == acc.Number {
+		return nil, errors.New("payee should not be the account itself")
+	}
+
+	payee := &Payee{
+		AccountNumber: acc.Number,
+		ToNumber:      toNumber,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if pixKey != "" {
+		payee.PixKey = NormalizePixKey(pixKey)
+	}
+
+	err := payee.Rename(nickname)
+	if err != nil {
+		return nil, err
+	}
+
+	return payee, nil
+}
+
+// Rename changes the nickname, the destination of a payee never changes so a payee trusted
+// by the policy keeps sending money to the same place.
+func (p *Payee) Rename(nickname string) error {
+	nickname = strings.TrimSpace(nickname)
+	if nickname == "" || len(nickname) > MaximumLengthPayeeNickname {
+		return errors.New("payee nickname should have between 1 and 50 characters")
+	}
+
+	p.Nickname = nickname
+	p.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Matches tells whether the payee is the destination of a transfer, to the pix key when the
+// transfer is addressed to one and to the account number otherwise.
+func (p *Payee) Matches(toNumber string, pixKey string) bool {
+	if pixKey != "" {
+		return p.PixKey == pixKey
+	}
+
+	return p.PixKey == "" && p.ToNumber == toNumber
+}
+
+// Trusted tells whether the payee was registered for longer than the cooling off period.
+func (p *Payee) Trusted(policy PayeePolicy, now time.Time) bool {
+	return !p.CreatedAt.Add(policy.CoolingOff).After(now)
+}
+
+// HasTrustedPayee tells whether any of payees is a trusted destination of the transfer.
+func HasTrustedPayee(payees []*Payee, policy PayeePolicy, toNumber string, pixKey string, now time.Time) bool {
+	for _, payee := range payees {
+		if payee.Matches(toNumber, pixKey) && payee.Trusted(policy, now) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPayee_ToAccountNumber(t *testing.T) {
+	acc := NewAccount("22", "01234567890", "John Doe")
+
+	payee, err := NewPayee(acc, "  Rent ", "35", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "22", payee.AccountNumber)
+	assert.Equal(t, "Rent", payee.Nickname)
+	assert.Equal(t, "35", payee.ToNumber)
+	assert.Empty(t, payee.PixKey)
+}
+
+func TestNewPayee_ToPixKeyIsNormalized(t *testing.T) {
+	acc := NewAccount("22", "01234567890", "John Doe")
+
+	payee, err := NewPayee(acc, "Jane", "", " Jane@Example.com ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jane@example.com", payee.PixKey)
+}
+
+func TestNewPayee_Invalid(t *testing.T) {
+	acc := NewAccount("22", "01234567890", "John Doe")
+
+	testCases := []struct {
+		testName string
+		nickname string
+		toNumber string
+		pixKey   string
+	}{
+		{"without destination", "Rent", "", ""},
+		{"with both destinations", "Rent", "35", "jane@example.com"},
+		{"to the account itself", "Me", "22", ""},
+		{"empty nickname", " ", "35", ""},
+		{"long nickname", strings.Repeat("a", MaximumLengthPayeeNickname+1), "35", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			_, err := NewPayee(acc, tc.nickname, tc.toNumber, tc.pixKey)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPayeePolicy_RequiresPayee(t *testing.T) {
+	assert.False(t, PayeePolicy{}.RequiresPayee(1000000))
+	assert.False(t, PayeePolicy{RequiredAbove: 1000}.RequiresPayee(1000))
+	assert.True(t, PayeePolicy{RequiredAbove: 1000}.RequiresPayee(1001))
+}
+
+func TestHasTrustedPayee(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	policy := PayeePolicy{RequiredAbove: 1000, CoolingOff: 24 * time.Hour}
+
+	payees := []*Payee{
+		{ToNumber: "35", CreatedAt: now.Add(-25 * time.Hour)},
+		{ToNumber: "43", CreatedAt: now.Add(-time.Hour)},
+		{ToNumber: "51", PixKey: "jane@example.com", CreatedAt: now.Add(-48 * time.Hour)},
+	}
+
+	assert.True(t, HasTrustedPayee(payees, policy, "35", "", now))
+	assert.False(t, HasTrustedPayee(payees, policy, "43", "", now))
+	assert.False(t, HasTrustedPayee(payees, policy, "60", "", now))
+	assert.True(t, HasTrustedPayee(payees, policy, "51", "jane@example.com", now))
+	assert.False(t, HasTrustedPayee(payees, policy, "51", "", now))
+}
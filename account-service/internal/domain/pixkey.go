@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PixKeyType string
+
+const (
+	PixKeyDocument PixKeyType = "document"
+	PixKeyEmail    PixKeyType = "email"
+	PixKeyPhone    PixKeyType = "phone"
+	PixKeyRandom   PixKeyType = "random"
+
+	MaximumPixKeysPerson  = 5
+	MaximumPixKeysCompany = 20
+	MaximumLengthEmailKey = 77
+)
+
+var (
+	ErrPixKeyInUse        = errors.New("pix key already registered")
+	ErrPixKeyLimitReached = errors.New("pix keys limit reached for the account")
+	ErrPixKeyNotOwned     = errors.New("document pix key should be the account document")
+)
+
+// PixKey is an alias registered by an account owner so transfers can be addressed to it
+// instead of the account number.
+type PixKey struct {
+	Id            string
+	AccountNumber string
+	Type          PixKeyType
+	Key           string
+	CreatedAt     time.Time
+}
+
+// NewPixKey validates and normalizes key for acc. Document keys must be the document of the
+// account and random keys are generated, so key is ignored for them.
+func NewPixKey(acc *Account, keyType PixKeyType, key string) (*PixKey, error) {
+	switch keyType {
+	case PixKeyDocument:
+		key = NormalizeDocument(key)
+		if key != acc.Document {
+			return nil, ErrPixKeyNotOwned
+		}
+	case PixKeyEmail:
+		key = NormalizePixKey(key)
+		address, err := mail.ParseAddress(key)
+		if err != nil || address.Address != key || len(key) > MaximumLengthEmailKey {
+			return nil, errors.New("invalid email pix key")
+		}
+	case PixKeyPhone:
+		key = NormalizePixKey(key)
+		if !validPhoneKey(key) {
+			return nil, errors.New("invalid phone pix key, should be in the international format like +5511912345678")
+		}
+	case PixKeyRandom:
+		key = uuid.NewString()
+	default:
+		return nil, fmt.Errorf("invalid pix key type %v", keyType)
+	}
+
+	return &PixKey{
+		AccountNumber: acc.Number,
+		Type:          keyType,
+		Key:           key,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// NormalizePixKey puts a key typed by a user in the form it is stored, so a formatted
+// document or phone and an upper case e-mail resolve to the registered key.
+func NormalizePixKey(key string) string {
+	key = strings.TrimSpace(key)
+
+	switch {
+	case strings.Contains(key, "@"):
+		return strings.ToLower(key)
+	case strings.HasPrefix(key, "+"):
+		return "+" + strings.Map(func(r rune) rune {
+			switch r {
+			case ' ', '-', '(', ')', '+':
+				return -1
+			}
+
+			return r
+		}, key)
+	case len(key) == 36 && strings.Count(key, "-") == 4:
+		return strings.ToLower(key)
+	default:
+		return NormalizeDocument(key)
+	}
+}
+
+// MaximumPixKeys is how many keys the account may register, companies are allowed more.
+func (acc *Account) MaximumPixKeys() int {
+	if acc.DocumentType() == DocumentTypeCompany {
+		return MaximumPixKeysCompany
+	}
+
+	return MaximumPixKeysPerson
+}
+
+func validPhoneKey(key string) bool {
+	digits, ok := strings.CutPrefix(key, "+")
+	if !ok || len(digits) < 10 || len(digits) > 15 {
+		return false
+	}
+
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPixKey(t *testing.T) {
+	acc := NewAccount("19", "01234567890", "John Doe")
+
+	testCases := []struct {
+		testName    string
+		keyType     PixKeyType
+		key         string
+		expectedKey string
+		expectError bool
+	}{
+		{testName: "own document", keyType: PixKeyDocument, key: "012.345.678-90", expectedKey: "01234567890"},
+		{testName: "document of another person", keyType: PixKeyDocument, key: "52998224725", expectError: true},
+		{testName: "email", keyType: PixKeyEmail, key: " John@Mail.com", expectedKey: "john@mail.com"},
+		{testName: "invalid email", keyType: PixKeyEmail, key: "john", expectError: true},
+		{testName: "email with name", keyType: PixKeyEmail, key: "John <john@mail.com>", expectError: true},
+		{testName: "phone", keyType: PixKeyPhone, key: "+55 (11) 91234-5678", expectedKey: "+5511912345678"},
+		{testName: "phone without country code", keyType: PixKeyPhone, key: "11912345678", expectError: true},
+		{testName: "invalid type", keyType: "name", key: "John", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			pixKey, err := NewPixKey(acc, tc.keyType, tc.key)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, pixKey)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedKey, pixKey.Key)
+			assert.Equal(t, "19", pixKey.AccountNumber)
+		})
+	}
+}
+
+func TestNewPixKey_Random(t *testing.T) {
+	pixKey, err := NewPixKey(NewAccount("19", "01234567890", "John Doe"), PixKeyRandom, "ignored")
+
+	assert.NoError(t, err)
+	_, err = uuid.Parse(pixKey.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, pixKey.Key, NormalizePixKey(pixKey.Key))
+}
+
+func TestNormalizePixKey(t *testing.T) {
+	assert.Equal(t, "01234567890", NormalizePixKey("012.345.678-90"))
+	assert.Equal(t, "11222333000181", NormalizePixKey("11.222.333/0001-81"))
+	assert.Equal(t, "john@mail.com", NormalizePixKey("JOHN@mail.com"))
+	assert.Equal(t, "+5511912345678", NormalizePixKey("+55 11 91234-5678"))
+	assert.Equal(t, "6f1c8a4e-2b3d-4e5f-8a9b-0c1d2e3f4a5b", NormalizePixKey("6F1C8A4E-2B3D-4E5F-8A9B-0C1D2E3F4A5B"))
+}
+
+func TestAccountMaximumPixKeys(t *testing.T) {
+	assert.Equal(t, MaximumPixKeysPerson, NewAccount("19", "01234567890", "John Doe").MaximumPixKeys())
+	assert.Equal(t, MaximumPixKeysCompany, NewAccount("19", "11222333000181", "John Doe Company").MaximumPixKeys())
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaximumLengthPocketName = 40
+	MaximumPockets          = 10
+)
+
+type PocketStatus string
+
+const (
+	PocketStatusActive PocketStatus = "active"
+	PocketStatusClosed PocketStatus = "closed"
+)
+
+type PocketMoveDirection string
+
+const (
+	PocketMoveIn  PocketMoveDirection = "in"
+	PocketMoveOut PocketMoveDirection = "out"
+)
+
+var (
+	ErrPocketNotActive         = errors.New("pocket is not active")
+	ErrPocketAlreadyExists     = errors.New("account already has an active pocket with this name")
+	ErrMaximumPockets          = fmt.Errorf("account already has the maximum of %v pockets", MaximumPockets)
+	ErrInsufficientPocketFunds = errors.New("insufficient funds in the pocket")
+	ErrInvalidPocketMove       = errors.New("invalid pocket move direction, should be in or out")
+	ErrCloseAccountWithPockets = errors.New("account must not have money in pockets to close it")
+)
+
+// Pocket separates part of the balance of an account for a purpose, like vacation or taxes.
+// The money stays in the account balance, a pocket only reserves it so it is not available
+// for withdrawals and transfers until moved back to the main balance.
+type Pocket struct {
+	Id            string
+	AccountNumber string
+	Name          string
+	Balance       int64
+	Status        PocketStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewPocket creates an empty pocket in acc, pockets are the active pockets of the account.
+func NewPocket(acc *Account, name string, pockets []*Pocket) (*Pocket, error) {
+	err := acc.EnsureActive()
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	nameLength := utf8.RuneCountInString(name)
+	if nameLength == 0 || nameLength > MaximumLengthPocketName {
+		return nil, fmt.Errorf("invalid pocket name, should have between 1 and %v characters", MaximumLengthPocketName)
+	}
+
+	if len(pockets) >= MaximumPockets {
+		return nil, ErrMaximumPockets
+	}
+
+	for _, pocket := range pockets {
+		if strings.EqualFold(pocket.Name, name) {
+			return nil, ErrPocketAlreadyExists
+		}
+	}
+
+	now := time.Now()
+
+	return &Pocket{
+		AccountNumber: acc.Number,
+		Name:          name,
+		Status:        PocketStatusActive,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// MoveToPocket moves value of the main balance into pocket. Only money the account holds and
+// has not reserved in holds can be saved, never the overdraft limit.
+func (acc *Account) MoveToPocket(pocket *Pocket, value Money) error {
+	err := acc.validatePocketMove(pocket, value)
+	if err != nil {
+		return err
+	}
+
+	if value.Amount > acc.ownBalance() {
+		return ErrInsufficientFunds
+	}
+
+	now := time.Now()
+
+	pocket.Balance += value.Amount
+	pocket.UpdatedAt = now
+
+	acc.PocketBalance += value.Amount
+	acc.UpdatedAt = now
+
+	return nil
+}
+
+// MoveFromPocket moves value of pocket back to the main balance of the account.
+func (acc *Account) MoveFromPocket(pocket *Pocket, value Money) error {
+	err := acc.validatePocketMove(pocket, value)
+	if err != nil {
+		return err
+	}
+
+	if value.Amount > pocket.Balance {
+		return ErrInsufficientPocketFunds
+	}
+
+	now := time.Now()
+
+	pocket.Balance -= value.Amount
+	pocket.UpdatedAt = now
+
+	acc.PocketBalance -= value.Amount
+	acc.UpdatedAt = now
+
+	return nil
+}
+
+// MovePocketFunds moves value into pocket or out of it back to the main balance.
+func (acc *Account) MovePocketFunds(pocket *Pocket, direction PocketMoveDirection, value Money) error {
+	switch direction {
+	case PocketMoveIn:
+		return acc.MoveToPocket(pocket, value)
+	case PocketMoveOut:
+		return acc.MoveFromPocket(pocket, value)
+	default:
+		return ErrInvalidPocketMove
+	}
+}
+
+// ClosePocket moves what is left in pocket back to the main balance and closes it, returning
+// the amount moved back.
+func (acc *Account) ClosePocket(pocket *Pocket) (Money, error) {
+	if pocket.AccountNumber != acc.Number || pocket.Status != PocketStatusActive {
+		return Money{}, ErrPocketNotActive
+	}
+
+	err := acc.EnsureActive()
+	if err != nil {
+		return Money{}, err
+	}
+
+	returned := acc.Money(pocket.Balance)
+	now := time.Now()
+
+	acc.PocketBalance -= pocket.Balance
+	acc.UpdatedAt = now
+
+	pocket.Balance = 0
+	pocket.Status = PocketStatusClosed
+	pocket.UpdatedAt = now
+
+	return returned, nil
+}
+
+func (acc *Account) validatePocketMove(pocket *Pocket, value Money) error {
+	if value.Amount <= 0 {
+		return errors.New("for a pocket move the value must be greater than zero")
+	}
+
+	if value.Currency != acc.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	if pocket.AccountNumber != acc.Number || pocket.Status != PocketStatusActive {
+		return ErrPocketNotActive
+	}
+
+	return acc.EnsureActive()
+}
+
+// MainBalance is the balance of the account not separated in pockets.
+func (acc *Account) MainBalance() int64 {
+	return acc.Balance - acc.PocketBalance
+}
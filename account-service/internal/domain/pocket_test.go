@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPocket(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+
+	// act
+	pocket, err := NewPocket(acc, "  Vacation  ", []*Pocket{{Name: "Taxes"}})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Vacation", pocket.Name)
+	assert.Equal(t, acc.Number, pocket.AccountNumber)
+	assert.Equal(t, PocketStatusActive, pocket.Status)
+	assert.Equal(t, int64(0), pocket.Balance)
+}
+
+func TestNewPocket_Errors(t *testing.T) {
+	maximumPockets := []*Pocket{}
+	for i := range MaximumPockets {
+		maximumPockets = append(maximumPockets, &Pocket{Name: fmt.Sprintf("Pocket %v", i)})
+	}
+
+	testCases := []struct {
+		testName      string
+		status        AccountStatus
+		name          string
+		pockets       []*Pocket
+		expectedError string
+	}{
+		{testName: "empty name", status: AccountStatusActive, name: "  ", expectedError: "invalid pocket name, should have between 1 and 40 characters"},
+		{testName: "duplicated name", status: AccountStatusActive, name: "vacation", pockets: []*Pocket{{Name: "Vacation"}}, expectedError: ErrPocketAlreadyExists.Error()},
+		{testName: "maximum pockets", status: AccountStatusActive, name: "Vacation", pockets: maximumPockets, expectedError: ErrMaximumPockets.Error()},
+		{testName: "blocked account", status: AccountStatusBlocked, name: "Vacation", expectedError: ErrAccountNotActive.Error()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// arrange
+			acc := NewAccount("19", "01234567890", "John Doe")
+			acc.Status = tc.status
+
+			// act
+			pocket, err := NewPocket(acc, tc.name, tc.pockets)
+
+			// assert
+			assert.EqualError(t, err, tc.expectedError)
+			assert.Nil(t, pocket)
+		})
+	}
+}
+
+func TestMoveToPocket(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	pocket := &Pocket{AccountNumber: acc.Number, Name: "Vacation", Status: PocketStatusActive}
+
+	// act
+	err := acc.MovePocketFunds(pocket, PocketMoveIn, acc.Money(400))
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(400), pocket.Balance)
+	assert.Equal(t, int64(1000), acc.Balance)
+	assert.Equal(t, int64(400), acc.PocketBalance)
+	assert.Equal(t, int64(600), acc.MainBalance())
+	assert.Equal(t, int64(600), acc.AvailableBalance())
+}
+
+func TestMoveToPocket_OverdraftLimitNotSaved(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.HeldBalance = 200
+	acc.PocketBalance = 300
+	acc.OverdraftLimit = 5000
+	pocket := &Pocket{AccountNumber: acc.Number, Name: "Vacation", Status: PocketStatusActive}
+
+	// act
+	err := acc.MoveToPocket(pocket, acc.Money(501))
+
+	// assert
+	assert.Equal(t, ErrInsufficientFunds, err)
+	assert.Equal(t, int64(0), pocket.Balance)
+	assert.Equal(t, int64(300), acc.PocketBalance)
+	assert.NoError(t, acc.MoveToPocket(pocket, acc.Money(500)))
+}
+
+func TestWithdraw_OverdraftAfterMovingToPocket(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 100
+	acc.OverdraftLimit = 50
+	pocket := &Pocket{AccountNumber: acc.Number, Name: "Vacation", Status: PocketStatusActive}
+	assert.NoError(t, acc.MoveToPocket(pocket, acc.Money(100)))
+
+	// act
+	err := acc.Withdraw(acc.Money(50))
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), acc.Balance)
+	assert.True(t, acc.InOverdraft())
+	assert.Equal(t, int64(0), acc.AvailableOverdraftLimit())
+	assert.Equal(t, ErrInvalidOverdraftLimit, acc.SetOverdraftLimit(0))
+	assert.Equal(t, int64(50), acc.OverdraftLimit)
+}
+
+func TestMoveToPocket_Errors(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		value         Money
+		pocketStatus  PocketStatus
+		expectedError string
+	}{
+		{testName: "invalid value", value: NewMoney(0, DefaultCurrency), pocketStatus: PocketStatusActive, expectedError: "for a pocket move the value must be greater than zero"},
+		{testName: "other currency", value: NewMoney(100, "USD"), pocketStatus: PocketStatusActive, expectedError: ErrCurrencyMismatch.Error()},
+		{testName: "closed pocket", value: NewMoney(100, DefaultCurrency), pocketStatus: PocketStatusClosed, expectedError: ErrPocketNotActive.Error()},
+		{testName: "above balance not held", value: NewMoney(801, DefaultCurrency), pocketStatus: PocketStatusActive, expectedError: ErrInsufficientFunds.Error()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// arrange
+			acc := NewAccount("19", "01234567890", "John Doe")
+			acc.Balance = 1000
+			acc.HeldBalance = 200
+			pocket := &Pocket{AccountNumber: acc.Number, Name: "Vacation", Status: tc.pocketStatus}
+
+			// act
+			err := acc.MoveToPocket(pocket, tc.value)
+
+			// assert
+			assert.EqualError(t, err, tc.expectedError)
+			assert.Equal(t, int64(0), pocket.Balance)
+			assert.Equal(t, int64(0), acc.PocketBalance)
+		})
+	}
+}
+
+func TestMoveFromPocket(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.PocketBalance = 400
+	pocket := &Pocket{AccountNumber: acc.Number, Name: "Vacation", Balance: 400, Status: PocketStatusActive}
+
+	// act
+	err := acc.MovePocketFunds(pocket, PocketMoveOut, acc.Money(150))
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(250), pocket.Balance)
+	assert.Equal(t, int64(250), acc.PocketBalance)
+	assert.Equal(t, int64(750), acc.AvailableBalance())
+}
+
+func TestMoveFromPocket_InsufficientPocketFunds(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.PocketBalance = 400
+	pocket := &Pocket{AccountNumber: acc.Number, Name: "Vacation", Balance: 400, Status: PocketStatusActive}
+
+	// act
+	err := acc.MoveFromPocket(pocket, acc.Money(401))
+
+	// assert
+	assert.ErrorIs(t, err, ErrInsufficientPocketFunds)
+	assert.Equal(t, int64(400), pocket.Balance)
+}
+
+func TestMovePocketFunds_InvalidDirection(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	pocket := &Pocket{AccountNumber: acc.Number, Name: "Vacation", Status: PocketStatusActive}
+
+	// act
+	err := acc.MovePocketFunds(pocket, "sideways", acc.Money(100))
+
+	// assert
+	assert.ErrorIs(t, err, ErrInvalidPocketMove)
+}
+
+func TestClosePocket(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.PocketBalance = 400
+	pocket := &Pocket{AccountNumber: acc.Number, Name: "Vacation", Balance: 300, Status: PocketStatusActive}
+
+	// act
+	returned, err := acc.ClosePocket(pocket)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, acc.Money(300), returned)
+	assert.Equal(t, PocketStatusClosed, pocket.Status)
+	assert.Equal(t, int64(0), pocket.Balance)
+	assert.Equal(t, int64(100), acc.PocketBalance)
+
+	_, err = acc.ClosePocket(pocket)
+	assert.ErrorIs(t, err, ErrPocketNotActive)
+}
+
+func TestChangeStatus_CloseWithPockets(t *testing.T) {
+	// arrange
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.PocketBalance = 100
+
+	// act
+	err := acc.ChangeStatus(AccountStatusClosed)
+
+	// assert
+	assert.ErrorIs(t, err, ErrCloseAccountWithPockets)
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// RiskDecision is the outcome of the risk screening. Transfers flagged for review wait for
+// approval, deposits flagged for review are credited and only reported.
+type RiskDecision string
+
+const (
+	RiskDecisionAllow  RiskDecision = "allow"
+	RiskDecisionReview RiskDecision = "review"
+	RiskDecisionBlock  RiskDecision = "block"
+)
+
+type RiskRuleType string
+
+const (
+	// RiskRuleVelocity triggers when the account already made MaxCount operations within Window.
+	RiskRuleVelocity RiskRuleType = "velocity"
+	// RiskRuleAmountThreshold triggers for values of at least MinValue.
+	RiskRuleAmountThreshold RiskRuleType = "amount_threshold"
+	// RiskRuleNewCounterparty triggers on the first transfer to an account, of at least MinValue.
+	RiskRuleNewCounterparty RiskRuleType = "new_counterparty"
+	// RiskRuleRoundAmount triggers for values multiple of Multiple, of at least MinValue.
+	RiskRuleRoundAmount RiskRuleType = "round_amount"
+)
+
+type RiskOperationType string
+
+const (
+	RiskOperationDeposit  RiskOperationType = "deposit"
+	RiskOperationTransfer RiskOperationType = "transfer"
+)
+
+var ErrOperationBlocked = errors.New("operation blocked by risk screening")
+
+// RiskRule is a rule of the risk screening, Operations limits it to deposits or transfers and
+// is empty for both.
+type RiskRule struct {
+	Name       string
+	Type       RiskRuleType
+	Operations []RiskOperationType
+	Decision   RiskDecision
+	MaxCount   int
+	Window     time.Duration
+	MinValue   int64
+	Multiple   int64
+}
+
+type RiskRules []RiskRule
+
+// RiskOperation is the deposit or transfer being screened, CounterpartyNumber is the account
+// receiving a transfer.
+type RiskOperation struct {
+	Type               RiskOperationType
+	AccountNumber      string
+	CounterpartyNumber string
+	Value              int64
+}
+
+// RiskFacts are the history of the account a rule needs, read only for the rules that use them.
+type RiskFacts struct {
+	RecentCount       int
+	KnownCounterparty bool
+}
+
+func (r RiskRule) Validate() error {
+	if r.Name == "" {
+		return errors.New("risk rule name is required")
+	}
+
+	if r.Decision != RiskDecisionReview && r.Decision != RiskDecisionBlock {
+		return fmt.Errorf("risk rule %v decision should be %v or %v", r.Name, RiskDecisionReview, RiskDecisionBlock)
+	}
+
+	switch r.Type {
+	case RiskRuleVelocity:
+		if r.MaxCount <= 0 || r.Window <= 0 {
+			return fmt.Errorf("risk rule %v requires maxCount and window", r.Name)
+		}
+	case RiskRuleAmountThreshold:
+		if r.MinValue <= 0 {
+			return fmt.Errorf("risk rule %v requires minValue", r.Name)
+		}
+	case RiskRuleRoundAmount:
+		if r.Multiple <= 0 {
+			return fmt.Errorf("risk rule %v requires multiple", r.Name)
+		}
+	case RiskRuleNewCounterparty:
+	default:
+		return fmt.Errorf("risk rule %v has unknown type %v", r.Name, r.Type)
+	}
+
+	return nil
+}
+
+func (r RiskRule) AppliesTo(operation RiskOperation) bool {
+	if r.Type == RiskRuleNewCounterparty && operation.CounterpartyNumber == "" {
+		return false
+	}
+
+	if operation.Value < r.MinValue {
+		return false
+	}
+
+	return len(r.Operations) == 0 || slices.Contains(r.Operations, operation.Type)
+}
+
+// Triggered tells whether the rule flags the operation, it is only called for operations the
+// rule applies to.
+func (r RiskRule) Triggered(operation RiskOperation, facts RiskFacts) bool {
+	switch r.Type {
+	case RiskRuleVelocity:
+		return facts.RecentCount >= r.MaxCount
+	case RiskRuleAmountThreshold:
+		return true
+	case RiskRuleNewCounterparty:
+		return !facts.KnownCounterparty
+	case RiskRuleRoundAmount:
+		return operation.Value%r.Multiple == 0
+	default:
+		return false
+	}
+}
+
+// RiskAssessment is the decision of the risk screening for an operation, Rule is the name of
+// the rule that triggered it and is empty when the operation is allowed.
+type RiskAssessment struct {
+	Id                 string
+	Operation          RiskOperationType
+	AccountNumber      string
+	CounterpartyNumber string
+	Value              int64
+	Decision           RiskDecision
+	Rule               string
+	CreatedAt          time.Time
+}
+
+func NewRiskAssessment(operation RiskOperation) *RiskAssessment {
+	return &RiskAssessment{
+		Operation:          operation.Type,
+		AccountNumber:      operation.AccountNumber,
+		CounterpartyNumber: operation.CounterpartyNumber,
+		Value:              operation.Value,
+		Decision:           RiskDecisionAllow,
+		CreatedAt:          time.Now(),
+	}
+}
+
+// Record keeps the most severe decision of the triggered rules, the first rule wins a tie.
+func (a *RiskAssessment) Record(rule RiskRule) {
+	if riskDecisionSeverity(rule.Decision) > riskDecisionSeverity(a.Decision) {
+		a.Decision = rule.Decision
+		a.Rule = rule.Name
+	}
+}
+
+func (a *RiskAssessment) Suspicious() bool {
+	return a.Decision != RiskDecisionAllow
+}
+
+func riskDecisionSeverity(decision RiskDecision) int {
+	switch decision {
+	case RiskDecisionBlock:
+		return 2
+	case RiskDecisionReview:
+		return 1
+	default:
+		return 0
+	}
+}
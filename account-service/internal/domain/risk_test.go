@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRiskRule_Validate(t *testing.T) {
+	assert.NoError(t, RiskRule{Name: "velocity", Type: RiskRuleVelocity, Decision: RiskDecisionBlock, MaxCount: 3, Window: time.Minute}.Validate())
+	assert.NoError(t, RiskRule{Name: "new", Type: RiskRuleNewCounterparty, Decision: RiskDecisionReview}.Validate())
+
+	assert.Error(t, RiskRule{Type: RiskRuleNewCounterparty, Decision: RiskDecisionReview}.Validate())
+	assert.Error(t, RiskRule{Name: "allow", Type: RiskRuleNewCounterparty, Decision: RiskDecisionAllow}.Validate())
+	assert.Error(t, RiskRule{Name: "velocity", Type: RiskRuleVelocity, Decision: RiskDecisionBlock, MaxCount: 3}.Validate())
+	assert.Error(t, RiskRule{Name: "large", Type: RiskRuleAmountThreshold, Decision: RiskDecisionReview}.Validate())
+	assert.Error(t, RiskRule{Name: "round", Type: RiskRuleRoundAmount, Decision: RiskDecisionReview}.Validate())
+	assert.Error(t, RiskRule{Name: "unknown", Type: "unknown", Decision: RiskDecisionReview}.Validate())
+}
+
+func TestRiskRule_AppliesTo(t *testing.T) {
+	deposit := RiskOperation{Type: RiskOperationDeposit, AccountNumber: "19", Value: 1000}
+	transfer := RiskOperation{Type: RiskOperationTransfer, AccountNumber: "19", CounterpartyNumber: "27", Value: 1000}
+
+	transfersOnly := RiskRule{Type: RiskRuleAmountThreshold, Operations: []RiskOperationType{RiskOperationTransfer}, MinValue: 500}
+	assert.True(t, transfersOnly.AppliesTo(transfer))
+	assert.False(t, transfersOnly.AppliesTo(deposit))
+
+	aboveValue := RiskRule{Type: RiskRuleAmountThreshold, MinValue: 1001}
+	assert.False(t, aboveValue.AppliesTo(transfer))
+
+	newCounterparty := RiskRule{Type: RiskRuleNewCounterparty}
+	assert.True(t, newCounterparty.AppliesTo(transfer))
+	assert.False(t, newCounterparty.AppliesTo(deposit))
+}
+
+func TestRiskRule_Triggered(t *testing.T) {
+	operation := RiskOperation{Type: RiskOperationTransfer, AccountNumber: "19", CounterpartyNumber: "27", Value: 3000}
+
+	velocity := RiskRule{Type: RiskRuleVelocity, MaxCount: 3}
+	assert.False(t, velocity.Triggered(operation, RiskFacts{RecentCount: 2}))
+	assert.True(t, velocity.Triggered(operation, RiskFacts{RecentCount: 3}))
+
+	newCounterparty := RiskRule{Type: RiskRuleNewCounterparty}
+	assert.True(t, newCounterparty.Triggered(operation, RiskFacts{}))
+	assert.False(t, newCounterparty.Triggered(operation, RiskFacts{KnownCounterparty: true}))
+
+	assert.True(t, RiskRule{Type: RiskRuleRoundAmount, Multiple: 1000}.Triggered(operation, RiskFacts{}))
+	assert.False(t, RiskRule{Type: RiskRuleRoundAmount, Multiple: 2000}.Triggered(operation, RiskFacts{}))
+}
+
+func TestRiskAssessment_Record(t *testing.T) {
+	assessment := NewRiskAssessment(RiskOperation{Type: RiskOperationDeposit, AccountNumber: "19", Value: 1000})
+	assert.Equal(t, RiskDecisionAllow, assessment.Decision)
+	assert.False(t, assessment.Suspicious())
+
+	assessment.Record(RiskRule{Name: "large", Decision: RiskDecisionReview})
+	assessment.Record(RiskRule{Name: "velocity", Decision: RiskDecisionBlock})
+	assessment.Record(RiskRule{Name: "round", Decision: RiskDecisionReview})
+
+	assert.Equal(t, RiskDecisionBlock, assessment.Decision)
+	assert.Equal(t, "velocity", assessment.Rule)
+	assert.True(t, assessment.Suspicious())
+}
@@ -0,0 +1,263 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrScheduledTransferNotActive = errors.New("scheduled transfer is not active")
+
+type ScheduledTransferFrequency string
+
+const (
+	ScheduledTransferOnce    ScheduledTransferFrequency = "once"
+	ScheduledTransferWeekly  ScheduledTransferFrequency = "weekly"
+	ScheduledTransferMonthly ScheduledTransferFrequency = "monthly"
+)
+
+type ScheduledTransferStatus string
+
+const (
+	ScheduledTransferActive    ScheduledTransferStatus = "active"
+	ScheduledTransferCompleted ScheduledTransferStatus = "completed"
+	ScheduledTransferCancelled ScheduledTransferStatus = "cancelled"
+	ScheduledTransferFailed    ScheduledTransferStatus = "failed"
+)
+
+// ScheduledTransfer is a transfer executed in the future, once or on a recurring basis.
+// ScheduledFor is the date of the pending occurrence and NextRunAt when it is attempted,
+// they only differ while a failed occurrence waits for a retry. PendingOccurrences counts the
+// occurrences above the approval threshold, handed to a transfer request instead of executed.
+// CreatedBy is the subject of the token that scheduled it, requesting every occurrence.
+type ScheduledTransfer struct {
+	Id                    string
+	FromNumber            string
+	ToNumber              string
+	Value                 int64
+	Frequency             ScheduledTransferFrequency
+	DayOfMonth            int
+	EndDate               *time.Time
+	MaxOccurrences        int
+	Occurrences           int
+	FailedOccurrences     int
+	PendingOccurrences    int
+	LastTransferRequestId string
+	ScheduledFor          time.Time
+	NextRunAt             time.Time
+	Attempts              int
+	LastError             string
+	LastAttemptAt         *time.Time
+	Status                ScheduledTransferStatus
+	CreatedBy             string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// NewScheduledTransfer schedules the first occurrence at startAt. Monthly transfers run on
+// dayOfMonth, or on the day of startAt when it is zero, clamped to the last day of short months.
+func NewScheduledTransfer(
+	fromNumber, toNumber string,
+	value int64,
+	frequency ScheduledTransferFrequency,
+	startAt time.Time,
+	dayOfMonth int,
+	endDate *time.Time,
+	maxOccurrences int,
+	createdBy string) (*ScheduledTransfer, error) {
+
+	if !startAt.After(time.Now()) {
+		return nil, errors.New("start date should be in the future")
+	}
+
+	if frequency == ScheduledTransferMonthly && dayOfMonth == 0 {
+		dayOfMonth = startAt.Day()
+	}
+
+	st := &ScheduledTransfer{
+		FromNumber:     fromNumber,
+		ToNumber:       toNumber,
+		Value:          value,
+		Frequency:      frequency,
+		DayOfMonth:     dayOfMonth,
+		EndDate:        endDate,
+		MaxOccurrences: maxOccurrences,
+		ScheduledFor:   startAt,
+		Status:         ScheduledTransferActive,
+		CreatedBy:      createdBy,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	if frequency == ScheduledTransferMonthly {
+		st.ScheduledFor = monthlyOccurrence(startAt.Year(), startAt.Month(), dayOfMonth, startAt)
+		if st.ScheduledFor.Before(startAt) {
+			st.ScheduledFor = monthlyOccurrence(startAt.Year(), startAt.Month()+1, dayOfMonth, startAt)
+		}
+	}
+
+	st.NextRunAt = st.ScheduledFor
+
+	err := st.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+func (st *ScheduledTransfer) Validate() error {
+	if st.FromNumber == st.ToNumber {
+		return errors.New("should transfer to another account")
+	}
+
+	if st.Value <= 0 {
+		return errors.New("invalid value, should be greater than zero")
+	}
+
+	switch st.Frequency {
+	case ScheduledTransferOnce, ScheduledTransferWeekly:
+	case ScheduledTransferMonthly:
+		if st.DayOfMonth < 1 || st.DayOfMonth > 31 {
+			return errors.New("invalid day of month, should be between 1 and 31")
+		}
+	default:
+		return fmt.Errorf("invalid frequency %v", st.Frequency)
+	}
+
+	if st.MaxOccurrences < 0 {
+		return errors.New("invalid occurrences, should not be negative")
+	}
+
+	if st.MaxOccurrences > 0 && st.MaxOccurrences <= st.Occurrences {
+		return errors.New("invalid occurrences, should be greater than the occurrences already executed")
+	}
+
+	if st.EndDate != nil && st.EndDate.Before(st.ScheduledFor) {
+		return errors.New("invalid end date, should be after the next occurrence")
+	}
+
+	return nil
+}
+
+// OccurrenceIdempotencyKey derives the idempotency key of the pending occurrence, so an
+// occurrence executed but not recorded as such is never transferred twice.
+func (st *ScheduledTransfer) OccurrenceIdempotencyKey() string {
+	return fmt.Sprintf("scheduled-%v-%v", st.Id, st.Occurrences+1)
+}
+
+func (st *ScheduledTransfer) Update(value int64, endDate *time.Time, maxOccurrences int) error {
+	if st.Status != ScheduledTransferActive {
+		return ErrScheduledTransferNotActive
+	}
+
+	updated := *st
+	updated.Value = value
+	updated.EndDate = endDate
+	updated.MaxOccurrences = maxOccurrences
+
+	err := updated.Validate()
+	if err != nil {
+		return err
+	}
+
+	st.Value = value
+	st.EndDate = endDate
+	st.MaxOccurrences = maxOccurrences
+	st.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (st *ScheduledTransfer) Cancel() error {
+	if st.Status != ScheduledTransferActive {
+		return ErrScheduledTransferNotActive
+	}
+
+	st.Status = ScheduledTransferCancelled
+	st.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (st *ScheduledTransfer) RecordSuccess(now time.Time) {
+	st.LastAttemptAt = &now
+	st.LastError = ""
+
+	st.settleOccurrence()
+}
+
+// RecordPendingApproval settles an occurrence that created the transfer request
+// transferRequestId, it is executed only if the request is approved.
+func (st *ScheduledTransfer) RecordPendingApproval(transferRequestId string, now time.Time) {
+	st.PendingOccurrences++
+	st.LastTransferRequestId = transferRequestId
+	st.LastAttemptAt = &now
+	st.LastError = ""
+
+	st.settleOccurrence()
+}
+
+// RecordFailure retries the occurrence after retryDelay until maxAttempts is reached. Then
+// the occurrence is skipped, ending a one-time transfer as failed.
+func (st *ScheduledTransfer) RecordFailure(err error, now time.Time, maxAttempts int, retryDelay time.Duration) {
+	st.Attempts++
+	st.LastAttemptAt = &now
+	st.LastError = err.Error()
+	st.UpdatedAt = now
+
+	if st.Attempts < maxAttempts {
+		st.NextRunAt = now.Add(retryDelay)
+		return
+	}
+
+	st.FailedOccurrences++
+
+	if st.Frequency == ScheduledTransferOnce {
+		st.Occurrences++
+		st.Status = ScheduledTransferFailed
+		return
+	}
+
+	st.settleOccurrence()
+}
+
+func (st *ScheduledTransfer) settleOccurrence() {
+	st.Occurrences++
+	st.Attempts = 0
+	st.UpdatedAt = time.Now()
+
+	next := st.nextOccurrence()
+
+	if st.Frequency == ScheduledTransferOnce ||
+		(st.MaxOccurrences > 0 && st.Occurrences >= st.MaxOccurrences) ||
+		(st.EndDate != nil && next.After(*st.EndDate)) {
+		st.Status = ScheduledTransferCompleted
+		return
+	}
+
+	st.ScheduledFor = next
+	st.NextRunAt = next
+}
+
+func (st *ScheduledTransfer) nextOccurrence() time.Time {
+	switch st.Frequency {
+	case ScheduledTransferWeekly:
+		return st.ScheduledFor.AddDate(0, 0, 7)
+	case ScheduledTransferMonthly:
+		return monthlyOccurrence(st.ScheduledFor.Year(), st.ScheduledFor.Month()+1, st.DayOfMonth, st.ScheduledFor)
+	default:
+		return st.ScheduledFor
+	}
+}
+
+// monthlyOccurrence returns the day of the month at the clock time of reference, using the
+// last day of the month when it has fewer days.
+func monthlyOccurrence(year int, month time.Month, day int, reference time.Time) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, reference.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month, day, reference.Hour(), reference.Minute(), reference.Second(), 0, reference.Location())
+}
@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewScheduledTransfer_Once(t *testing.T) {
+	// arrange
+	startAt := time.Now().Add(24 * time.Hour)
+
+	// act
+	st, err := NewScheduledTransfer("1", "2", 100, ScheduledTransferOnce, startAt, 0, nil, 0, "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, ScheduledTransferActive, st.Status)
+	assert.Equal(t, "bob", st.CreatedBy)
+	assert.Equal(t, startAt, st.ScheduledFor)
+	assert.Equal(t, startAt, st.NextRunAt)
+}
+
+func TestNewScheduledTransfer_MonthlyOnDayAlreadyPassed(t *testing.T) {
+	// arrange
+	startAt := time.Date(2100, time.January, 20, 9, 0, 0, 0, time.UTC)
+
+	// act
+	st, err := NewScheduledTransfer("1", "2", 100, ScheduledTransferMonthly, startAt, 5, nil, 0, "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2100, time.February, 5, 9, 0, 0, 0, time.UTC), st.NextRunAt)
+}
+
+func TestNewScheduledTransfer_Invalid(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-24 * time.Hour)
+
+	testCases := []struct {
+		name           string
+		toNumber       string
+		value          int64
+		frequency      ScheduledTransferFrequency
+		startAt        time.Time
+		dayOfMonth     int
+		endDate        *time.Time
+		maxOccurrences int
+	}{
+		{name: "start in the past", toNumber: "2", value: 100, frequency: ScheduledTransferOnce, startAt: past},
+		{name: "same account", toNumber: "1", value: 100, frequency: ScheduledTransferOnce, startAt: future},
+		{name: "zero value", toNumber: "2", value: 0, frequency: ScheduledTransferOnce, startAt: future},
+		{name: "unknown frequency", toNumber: "2", value: 100, frequency: "daily", startAt: future},
+		{name: "invalid day of month", toNumber: "2", value: 100, frequency: ScheduledTransferMonthly, startAt: future, dayOfMonth: 32},
+		{name: "negative occurrences", toNumber: "2", value: 100, frequency: ScheduledTransferWeekly, startAt: future, maxOccurrences: -1},
+		{name: "end before start", toNumber: "2", value: 100, frequency: ScheduledTransferWeekly, startAt: future, endDate: &past},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// act
+			st, err := NewScheduledTransfer("1", tc.toNumber, tc.value, tc.frequency, tc.startAt, tc.dayOfMonth, tc.endDate, tc.maxOccurrences, "bob")
+
+			// assert
+			assert.Error(t, err)
+			assert.Nil(t, st)
+		})
+	}
+}
+
+func TestScheduledTransfer_OccurrenceIdempotencyKey(t *testing.T) {
+	// arrange
+	st := &ScheduledTransfer{Id: "7", Occurrences: 2}
+
+	// act
+	key := st.OccurrenceIdempotencyKey()
+
+	// assert
+	assert.Equal(t, "scheduled-7-3", key)
+}
+
+func TestScheduledTransfer_RecordSuccess_Monthly(t *testing.T) {
+	// arrange
+	scheduledFor := time.Date(2100, time.January, 31, 9, 0, 0, 0, time.UTC)
+	st := &ScheduledTransfer{Frequency: ScheduledTransferMonthly, DayOfMonth: 31, ScheduledFor: scheduledFor, NextRunAt: scheduledFor, Status: ScheduledTransferActive}
+
+	// act
+	st.RecordSuccess(scheduledFor)
+
+	// assert
+	assert.Equal(t, 1, st.Occurrences)
+	assert.Equal(t, ScheduledTransferActive, st.Status)
+	assert.Equal(t, time.Date(2100, time.February, 28, 9, 0, 0, 0, time.UTC), st.NextRunAt)
+
+	// act
+	st.RecordSuccess(st.NextRunAt)
+
+	// assert
+	assert.Equal(t, time.Date(2100, time.March, 31, 9, 0, 0, 0, time.UTC), st.NextRunAt)
+}
+
+func TestScheduledTransfer_RecordPendingApproval(t *testing.T) {
+	// arrange
+	scheduledFor := time.Date(2100, time.January, 1, 9, 0, 0, 0, time.UTC)
+	st := &ScheduledTransfer{Frequency: ScheduledTransferWeekly, ScheduledFor: scheduledFor, NextRunAt: scheduledFor, Status: ScheduledTransferActive}
+
+	// act
+	st.RecordPendingApproval("9", scheduledFor)
+
+	// assert
+	assert.Equal(t, 1, st.Occurrences)
+	assert.Equal(t, 1, st.PendingOccurrences)
+	assert.Equal(t, "9", st.LastTransferRequestId)
+	assert.Equal(t, scheduledFor.AddDate(0, 0, 7), st.NextRunAt)
+}
+
+func TestScheduledTransfer_RecordSuccess_Completion(t *testing.T) {
+	scheduledFor := time.Date(2100, time.January, 1, 9, 0, 0, 0, time.UTC)
+	endDate := scheduledFor.AddDate(0, 0, 10)
+
+	testCases := []struct {
+		name           string
+		frequency      ScheduledTransferFrequency
+		endDate        *time.Time
+		maxOccurrences int
+		status         ScheduledTransferStatus
+	}{
+		{name: "once", frequency: ScheduledTransferOnce, status: ScheduledTransferCompleted},
+		{name: "weekly without end", frequency: ScheduledTransferWeekly, status: ScheduledTransferActive},
+		{name: "weekly reaching occurrences", frequency: ScheduledTransferWeekly, maxOccurrences: 1, status: ScheduledTransferCompleted},
+		{name: "weekly before end date", frequency: ScheduledTransferWeekly, endDate: &endDate, status: ScheduledTransferActive},
+		{name: "monthly after end date", frequency: ScheduledTransferMonthly, endDate: &endDate, status: ScheduledTransferCompleted},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			st := &ScheduledTransfer{
+				Frequency:      tc.frequency,
+				DayOfMonth:     1,
+				EndDate:        tc.endDate,
+				MaxOccurrences: tc.maxOccurrences,
+				ScheduledFor:   scheduledFor,
+				NextRunAt:      scheduledFor,
+				Status:         ScheduledTransferActive,
+			}
+
+			// act
+			st.RecordSuccess(scheduledFor)
+
+			// assert
+			assert.Equal(t, tc.status, st.Status)
+			assert.Equal(t, 1, st.Occurrences)
+		})
+	}
+}
+
+func TestScheduledTransfer_RecordFailure_Retry(t *testing.T) {
+	// arrange
+	now := time.Date(2100, time.January, 1, 9, 0, 0, 0, time.UTC)
+	st := &ScheduledTransfer{Frequency: ScheduledTransferWeekly, ScheduledFor: now, NextRunAt: now, Status: ScheduledTransferActive}
+
+	// act
+	st.RecordFailure(ErrInsufficientFunds, now, 3, time.Hour)
+
+	// assert
+	assert.Equal(t, 1, st.Attempts)
+	assert.Equal(t, 0, st.Occurrences)
+	assert.Equal(t, ErrInsufficientFunds.Error(), st.LastError)
+	assert.Equal(t, now, st.ScheduledFor)
+	assert.Equal(t, now.Add(time.Hour), st.NextRunAt)
+	assert.Equal(t, ScheduledTransferActive, st.Status)
+}
+
+func TestScheduledTransfer_RecordFailure_AttemptsExhausted(t *testing.T) {
+	// arrange
+	now := time.Date(2100, time.January, 1, 9, 0, 0, 0, time.UTC)
+	weekly := &ScheduledTransfer{Frequency: ScheduledTransferWeekly, ScheduledFor: now, NextRunAt: now, Attempts: 2, Status: ScheduledTransferActive}
+	once := &ScheduledTransfer{Frequency: ScheduledTransferOnce, ScheduledFor: now, NextRunAt: now, Attempts: 2, Status: ScheduledTransferActive}
+
+	// act
+	weekly.RecordFailure(errors.New("transfer error"), now, 3, time.Hour)
+	once.RecordFailure(errors.New("transfer error"), now, 3, time.Hour)
+
+	// assert
+	assert.Equal(t, ScheduledTransferActive, weekly.Status)
+	assert.Equal(t, 0, weekly.Attempts)
+	assert.Equal(t, 1, weekly.Occurrences)
+	assert.Equal(t, 1, weekly.FailedOccurrences)
+	assert.Equal(t, now.AddDate(0, 0, 7), weekly.NextRunAt)
+
+	assert.Equal(t, ScheduledTransferFailed, once.Status)
+	assert.Equal(t, 1, once.FailedOccurrences)
+}
+
+func TestScheduledTransfer_Update(t *testing.T) {
+	// arrange
+	st := &ScheduledTransfer{FromNumber: "1", ToNumber: "2", Value: 100, Frequency: ScheduledTransferWeekly, Occurrences: 3, Status: ScheduledTransferActive}
+
+	// act & assert
+	assert.Error(t, st.Update(100, nil, 3))
+	assert.Error(t, st.Update(0, nil, 0))
+	assert.Equal(t, int64(100), st.Value)
+
+	assert.NoError(t, st.Update(250, nil, 5))
+	assert.Equal(t, int64(250), st.Value)
+	assert.Equal(t, 5, st.MaxOccurrences)
+}
+
+func TestScheduledTransfer_Cancel(t *testing.T) {
+	// arrange
+	st := &ScheduledTransfer{Status: ScheduledTransferActive}
+
+	// act & assert
+	assert.NoError(t, st.Cancel())
+	assert.Equal(t, ScheduledTransferCancelled, st.Status)
+	assert.Equal(t, ErrScheduledTransferNotActive, st.Cancel())
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+type TransferBatchMode string
+
+const (
+	// TransferBatchAllOrNothing executes the batch in a single database transaction, so a
+	// failing line rolls back the lines executed before it.
+	TransferBatchAllOrNothing TransferBatchMode = "all_or_nothing"
+	// TransferBatchBestEffort executes every line on its own, a failing line does not stop
+	// the others.
+	TransferBatchBestEffort TransferBatchMode = "best_effort"
+)
+
+type TransferBatchLineStatus string
+
+const (
+	TransferBatchLinePending   TransferBatchLineStatus = "pending"
+	TransferBatchLineInvalid   TransferBatchLineStatus = "invalid"
+	TransferBatchLineSucceeded TransferBatchLineStatus = "succeeded"
+	// TransferBatchLinePendingApproval is a line above the approval threshold, it only
+	// created a transfer request.
+	TransferBatchLinePendingApproval TransferBatchLineStatus = "pending_approval"
+	TransferBatchLineFailed          TransferBatchLineStatus = "failed"
+	TransferBatchLineRolledBack      TransferBatchLineStatus = "rolled_back"
+	TransferBatchLineNotExecuted     TransferBatchLineStatus = "not_executed"
+)
+
+var ErrInvalidTransferBatch = errors.New("transfer batch has invalid lines, nothing was executed")
+
+// TransferBatchLine is a transfer of a batch to an account number or a pix key. Line is its
+// position in the batch starting at 1, and Error tells why it is invalid or failed.
+type TransferBatchLine struct {
+	Line           int
+	ToNumber       string
+	PixKey         string
+	Value          int64
+	IdempotencyKey string
+	Status         TransferBatchLineStatus
+	Error          string
+}
+
+type TransferBatch struct {
+	FromNumber  string
+	Mode        TransferBatchMode
+	RequestedBy string
+	Lines       []*TransferBatchLine
+}
+
+func NewTransferBatch(fromNumber string, mode TransferBatchMode, requestedBy string, lines []*TransferBatchLine) *TransferBatch {
+	for i, line := range lines {
+		line.Line = i + 1
+		line.Status = TransferBatchLinePending
+	}
+
+	return &TransferBatch{
+		FromNumber:  fromNumber,
+		Mode:        mode,
+		RequestedBy: requestedBy,
+		Lines:       lines,
+	}
+}
+
+// Validate checks the whole batch before any line is executed. Invalid lines are marked with
+// their error and ErrInvalidTransferBatch is returned, so the report tells every line to fix.
+func (b *TransferBatch) Validate(maxLines int, accountNumberPolicy AccountNumberPolicy) error {
+	if b.Mode != TransferBatchAllOrNothing && b.Mode != TransferBatchBestEffort {
+		return fmt.Errorf("invalid transfer batch mode %v, should be %v or %v", b.Mode, TransferBatchAllOrNothing, TransferBatchBestEffort)
+	}
+
+	if len(b.Lines) == 0 {
+		return errors.New("transfer batch must have at least one line")
+	}
+
+	if len(b.Lines) > maxLines {
+		return fmt.Errorf("transfer batch must have at most %v lines", maxLines)
+	}
+
+	idempotencyKeys := map[string]int{}
+	invalid := false
+
+	for _, line := range b.Lines {
+		err := line.validate(idempotencyKeys, accountNumberPolicy)
+		if err != nil {
+			line.Status = TransferBatchLineInvalid
+			line.Error = err.Error()
+			invalid = true
+		}
+
+		idempotencyKeys[line.IdempotencyKey] = line.Line
+	}
+
+	if invalid {
+		return ErrInvalidTransferBatch
+	}
+
+	return nil
+}
+
+func (l *TransferBatchLine) validate(idempotencyKeys map[string]int, accountNumberPolicy AccountNumberPolicy) error {
+	if l.Value <= 0 {
+		return errors.New("value must be greater than zero")
+	}
+
+	if (l.ToNumber == "") == (l.PixKey == "") {
+		return errors.New("either toNumber or pixKey is required")
+	}
+
+	if l.ToNumber != "" {
+		err := accountNumberPolicy.ValidateNumber(l.ToNumber)
+		if err != nil {
+			return err
+		}
+	}
+
+	if l.IdempotencyKey == "" {
+		return errors.New("idempotency key is required")
+	}
+
+	if line, ok := idempotencyKeys[l.IdempotencyKey]; ok {
+		return fmt.Errorf("idempotency key already used by line %v", line)
+	}
+
+	return nil
+}
+
+// RecordFailure marks line as failed with err and, for all or nothing batches, rolls back the
+// lines executed before it and skips the ones after it.
+func (b *TransferBatch) RecordFailure(line *TransferBatchLine, err error) {
+	line.Status = TransferBatchLineFailed
+	line.Error = err.Error()
+
+	if b.Mode != TransferBatchAllOrNothing {
+		return
+	}
+
+	for _, other := range b.Lines {
+		switch other.Status {
+		case TransferBatchLineSucceeded, TransferBatchLinePendingApproval:
+			other.Status = TransferBatchLineRolledBack
+		case TransferBatchLinePending:
+			other.Status = TransferBatchLineNotExecuted
+		}
+	}
+}
+
+func (b *TransferBatch) Count(status TransferBatchLineStatus) int {
+	count := 0
+	for _, line := range b.Lines {
+		if line.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
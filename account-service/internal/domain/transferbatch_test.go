@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferBatchValidate(t *testing.T) {
+	// arrange
+	batch := NewTransferBatch("19", TransferBatchBestEffort, "user-1", []*TransferBatchLine{
+		{ToNumber: "27", Value: 100, IdempotencyKey: "a"},
+		{ToNumber: "27", Value: 0, IdempotencyKey: "b"},
+		{ToNumber: "28", Value: 100, IdempotencyKey: "c"},
+		{ToNumber: "27", PixKey: "jane@mail.com", Value: 100, IdempotencyKey: "d"},
+		{PixKey: "jane@mail.com", Value: 100, IdempotencyKey: "a"},
+		{PixKey: "jane@mail.com", Value: 100},
+	})
+
+	// act
+	err := batch.Validate(10, AccountNumberPolicy{})
+
+	// assert
+	assert.Equal(t, ErrInvalidTransferBatch, err)
+	assert.Equal(t, TransferBatchLinePending, batch.Lines[0].Status)
+	assert.Equal(t, "value must be greater than zero", batch.Lines[1].Error)
+	assert.Equal(t, ErrInvalidAccountNumber.Error(), batch.Lines[2].Error)
+	assert.Equal(t, "either toNumber or pixKey is required", batch.Lines[3].Error)
+	assert.Equal(t, "idempotency key already used by line 1", batch.Lines[4].Error)
+	assert.Equal(t, "idempotency key is required", batch.Lines[5].Error)
+	assert.Equal(t, 5, batch.Count(TransferBatchLineInvalid))
+}
+
+func TestTransferBatchValidate_BatchErrors(t *testing.T) {
+	line := &TransferBatchLine{ToNumber: "27", Value: 100, IdempotencyKey: "a"}
+
+	assert.EqualError(t, NewTransferBatch("19", "some", "user-1", []*TransferBatchLine{line}).Validate(10, AccountNumberPolicy{}),
+		"invalid transfer batch mode some, should be all_or_nothing or best_effort")
+	assert.EqualError(t, NewTransferBatch("19", TransferBatchBestEffort, "user-1", nil).Validate(10, AccountNumberPolicy{}),
+		"transfer batch must have at least one line")
+	assert.EqualError(t, NewTransferBatch("19", TransferBatchBestEffort, "user-1", []*TransferBatchLine{line, {}}).Validate(1, AccountNumberPolicy{}),
+		"transfer batch must have at most 1 lines")
+}
+
+func TestTransferBatchValidate_LegacyAccountNumber(t *testing.T) {
+	// arrange
+	batch := NewTransferBatch("19", TransferBatchBestEffort, "user-1", []*TransferBatchLine{
+		{ToNumber: "28", Value: 100, IdempotencyKey: "a"},
+		{ToNumber: "101", Value: 100, IdempotencyKey: "b"},
+	})
+
+	// act
+	err := batch.Validate(10, AccountNumberPolicy{LegacyMaxNumber: 100})
+
+	// assert
+	assert.Equal(t, ErrInvalidTransferBatch, err)
+	assert.Equal(t, TransferBatchLinePending, batch.Lines[0].Status)
+	assert.Equal(t, ErrInvalidAccountNumber.Error(), batch.Lines[1].Error)
+}
+
+func TestTransferBatchRecordFailure(t *testing.T) {
+	testCases := []struct {
+		mode             TransferBatchMode
+		expectedStatuses []TransferBatchLineStatus
+	}{
+		{
+			mode:             TransferBatchAllOrNothing,
+			expectedStatuses: []TransferBatchLineStatus{TransferBatchLineRolledBack, TransferBatchLineFailed, TransferBatchLineNotExecuted},
+		},
+		{
+			mode:             TransferBatchBestEffort,
+			expectedStatuses: []TransferBatchLineStatus{TransferBatchLineSucceeded, TransferBatchLineFailed, TransferBatchLinePending},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.mode), func(t *testing.T) {
+			batch := NewTransferBatch("19", tc.mode, "user-1", []*TransferBatchLine{{}, {}, {}})
+			batch.Lines[0].Status = TransferBatchLineSucceeded
+
+			batch.RecordFailure(batch.Lines[1], errors.New("insufficient funds"))
+
+			for i, status := range tc.expectedStatuses {
+				assert.Equal(t, status, batch.Lines[i].Status)
+			}
+			assert.Equal(t, "insufficient funds", batch.Lines[1].Error)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The night window runs from NightWindowStartHour until NightWindowEndHour of the next day,
+// transfers in it are also capped by the nightly limit.
+const (
+	NightWindowStartHour = 20
+	NightWindowEndHour   = 6
+)
+
+type TransferLimitKind string
+
+const (
+	PerTransactionTransferLimit TransferLimitKind = "per_transaction"
+	DailyTransferLimit          TransferLimitKind = "daily"
+	NightlyTransferLimit        TransferLimitKind = "nightly"
+)
+
+var ErrInvalidTransferLimits = errors.New("transfer limits must not be negative and the per transaction and nightly limits must not exceed the daily limit")
+
+// TransferLimits caps the money transferred out of an account. A zero value has no limit of
+// its own, so an account falls back to the default of its tier for it.
+type TransferLimits struct {
+	PerTransaction int64
+	Daily          int64
+	Nightly        int64
+}
+
+// DefaultTransferLimits are the limits of the accounts by tier, people and companies.
+type DefaultTransferLimits map[DocumentType]TransferLimits
+
+// TransferLimitExceededError is returned when a transfer exceeds one of the limits, Available
+// is how much can still be transferred under it.
+type TransferLimitExceededError struct {
+	Limit     TransferLimitKind
+	Available int64
+}
+
+func (e *TransferLimitExceededError) Error() string {
+	return fmt.Sprintf("%v transfer limit exceeded, available %v", e.Limit, e.Available)
+}
+
+func (l TransferLimits) Validate() error {
+	if l.PerTransaction < 0 || l.Daily < 0 || l.Nightly < 0 {
+		return ErrInvalidTransferLimits
+	}
+
+	if l.Daily > 0 && (l.PerTransaction > l.Daily || l.Nightly > l.Daily) {
+		return ErrInvalidTransferLimits
+	}
+
+	return nil
+}
+
+// Merge fills the limits not set with the ones of defaults.
+func (l TransferLimits) Merge(defaults TransferLimits) TransferLimits {
+	if l.PerTransaction == 0 {
+		l.PerTransaction = defaults.PerTransaction
+	}
+
+	if l.Daily == 0 {
+		l.Daily = defaults.Daily
+	}
+
+	if l.Nightly == 0 {
+		l.Nightly = defaults.Nightly
+	}
+
+	return l
+}
+
+func (l TransferLimits) Unlimited() bool {
+	return l.PerTransaction == 0 && l.Daily == 0 && l.Nightly == 0
+}
+
+// Check verifies a transfer of value given what was already transferred today and in the
+// current night window, atNight tells whether the transfer happens in the night window.
+func (l TransferLimits) Check(value int64, spentToday int64, spentTonight int64, atNight bool) error {
+	if l.PerTransaction > 0 && value > l.PerTransaction {
+		return &TransferLimitExceededError{Limit: PerTransactionTransferLimit, Available: l.PerTransaction}
+	}
+
+	if l.Daily > 0 && spentToday+value > l.Daily {
+		return &TransferLimitExceededError{Limit: DailyTransferLimit, Available: max(l.Daily-spentToday, 0)}
+	}
+
+	if atNight && l.Nightly > 0 && spentTonight+value > l.Nightly {
+		return &TransferLimitExceededError{Limit: NightlyTransferLimit, Available: max(l.Nightly-spentTonight, 0)}
+	}
+
+	return nil
+}
+
+// EffectiveTransferLimits are the limits of the account merged with the default of its tier.
+func (acc *Account) EffectiveTransferLimits(defaults DefaultTransferLimits) TransferLimits {
+	return acc.TransferLimits.Merge(defaults[acc.DocumentType()])
+}
+
+func (acc *Account) SetTransferLimits(limits TransferLimits) error {
+	if acc.Status == AccountStatusClosed {
+		return ErrAccountNotActive
+	}
+
+	err := limits.Validate()
+	if err != nil {
+		return err
+	}
+
+	acc.TransferLimits = limits
+	acc.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func StartOfDay(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
+
+// NightWindowStart returns when the night window containing now started, or false when now
+// is outside of it.
+func NightWindowStart(now time.Time) (time.Time, bool) {
+	switch {
+	case now.Hour() >= NightWindowStartHour:
+		return StartOfDay(now).Add(NightWindowStartHour * time.Hour), true
+	case now.Hour() < NightWindowEndHour:
+		return StartOfDay(now).AddDate(0, 0, -1).Add(NightWindowStartHour * time.Hour), true
+	default:
+		return time.Time{}, false
+	}
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferLimitsValidate(t *testing.T) {
+	assert.NoError(t, TransferLimits{}.Validate())
+	assert.NoError(t, TransferLimits{PerTransaction: 100, Daily: 500, Nightly: 50}.Validate())
+	assert.NoError(t, TransferLimits{PerTransaction: 1000}.Validate())
+	assert.Equal(t, ErrInvalidTransferLimits, TransferLimits{Daily: -1}.Validate())
+	assert.Equal(t, ErrInvalidTransferLimits, TransferLimits{PerTransaction: 600, Daily: 500}.Validate())
+	assert.Equal(t, ErrInvalidTransferLimits, TransferLimits{Daily: 500, Nightly: 600}.Validate())
+}
+
+func TestTransferLimitsCheck(t *testing.T) {
+	limits := TransferLimits{PerTransaction: 100, Daily: 500, Nightly: 150}
+
+	testCases := []struct {
+		testName      string
+		value         int64
+		spentToday    int64
+		spentTonight  int64
+		atNight       bool
+		expectedError error
+	}{
+		{testName: "within limits", value: 100, spentToday: 400},
+		{testName: "above per transaction limit", value: 101,
+			expectedError: &TransferLimitExceededError{Limit: PerTransactionTransferLimit, Available: 100}},
+		{testName: "above daily limit", value: 100, spentToday: 450,
+			expectedError: &TransferLimitExceededError{Limit: DailyTransferLimit, Available: 50}},
+		{testName: "nightly limit ignored during the day", value: 100, spentToday: 100, spentTonight: 100},
+		{testName: "above nightly limit", value: 100, spentToday: 100, spentTonight: 100, atNight: true,
+			expectedError: &TransferLimitExceededError{Limit: NightlyTransferLimit, Available: 50}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			err := limits.Check(tc.value, tc.spentToday, tc.spentTonight, tc.atNight)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestEffectiveTransferLimits(t *testing.T) {
+	defaults := DefaultTransferLimits{
+		DocumentTypePerson:  {PerTransaction: 100, Daily: 500, Nightly: 50},
+		DocumentTypeCompany: {PerTransaction: 1000, Daily: 5000, Nightly: 500},
+	}
+
+	person := NewAccount("19", "01234567890", "John Doe")
+	person.TransferLimits = TransferLimits{Daily: 300}
+	company := NewAccount("27", "11222333000181", "John Doe Company")
+
+	assert.Equal(t, TransferLimits{PerTransaction: 100, Daily: 300, Nightly: 50}, person.EffectiveTransferLimits(defaults))
+	assert.Equal(t, defaults[DocumentTypeCompany], company.EffectiveTransferLimits(defaults))
+	assert.Equal(t, TransferLimits{Daily: 300}, person.EffectiveTransferLimits(nil))
+}
+
+func TestSetTransferLimits_ClosedAccount(t *testing.T) {
+	acc := NewAccount("19", "01234567890", "John Doe")
+	acc.Status = AccountStatusClosed
+
+	err := acc.SetTransferLimits(TransferLimits{Daily: 300})
+
+	assert.Equal(t, ErrAccountNotActive, err)
+}
+
+func TestNightWindowStart(t *testing.T) {
+	evening := time.Date(2024, 3, 10, 21, 30, 0, 0, time.UTC)
+	earlyMorning := time.Date(2024, 3, 11, 5, 59, 0, 0, time.UTC)
+	afternoon := time.Date(2024, 3, 11, 14, 0, 0, 0, time.UTC)
+
+	start, atNight := NightWindowStart(evening)
+	assert.True(t, atNight)
+	assert.Equal(t, time.Date(2024, 3, 10, 20, 0, 0, 0, time.UTC), start)
+
+	start, atNight = NightWindowStart(earlyMorning)
+	assert.True(t, atNight)
+	assert.Equal(t, time.Date(2024, 3, 10, 20, 0, 0, 0, time.UTC), start)
+
+	_, atNight = NightWindowStart(afternoon)
+	assert.False(t, atNight)
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type TransferRequestStatus string
+
+const (
+	TransferRequestPendingApproval TransferRequestStatus = "pending_approval"
+	TransferRequestApproved        TransferRequestStatus = "approved"
+	TransferRequestRejected        TransferRequestStatus = "rejected"
+	TransferRequestExpired         TransferRequestStatus = "expired"
+)
+
+var (
+	ErrTransferRequestNotPending = errors.New("transfer request is not pending approval")
+	ErrTransferRequestSelfReview = errors.New("transfer request must be reviewed by a user other than the requester")
+)
+
+// TransferApprovalPolicy tells which transfers need approval, the ones above Threshold, and
+// for how long a request waits for it. A zero Threshold disables approvals.
+type TransferApprovalPolicy struct {
+	Threshold int64
+	TTL       time.Duration
+}
+
+func (p TransferApprovalPolicy) RequiresApproval(value int64) bool {
+	return p.Threshold > 0 && value > p.Threshold
+}
+
+// TransferRequest is a transfer waiting for a user other than the requester to approve it,
+// the money only moves once it is approved.
+type TransferRequest struct {
+	Id              string
+	FromNumber      string
+	ToNumber        string
+	PixKey          string
+	Value           int64
+	Status          TransferRequestStatus
+	RequestedBy     string
+	ReviewedBy      string
+	RejectionReason string
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewTransferRequest(fromNumber string, toNumber string, pixKey string, value int64, requestedBy string, ttl time.Duration) *TransferRequest {
+	now := time.Now()
+
+	return &TransferRequest{
+		FromNumber:  fromNumber,
+		ToNumber:    toNumber,
+		PixKey:      pixKey,
+		Value:       value,
+		Status:      TransferRequestPendingApproval,
+		RequestedBy: requestedBy,
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (r *TransferRequest) Approve(approvedBy string, now time.Time) error {
+	err := r.review(approvedBy, now)
+	if err != nil {
+		return err
+	}
+
+	r.Status = TransferRequestApproved
+
+	return nil
+}
+
+func (r *TransferRequest) Reject(rejectedBy string, reason string, now time.Time) error {
+	err := r.review(rejectedBy, now)
+	if err != nil {
+		return err
+	}
+
+	r.Status = TransferRequestRejected
+	r.RejectionReason = reason
+
+	return nil
+}
+
+func (r *TransferRequest) review(reviewedBy string, now time.Time) error {
+	if r.Status != TransferRequestPendingApproval || r.Expired(now) {
+		return ErrTransferRequestNotPending
+	}
+
+	if reviewedBy == "" || reviewedBy == r.RequestedBy {
+		return ErrTransferRequestSelfReview
+	}
+
+	r.ReviewedBy = reviewedBy
+	r.UpdatedAt = now
+
+	return nil
+}
+
+// Expired reports whether the request is still pending past its expiry, waiting to be expired.
+func (r *TransferRequest) Expired(now time.Time) bool {
+	return r.Status == TransferRequestPendingApproval && !now.Before(r.ExpiresAt)
+}
+
+func (r *TransferRequest) Expire(now time.Time) error {
+	if !r.Expired(now) {
+		return ErrTransferRequestNotPending
+	}
+
+	r.Status = TransferRequestExpired
+	r.UpdatedAt = now
+
+	return nil
+}
+
+// ExecutionIdempotencyKey is the key of the transfer executed on approval, so approving again
+// after a failure does not transfer twice.
+func (r *TransferRequest) ExecutionIdempotencyKey() string {
+	return fmt.Sprintf("transfer-request-%v", r.Id)
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferApprovalPolicy_RequiresApproval(t *testing.T) {
+	policy := TransferApprovalPolicy{Threshold: 1000}
+
+	assert.False(t, policy.RequiresApproval(1000))
+	assert.True(t, policy.RequiresApproval(1001))
+	assert.False(t, TransferApprovalPolicy{}.RequiresApproval(1001))
+}
+
+func TestTransferRequest_Approve(t *testing.T) {
+	now := time.Now()
+
+	transferRequest := NewTransferRequest("19", "27", "", 5000, "user-1", time.Hour)
+
+	assert.Equal(t, ErrTransferRequestSelfReview, transferRequest.Approve("user-1", now))
+	assert.Equal(t, ErrTransferRequestSelfReview, transferRequest.Approve("", now))
+	assert.Equal(t, ErrTransferRequestNotPending, transferRequest.Approve("user-2", now.Add(2*time.Hour)))
+
+	assert.NoError(t, transferRequest.Approve("user-2", now))
+	assert.Equal(t, TransferRequestApproved, transferRequest.Status)
+	assert.Equal(t, "user-2", transferRequest.ReviewedBy)
+
+	assert.Equal(t, ErrTransferRequestNotPending, transferRequest.Reject("user-3", "late", now))
+}
+
+func TestTransferRequest_Reject(t *testing.T) {
+	transferRequest := NewTransferRequest("19", "27", "", 5000, "user-1", time.Hour)
+
+	assert.NoError(t, transferRequest.Reject("user-2", "unknown payee", time.Now()))
+	assert.Equal(t, TransferRequestRejected, transferRequest.Status)
+	assert.Equal(t, "unknown payee", transferRequest.RejectionReason)
+}
+
+func TestTransferRequest_Expire(t *testing.T) {
+	now := time.Now()
+
+	transferRequest := NewTransferRequest("19", "27", "", 5000, "user-1", time.Hour)
+
+	assert.Equal(t, ErrTransferRequestNotPending, transferRequest.Expire(now))
+	assert.NoError(t, transferRequest.Expire(now.Add(2*time.Hour)))
+	assert.Equal(t, TransferRequestExpired, transferRequest.Status)
+	assert.False(t, transferRequest.Expired(now.Add(2*time.Hour)))
+}
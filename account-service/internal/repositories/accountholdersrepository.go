@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"database/sql"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type AccountHoldersRepositoryInterface interface {
+	CreateAccountHolder(holder *domain.AccountHolder) (string, error)
+	GetAccountHolders(accountNumber string) ([]*domain.AccountHolder, error)
+	UpdatePrimaryHolderName(accountNumber string, name string) error
+}
+
+type AccountHoldersRepository struct {
+	db DBTX
+}
+
+func NewAccountHoldersRepository(db DBTX) *AccountHoldersRepository {
+	return &AccountHoldersRepository{
+		db: db,
+	}
+}
+
+// CreateAccountHolder adds the holder to its account. A document is the primary holder of a
+// single account, so a primary holder whose document already is fails with ErrDocumentInUse.
+func (r *AccountHoldersRepository) CreateAccountHolder(holder *domain.AccountHolder) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO accountholders (AccountNumber, Document, Name, Role, CreatedAt)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (Document) WHERE Role = 'primary' DO NOTHING
+	RETURNING Id`,
+		holder.AccountNumber, holder.Document, holder.Name, holder.Role, holder.CreatedAt).Scan(&id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", domain.ErrDocumentInUse
+		}
+
+		return "", err
+	}
+
+	holder.Id = id
+
+	return id, nil
+}
+
+// GetAccountHolders returns the holders of the account, the primary holder first.
+func (r *AccountHoldersRepository) GetAccountHolders(accountNumber string) ([]*domain.AccountHolder, error) {
+	rows, err := r.db.Query(`
+		SELECT Id, AccountNumber, Document, Name, Role, CreatedAt
+		FROM accountholders
+		WHERE AccountNumber = $1
+		ORDER BY CASE WHEN Role = $2 THEN 0 ELSE 1 END, Id
+	`, accountNumber, domain.AccountHolderRolePrimary)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	holders := []*domain.AccountHolder{}
+	for rows.Next() {
+		var holder domain.AccountHolder
+		err := rows.Scan(&holder.Id, &holder.AccountNumber, &holder.Document, &holder.Name, &holder.Role, &holder.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		holders = append(holders, &holder)
+	}
+
+	return holders, rows.Err()
+}
+
+// UpdatePrimaryHolderName keeps the primary holder named as the account. Accounts opened before
+// joint accounts have no holders recorded, so no row updated is not an error.
+func (r *AccountHoldersRepository) UpdatePrimaryHolderName(accountNumber string, name string) error {
+	_, err := r.db.Exec(`
+	UPDATE accountholders SET Name = $1 WHERE AccountNumber = $2 AND Role = $3`,
+		name, accountNumber, domain.AccountHolderRolePrimary)
+
+	return err
+}
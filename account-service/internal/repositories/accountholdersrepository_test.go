@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAccountHolder_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountHoldersRepository(db)
+
+	holder := &domain.AccountHolder{AccountNumber: "19", Document: "52998224725", Name: "Jane Doe", Role: domain.AccountHolderRoleSecondary, CreatedAt: time.Now()}
+
+	mock.ExpectQuery("INSERT INTO accountholders (.+) RETURNING Id").
+		WithArgs("19", "52998224725", "Jane Doe", domain.AccountHolderRoleSecondary, holder.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("7"))
+
+	// Act
+	id, err := repo.CreateAccountHolder(holder)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "7", id)
+	assert.Equal(t, "7", holder.Id)
+}
+
+func TestCreateAccountHolder_PrimaryDocumentInUse(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountHoldersRepository(db)
+
+	holder := &domain.AccountHolder{AccountNumber: "19", Document: "52998224725", Name: "Jane Doe", Role: domain.AccountHolderRolePrimary, CreatedAt: time.Now()}
+
+	mock.ExpectQuery("INSERT INTO accountholders (.+) ON CONFLICT \\(Document\\) WHERE Role = 'primary' DO NOTHING RETURNING Id").
+		WithArgs("19", "52998224725", "Jane Doe", domain.AccountHolderRolePrimary, holder.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}))
+
+	// Act
+	id, err := repo.CreateAccountHolder(holder)
+
+	// Assert
+	assert.Equal(t, domain.ErrDocumentInUse, err)
+	assert.Empty(t, id)
+	assert.Empty(t, holder.Id)
+}
+
+func TestCreateAccountHolder_Error(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountHoldersRepository(db)
+
+	mock.ExpectQuery("INSERT INTO accountholders (.+) RETURNING Id").
+		WillReturnError(errors.New("generic error"))
+
+	// Act
+	id, err := repo.CreateAccountHolder(&domain.AccountHolder{AccountNumber: "19"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, id)
+}
+
+func TestGetAccountHolders_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountHoldersRepository(db)
+	createdAt := time.Now()
+
+	rows := sqlmock.NewRows([]string{"Id", "AccountNumber", "Document", "Name", "Role", "CreatedAt"}).
+		AddRow("6", "19", "01234567890", "John Doe", "primary", createdAt).
+		AddRow("7", "19", "52998224725", "Jane Doe", "secondary", createdAt)
+
+	mock.ExpectQuery("FROM accountholders WHERE AccountNumber = \\$1 ORDER BY CASE WHEN Role = \\$2 THEN 0 ELSE 1 END, Id").
+		WithArgs("19", domain.AccountHolderRolePrimary).
+		WillReturnRows(rows)
+
+	// Act
+	holders, err := repo.GetAccountHolders("19")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, holders, 2)
+	assert.Equal(t, domain.AccountHolderRolePrimary, holders[0].Role)
+	assert.Equal(t, "52998224725", holders[1].Document)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePrimaryHolderName_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountHoldersRepository(db)
+
+	mock.ExpectExec("UPDATE accountholders SET Name = \\$1 WHERE AccountNumber = \\$2 AND Role = \\$3").
+		WithArgs("John Bidden", "19", domain.AccountHolderRolePrimary).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err = repo.UpdatePrimaryHolderName("19", "John Bidden")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type AccountProfileChangesRepositoryInterface interface {
+	CreateAccountProfileChange(change *domain.AccountProfileChange) (string, error)
+	GetAccountProfileChanges(accountNumber string) ([]*domain.AccountProfileChange, error)
+}
+
+type AccountProfileChangesRepository struct {
+	db DBTX
+}
+
+func NewAccountProfileChangesRepository(db DBTX) *AccountProfileChangesRepository {
+	return &AccountProfileChangesRepository{
+		db: db,
+	}
+}
+
+func (r *AccountProfileChangesRepository) CreateAccountProfileChange(change *domain.AccountProfileChange) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO accountprofilechanges (AccountNumber, Field, OldValue, NewValue, ChangedBy, ChangedAt)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING Id`,
+		change.AccountNumber, change.Field, change.OldValue, change.NewValue, change.ChangedBy, change.ChangedAt).Scan(&id)
+
+	if err != nil {
+		return "", err
+	}
+
+	change.Id = id
+
+	return id, nil
+}
+
+// GetAccountProfileChanges returns the history of the account profile, the latest change first.
+func (r *AccountProfileChangesRepository) GetAccountProfileChanges(accountNumber string) ([]*domain.AccountProfileChange, error) {
+	rows, err := r.db.Query(`
+		SELECT Id, AccountNumber, Field, OldValue, NewValue, ChangedBy, ChangedAt
+		FROM accountprofilechanges
+		WHERE AccountNumber = $1
+		ORDER BY ChangedAt DESC, Id DESC
+	`, accountNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	changes := []*domain.AccountProfileChange{}
+	for rows.Next() {
+		var change domain.AccountProfileChange
+		err := rows.Scan(&change.Id, &change.AccountNumber, &change.Field, &change.OldValue, &change.NewValue, &change.ChangedBy, &change.ChangedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &change)
+	}
+
+	return changes, rows.Err()
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAccountProfileChange_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountProfileChangesRepository(db)
+
+	change := &domain.AccountProfileChange{AccountNumber: "19", Field: "name", OldValue: "John Dii", NewValue: "John Doe", ChangedBy: "client", ChangedAt: time.Now()}
+
+	mock.ExpectQuery("INSERT INTO accountprofilechanges (.+) RETURNING Id").
+		WithArgs("19", "name", "John Dii", "John Doe", "client", change.ChangedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("3"))
+
+	// Act
+	id, err := repo.CreateAccountProfileChange(change)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "3", id)
+	assert.Equal(t, "3", change.Id)
+}
+
+func TestCreateAccountProfileChange_Error(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountProfileChangesRepository(db)
+
+	mock.ExpectQuery("INSERT INTO accountprofilechanges (.+) RETURNING Id").
+		WillReturnError(errors.New("generic error"))
+
+	// Act
+	id, err := repo.CreateAccountProfileChange(&domain.AccountProfileChange{AccountNumber: "19"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, id)
+}
+
+func TestGetAccountProfileChanges_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountProfileChangesRepository(db)
+
+	changedAt := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"Id", "AccountNumber", "Field", "OldValue", "NewValue", "ChangedBy", "ChangedAt"}).
+		AddRow("4", "19", "email", "", "john@example.com", "client", changedAt).
+		AddRow("3", "19", "name", "John Dii", "John Doe", "client", changedAt)
+
+	mock.ExpectQuery("FROM accountprofilechanges WHERE AccountNumber = \\$1 ORDER BY ChangedAt DESC, Id DESC").
+		WithArgs("19").
+		WillReturnRows(rows)
+
+	// Act
+	changes, err := repo.GetAccountProfileChanges("19")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "email", changes[0].Field)
+	assert.Equal(t, "john@example.com", changes[0].NewValue)
+	assert.Equal(t, "John Dii", changes[1].OldValue)
+}
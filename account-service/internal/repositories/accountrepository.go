@@ -2,23 +2,37 @@ package repositories
 
 import (
 	"database/sql"
+	"sort"
+	"time"
 
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
 )
 
 type AccountRepositoryInterface interface {
 	GetAccountByNumber(number string) (*domain.Account, error)
-	GetAccountByDocument(document string) (*domain.Account, error)
-	GetNextAccountNumber() (string, error)
+	GetAccountsByDocument(document string) ([]*domain.Account, error)
+	GetNextAccountSequence() (int64, error)
 	CreateAccount(account *domain.Account) (string, error)
 	UpdateAccountBalance(account *domain.Account) error
+	UpdateAccountStatus(account *domain.Account) error
+	UpdateAccountOverdraftLimit(account *domain.Account) error
+	UpdateAccountTransferLimits(account *domain.Account) error
+	UpdateAccountInterest(account *domain.Account) error
+	GetAccountsToAccrueInterest(day time.Time, limit int) ([]*domain.Account, error)
+	UpdateAccountMaintenanceFee(account *domain.Account) error
+	UpdateAccountProfile(account *domain.Account) error
+	GetAccountsDueMaintenanceFee(monthStart time.Time, limit int) ([]*domain.Account, error)
+	GetAccountsByNumbersForUpdate(numbers ...string) (map[string]*domain.Account, error)
+	WithTransaction(fn func(uow UnitOfWorkInterface) error) error
 }
 
+const accountColumns = `Id, Number, Name, Document, Email, Phone, Address, Type, Currency, Balance, HeldBalance, PocketBalance, OverdraftLimit, PerTransactionLimit, DailyLimit, NightlyLimit, AccruedInterest, InterestAccruedOn, MaintenanceFeeChargedOn, Status, CreatedAt, UpdatedAt`
+
 type AccountRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewAccountRepository(db *sql.DB) *AccountRepository {
+func NewAccountRepository(db DBTX) *AccountRepository {
 	return &AccountRepository{
 		db: db,
 	}
@@ -26,13 +40,12 @@ func NewAccountRepository(db *sql.DB) *AccountRepository {
 
 func (r *AccountRepository) GetAccountByNumber(number string) (*domain.Account, error) {
 	row := r.db.QueryRow(`
-		SELECT Id, Number, Name, Document, Balance, CreatedAt, UpdatedAt
+		SELECT `+accountColumns+`
 		FROM accounts 
 		WHERE Number = $1
 	`, number)
 
-	var account domain.Account
-	err := row.Scan(&account.Id, &account.Number, &account.Name, &account.Document, &account.Balance, &account.CreatedAt, &account.UpdatedAt)
+	account, err := scanAccount(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -41,47 +54,48 @@ func (r *AccountRepository) GetAccountByNumber(number string) (*domain.Account,
 		return nil, err
 	}
 
-	return &account, nil
+	return account, nil
 }
 
-func (r *AccountRepository) GetAccountByDocument(document string) (*domain.Account, error) {
-	row := r.db.QueryRow(`
-		SELECT Id, Number, Name, Document, Balance, CreatedAt, UpdatedAt
-		FROM accounts 
-		WHERE Document = $1
+// GetAccountsByDocument returns every account held by document, as the primary or a secondary
+// holder, the oldest first.
+func (r *AccountRepository) GetAccountsByDocument(document string) ([]*domain.Account, error) {
+	document = domain.NormalizeDocument(document)
+
+	rows, err := r.db.Query(`
+		SELECT `+accountColumns+`
+		FROM accounts
+		WHERE Document = $1 OR Number IN (SELECT AccountNumber FROM accountholders WHERE Document = $1)
+		ORDER BY Id
 	`, document)
 
-	var account domain.Account
-	err := row.Scan(&account.Id, &account.Number, &account.Name, &account.Document, &account.Balance, &account.CreatedAt, &account.UpdatedAt)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-
 		return nil, err
 	}
 
-	return &account, nil
+	return scanAccounts(rows)
 }
 
-func (r *AccountRepository) GetNextAccountNumber() (string, error) {
-	var number string
+// GetNextAccountSequence takes the next value of the account number sequence, values are never
+// handed out twice even when concurrent transactions roll back.
+func (r *AccountRepository) GetNextAccountSequence() (int64, error) {
+	var sequence int64
 
-	row := r.db.QueryRow(`SELECT CAST(coalesce(MAX(Number), '0') AS integer) + 1 FROM accounts`)
+	row := r.db.QueryRow(`SELECT nextval('accounts_number_seq')`)
 
-	err := row.Scan(&number)
+	err := row.Scan(&sequence)
 
-	return number, err
+	return sequence, err
 }
 
 func (r *AccountRepository) CreateAccount(account *domain.Account) (string, error) {
 
 	row := r.db.QueryRow(`
-	INSERT INTO accounts (Number, Name, Document, Balance, CreatedAt, UpdatedAt)
-	VALUES ($1, $2, $3, $4, $5, $6)
+	INSERT INTO accounts (Number, Name, Document, Type, Currency, Balance, OverdraftLimit, Status, CreatedAt, UpdatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	
 	RETURNING Id
-	`, account.Number, account.Name, account.Document, account.Balance, account.CreatedAt, account.UpdatedAt)
+	`, account.Number, account.Name, account.Document, account.Type, account.Currency, account.Balance, account.OverdraftLimit, account.Status, account.CreatedAt, account.UpdatedAt)
 
 	var id string
 	err := row.Scan(&id)
@@ -90,8 +104,148 @@ func (r *AccountRepository) CreateAccount(account *domain.Account) (string, erro
 }
 
 func (r *AccountRepository) UpdateAccountBalance(account *domain.Account) error {
-	result, err := r.db.Exec(`UPDATE accounts SET Balance = $1, UpdatedAt = $2 WHERE Id = $3`,
-		account.Balance, account.UpdatedAt, account.Id)
+	result, err := r.db.Exec(`UPDATE accounts SET Balance = $1, HeldBalance = $2, PocketBalance = $3, UpdatedAt = $4 WHERE Id = $5`,
+		account.Balance, account.HeldBalance, account.PocketBalance, account.UpdatedAt, account.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) UpdateAccountStatus(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET Status = $1, UpdatedAt = $2 WHERE Id = $3`,
+		account.Status, account.UpdatedAt, account.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) UpdateAccountOverdraftLimit(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET OverdraftLimit = $1, UpdatedAt = $2 WHERE Id = $3`,
+		account.OverdraftLimit, account.UpdatedAt, account.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) UpdateAccountTransferLimits(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET PerTransactionLimit = $1, DailyLimit = $2, NightlyLimit = $3, UpdatedAt = $4 WHERE Id = $5`,
+		account.TransferLimits.PerTransaction, account.TransferLimits.Daily, account.TransferLimits.Nightly, account.UpdatedAt, account.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// UpdateAccountInterest stores the interest accrued and not credited yet and the last day accrued.
+func (r *AccountRepository) UpdateAccountInterest(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET AccruedInterest = $1, InterestAccruedOn = $2, UpdatedAt = $3 WHERE Id = $4`,
+		account.AccruedInterest, account.InterestAccruedOn, account.UpdatedAt, account.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetAccountsToAccrueInterest returns the savings accounts not closed whose interest was not
+// accrued up to day yet, accounts that never accrued counting from their creation day.
+func (r *AccountRepository) GetAccountsToAccrueInterest(day time.Time, limit int) ([]*domain.Account, error) {
+	rows, err := r.db.Query(`
+		SELECT `+accountColumns+`
+		FROM accounts
+		WHERE Type = $1 AND Status <> $2 AND COALESCE(InterestAccruedOn, CAST(CreatedAt AS DATE)) < $3
+		ORDER BY Id
+		LIMIT $4
+	`, domain.AccountTypeSavings, domain.AccountStatusClosed, day, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAccounts(rows)
+}
+
+// UpdateAccountMaintenanceFee stores the day the maintenance fee of the month was charged.
+func (r *AccountRepository) UpdateAccountMaintenanceFee(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET MaintenanceFeeChargedOn = $1, UpdatedAt = $2 WHERE Id = $3`,
+		account.MaintenanceFeeChargedOn, account.UpdatedAt, account.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) UpdateAccountProfile(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET Name = $1, Email = $2, Phone = $3, Address = $4, UpdatedAt = $5 WHERE Id = $6`,
+		account.Name, account.Email, account.Phone, account.Address, account.UpdatedAt, account.Id)
 
 	if err != nil {
 		return err
@@ -108,3 +262,104 @@ func (r *AccountRepository) UpdateAccountBalance(account *domain.Account) error
 
 	return nil
 }
+
+// GetAccountsDueMaintenanceFee returns the accounts not closed whose maintenance fee was not
+// charged since monthStart, accounts opened in the month are not charged until the next one.
+func (r *AccountRepository) GetAccountsDueMaintenanceFee(monthStart time.Time, limit int) ([]*domain.Account, error) {
+	rows, err := r.db.Query(`
+		SELECT `+accountColumns+`
+		FROM accounts
+		WHERE Status <> $1 AND COALESCE(MaintenanceFeeChargedOn, CAST(CreatedAt AS DATE)) < $2
+		ORDER BY Id
+		LIMIT $3
+	`, domain.AccountStatusClosed, monthStart, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAccounts(rows)
+}
+
+// GetAccountsByNumbersForUpdate locks the rows of the given accounts with SELECT ... FOR UPDATE.
+// Rows are always locked in ascending number order so concurrent operations touching the
+// same accounts cannot deadlock. Accounts not found are absent from the result.
+func (r *AccountRepository) GetAccountsByNumbersForUpdate(numbers ...string) (map[string]*domain.Account, error) {
+	sorted := make([]string, 0, len(numbers))
+	accounts := make(map[string]*domain.Account, len(numbers))
+
+	for _, number := range numbers {
+		if _, ok := accounts[number]; ok {
+			continue
+		}
+
+		accounts[number] = nil
+		sorted = append(sorted, number)
+	}
+
+	sort.Strings(sorted)
+
+	for _, number := range sorted {
+		row := r.db.QueryRow(`
+			SELECT `+accountColumns+`
+			FROM accounts 
+			WHERE Number = $1
+			FOR UPDATE
+		`, number)
+
+		account, err := scanAccount(row)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				delete(accounts, number)
+				continue
+			}
+
+			return nil, err
+		}
+
+		accounts[number] = account
+	}
+
+	return accounts, nil
+}
+
+func scanAccount(row scanner) (*domain.Account, error) {
+	var account domain.Account
+	var interestAccruedOn, maintenanceFeeChargedOn sql.NullTime
+	err := row.Scan(&account.Id, &account.Number, &account.Name, &account.Document, &account.Email, &account.Phone, &account.Address, &account.Type, &account.Currency, &account.Balance,
+		&account.HeldBalance, &account.PocketBalance, &account.OverdraftLimit, &account.TransferLimits.PerTransaction, &account.TransferLimits.Daily,
+		&account.TransferLimits.Nightly, &account.AccruedInterest, &interestAccruedOn, &maintenanceFeeChargedOn, &account.Status, &account.CreatedAt, &account.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	account.InterestAccruedOn = interestAccruedOn.Time
+	account.MaintenanceFeeChargedOn = maintenanceFeeChargedOn.Time
+
+	return &account, nil
+}
+
+func scanAccounts(rows *sql.Rows) ([]*domain.Account, error) {
+	defer rows.Close()
+
+	accounts := []*domain.Account{}
+
+	for rows.Next() {
+		account, err := scanAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+func (r *AccountRepository) WithTransaction(fn func(uow UnitOfWorkInterface) error) error {
+	return runInTransaction(r.db, fn)
+}
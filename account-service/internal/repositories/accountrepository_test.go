@@ -17,6 +17,8 @@ func getExpectedAccount() *domain.Account {
 		Number:    "123456789",
 		Name:      "John Doe",
 		Document:  "12345678901",
+		Type:      domain.AccountTypeChecking,
+		Currency:  domain.DefaultCurrency,
 		Balance:   1000.0,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -31,9 +33,9 @@ func TestGetAccountByNumber_Success(t *testing.T) {
 	repo := NewAccountRepository(db)
 	expectedAccount := getExpectedAccount()
 
-	rows := sqlmock.NewRows([]string{"Id", "Number", "Name", "Document", "Balance", "CreatedAt", "UpdatedAt"}).
-		AddRow(expectedAccount.Id, expectedAccount.Number, expectedAccount.Name, expectedAccount.Document, expectedAccount.Balance, expectedAccount.CreatedAt, expectedAccount.UpdatedAt)
-	mock.ExpectQuery("SELECT Id, Number, Name, Document, Balance, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1").
+	rows := sqlmock.NewRows([]string{"Id", "Number", "Name", "Document", "Email", "Phone", "Address", "Type", "Currency", "Balance", "HeldBalance", "PocketBalance", "OverdraftLimit", "PerTransactionLimit", "DailyLimit", "NightlyLimit", "AccruedInterest", "InterestAccruedOn", "MaintenanceFeeChargedOn", "Status", "CreatedAt", "UpdatedAt"}).
+		AddRow(expectedAccount.Id, expectedAccount.Number, expectedAccount.Name, expectedAccount.Document, expectedAccount.Email, expectedAccount.Phone, expectedAccount.Address, expectedAccount.Type, expectedAccount.Currency, expectedAccount.Balance, expectedAccount.HeldBalance, expectedAccount.PocketBalance, expectedAccount.OverdraftLimit, expectedAccount.TransferLimits.PerTransaction, expectedAccount.TransferLimits.Daily, expectedAccount.TransferLimits.Nightly, expectedAccount.AccruedInterest, nil, nil, expectedAccount.Status, expectedAccount.CreatedAt, expectedAccount.UpdatedAt)
+	mock.ExpectQuery("SELECT Id, Number, Name, Document, Email, Phone, Address, Type, Currency, Balance, HeldBalance, PocketBalance, OverdraftLimit, PerTransactionLimit, DailyLimit, NightlyLimit, AccruedInterest, InterestAccruedOn, MaintenanceFeeChargedOn, Status, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1").
 		WithArgs(expectedAccount.Number).
 		WillReturnRows(rows)
 
@@ -50,7 +52,7 @@ func TestGetAccountByNumber_NotFound(t *testing.T) {
 
 	repo := NewAccountRepository(db)
 
-	mock.ExpectQuery("SELECT Id, Number, Name, Document, Balance, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1").
+	mock.ExpectQuery("SELECT Id, Number, Name, Document, Email, Phone, Address, Type, Currency, Balance, HeldBalance, PocketBalance, OverdraftLimit, PerTransactionLimit, DailyLimit, NightlyLimit, AccruedInterest, InterestAccruedOn, MaintenanceFeeChargedOn, Status, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1").
 		WithArgs("987654321").
 		WillReturnError(sql.ErrNoRows)
 
@@ -66,7 +68,7 @@ func TestGetAccountByNumber_DBError(t *testing.T) {
 
 	repo := NewAccountRepository(db)
 
-	mock.ExpectQuery("SELECT Id, Number, Name, Document, Balance, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1").
+	mock.ExpectQuery("SELECT Id, Number, Name, Document, Email, Phone, Address, Type, Currency, Balance, HeldBalance, PocketBalance, OverdraftLimit, PerTransactionLimit, DailyLimit, NightlyLimit, AccruedInterest, InterestAccruedOn, MaintenanceFeeChargedOn, Status, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1").
 		WithArgs("123456789").
 		WillReturnError(sql.ErrConnDone)
 
@@ -85,10 +87,10 @@ func TestUpdateAccountBalance_ErrOnExecute(t *testing.T) {
 
 	acc := domain.NewAccount("1", "12345678901", "John Dii")
 	acc.Id = "13"
-	acc.Deposit(1000)
+	acc.Deposit(acc.Money(1000))
 
-	mock.ExpectExec("UPDATE accounts SET Balance = \\$1, UpdatedAt = \\$2 WHERE Id = \\$3").
-		WithArgs(acc.Balance, acc.UpdatedAt, acc.Id).
+	mock.ExpectExec("UPDATE accounts SET Balance = \\$1, HeldBalance = \\$2, PocketBalance = \\$3, UpdatedAt = \\$4 WHERE Id = \\$5").
+		WithArgs(acc.Balance, acc.HeldBalance, acc.PocketBalance, acc.UpdatedAt, acc.Id).
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
@@ -109,10 +111,10 @@ func TestUpdateAccountBalance_NotRowsAffected(t *testing.T) {
 
 	acc := domain.NewAccount("1", "12345678901", "John Dii")
 	acc.Id = "13"
-	acc.Deposit(1000)
+	acc.Deposit(acc.Money(1000))
 
-	mock.ExpectExec("UPDATE accounts SET Balance = \\$1, UpdatedAt = \\$2 WHERE Id = \\$3").
-		WithArgs(acc.Balance, acc.UpdatedAt, acc.Id).
+	mock.ExpectExec("UPDATE accounts SET Balance = \\$1, HeldBalance = \\$2, PocketBalance = \\$3, UpdatedAt = \\$4 WHERE Id = \\$5").
+		WithArgs(acc.Balance, acc.HeldBalance, acc.PocketBalance, acc.UpdatedAt, acc.Id).
 		WillReturnResult(sqlmock.NewResult(1, 0))
 
 	// Act
@@ -133,10 +135,10 @@ func TestUpdateAccountBalance_Success(t *testing.T) {
 
 	acc := domain.NewAccount("1", "12345678901", "John Dii")
 	acc.Id = "13"
-	acc.Deposit(1000)
+	acc.Deposit(acc.Money(1000))
 
-	mock.ExpectExec("UPDATE accounts SET Balance = \\$1, UpdatedAt = \\$2 WHERE Id = \\$3").
-		WithArgs(acc.Balance, acc.UpdatedAt, acc.Id).
+	mock.ExpectExec("UPDATE accounts SET Balance = \\$1, HeldBalance = \\$2, PocketBalance = \\$3, UpdatedAt = \\$4 WHERE Id = \\$5").
+		WithArgs(acc.Balance, acc.HeldBalance, acc.PocketBalance, acc.UpdatedAt, acc.Id).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Act
@@ -145,3 +147,382 @@ func TestUpdateAccountBalance_Success(t *testing.T) {
 	// Assert
 	assert.Nil(t, err)
 }
+
+func TestGetAccountsByNumbersForUpdate_LocksInNumberOrder(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+	expectedAccount := getExpectedAccount()
+
+	columns := []string{"Id", "Number", "Name", "Document", "Email", "Phone", "Address", "Type", "Currency", "Balance", "HeldBalance", "PocketBalance", "OverdraftLimit", "PerTransactionLimit", "DailyLimit", "NightlyLimit", "AccruedInterest", "InterestAccruedOn", "MaintenanceFeeChargedOn", "Status", "CreatedAt", "UpdatedAt"}
+
+	mock.ExpectQuery("SELECT Id, Number, Name, Document, Email, Phone, Address, Type, Currency, Balance, HeldBalance, PocketBalance, OverdraftLimit, PerTransactionLimit, DailyLimit, NightlyLimit, AccruedInterest, InterestAccruedOn, MaintenanceFeeChargedOn, Status, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1 FOR UPDATE").
+		WithArgs("111").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT Id, Number, Name, Document, Email, Phone, Address, Type, Currency, Balance, HeldBalance, PocketBalance, OverdraftLimit, PerTransactionLimit, DailyLimit, NightlyLimit, AccruedInterest, InterestAccruedOn, MaintenanceFeeChargedOn, Status, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1 FOR UPDATE").
+		WithArgs(expectedAccount.Number).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(expectedAccount.Id, expectedAccount.Number, expectedAccount.Name, expectedAccount.Document, expectedAccount.Email, expectedAccount.Phone, expectedAccount.Address, expectedAccount.Type, expectedAccount.Currency, expectedAccount.Balance, expectedAccount.HeldBalance, expectedAccount.PocketBalance, expectedAccount.OverdraftLimit, expectedAccount.TransferLimits.PerTransaction, expectedAccount.TransferLimits.Daily, expectedAccount.TransferLimits.Nightly, expectedAccount.AccruedInterest, nil, nil, expectedAccount.Status, expectedAccount.CreatedAt, expectedAccount.UpdatedAt))
+
+	// Act
+	accounts, err := repo.GetAccountsByNumbersForUpdate(expectedAccount.Number, "111", expectedAccount.Number)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 1)
+	assert.Equal(t, expectedAccount, accounts[expectedAccount.Number])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAccountsByNumbersForUpdate_DBError(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	mock.ExpectQuery("SELECT Id, Number, Name, Document, Email, Phone, Address, Type, Currency, Balance, HeldBalance, PocketBalance, OverdraftLimit, PerTransactionLimit, DailyLimit, NightlyLimit, AccruedInterest, InterestAccruedOn, MaintenanceFeeChargedOn, Status, CreatedAt, UpdatedAt FROM accounts WHERE Number = \\$1 FOR UPDATE").
+		WithArgs("123").
+		WillReturnError(sql.ErrConnDone)
+
+	// Act
+	accounts, err := repo.GetAccountsByNumbersForUpdate("123")
+
+	// Assert
+	assert.Equal(t, sql.ErrConnDone, err)
+	assert.Nil(t, accounts)
+}
+
+func TestWithTransaction_Commit(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("1", "12345678901", "John Dii")
+	acc.Id = "13"
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE accounts SET Balance = \\$1, HeldBalance = \\$2, PocketBalance = \\$3, UpdatedAt = \\$4 WHERE Id = \\$5").
+		WithArgs(acc.Balance, acc.HeldBalance, acc.PocketBalance, acc.UpdatedAt, acc.Id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err = repo.WithTransaction(func(uow UnitOfWorkInterface) error {
+		return uow.AccountRepository().UpdateAccountBalance(acc)
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWithTransaction_RollbackOnError(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("1", "12345678901", "John Dii")
+	acc.Id = "13"
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE accounts SET Balance = \\$1, HeldBalance = \\$2, PocketBalance = \\$3, UpdatedAt = \\$4 WHERE Id = \\$5").
+		WithArgs(acc.Balance, acc.HeldBalance, acc.PocketBalance, acc.UpdatedAt, acc.Id).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	// Act
+	err = repo.WithTransaction(func(uow UnitOfWorkInterface) error {
+		return uow.AccountRepository().UpdateAccountBalance(acc)
+	})
+
+	// Assert
+	assert.Equal(t, sql.ErrConnDone, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAccountStatus_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("1", "12345678901", "John Dii")
+	acc.Id = "13"
+	acc.ChangeStatus(domain.AccountStatusBlocked)
+
+	mock.ExpectExec("UPDATE accounts SET Status = \\$1, UpdatedAt = \\$2 WHERE Id = \\$3").
+		WithArgs(acc.Status, acc.UpdatedAt, acc.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err = repo.UpdateAccountStatus(acc)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestUpdateAccountStatus_NotRowsAffected(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("1", "12345678901", "John Dii")
+	acc.Id = "13"
+
+	mock.ExpectExec("UPDATE accounts SET Status = \\$1, UpdatedAt = \\$2 WHERE Id = \\$3").
+		WithArgs(acc.Status, acc.UpdatedAt, acc.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err = repo.UpdateAccountStatus(acc)
+
+	// Assert
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestUpdateAccountOverdraftLimit_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("1", "12345678901", "John Dii")
+	acc.Id = "13"
+	acc.SetOverdraftLimit(500)
+
+	mock.ExpectExec("UPDATE accounts SET OverdraftLimit = \\$1, UpdatedAt = \\$2 WHERE Id = \\$3").
+		WithArgs(acc.OverdraftLimit, acc.UpdatedAt, acc.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err = repo.UpdateAccountOverdraftLimit(acc)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestGetNextAccountSequence_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	mock.ExpectQuery("SELECT nextval\\('accounts_number_seq'\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"nextval"}).AddRow(42))
+
+	sequence, err := repo.GetNextAccountSequence()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), sequence)
+}
+
+func TestUpdateAccountTransferLimits_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("19", "01234567890", "John Dii")
+	acc.Id = "13"
+	acc.SetTransferLimits(domain.TransferLimits{PerTransaction: 100, Daily: 500, Nightly: 50})
+
+	mock.ExpectExec("UPDATE accounts SET PerTransactionLimit = \\$1, DailyLimit = \\$2, NightlyLimit = \\$3, UpdatedAt = \\$4 WHERE Id = \\$5").
+		WithArgs(int64(100), int64(500), int64(50), acc.UpdatedAt, acc.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err = repo.UpdateAccountTransferLimits(acc)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestUpdateAccountInterest_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("19", "01234567890", "John Dii")
+	acc.Id = "13"
+	acc.AccruedInterest = 42
+	acc.InterestAccruedOn = time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec("UPDATE accounts SET AccruedInterest = \\$1, InterestAccruedOn = \\$2, UpdatedAt = \\$3 WHERE Id = \\$4").
+		WithArgs(int64(42), acc.InterestAccruedOn, acc.UpdatedAt, acc.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err = repo.UpdateAccountInterest(acc)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestGetAccountsToAccrueInterest_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+	expectedAccount := getExpectedAccount()
+	expectedAccount.Type = domain.AccountTypeSavings
+	expectedAccount.AccruedInterest = 15
+	expectedAccount.InterestAccruedOn = time.Date(2024, 5, 9, 0, 0, 0, 0, time.UTC)
+	day := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"Id", "Number", "Name", "Document", "Email", "Phone", "Address", "Type", "Currency", "Balance", "HeldBalance", "PocketBalance", "OverdraftLimit", "PerTransactionLimit", "DailyLimit", "NightlyLimit", "AccruedInterest", "InterestAccruedOn", "MaintenanceFeeChargedOn", "Status", "CreatedAt", "UpdatedAt"}).
+		AddRow(expectedAccount.Id, expectedAccount.Number, expectedAccount.Name, expectedAccount.Document, expectedAccount.Email, expectedAccount.Phone, expectedAccount.Address, expectedAccount.Type, expectedAccount.Currency, expectedAccount.Balance, expectedAccount.HeldBalance, expectedAccount.PocketBalance, expectedAccount.OverdraftLimit, expectedAccount.TransferLimits.PerTransaction, expectedAccount.TransferLimits.Daily, expectedAccount.TransferLimits.Nightly, expectedAccount.AccruedInterest, expectedAccount.InterestAccruedOn, nil, expectedAccount.Status, expectedAccount.CreatedAt, expectedAccount.UpdatedAt)
+	mock.ExpectQuery("FROM accounts WHERE Type = \\$1 AND Status <> \\$2 AND COALESCE\\(InterestAccruedOn, CAST\\(CreatedAt AS DATE\\)\\) < \\$3 ORDER BY Id LIMIT \\$4").
+		WithArgs(domain.AccountTypeSavings, domain.AccountStatusClosed, day, 100).
+		WillReturnRows(rows)
+
+	// Act
+	accounts, err := repo.GetAccountsToAccrueInterest(day, 100)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.Account{expectedAccount}, accounts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAccountMaintenanceFee_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("21", "01234567890", "John Dii")
+	acc.Id = "13"
+	acc.MaintenanceFeeChargedOn = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec("UPDATE accounts SET MaintenanceFeeChargedOn = \\$1, UpdatedAt = \\$2 WHERE Id = \\$3").
+		WithArgs(acc.MaintenanceFeeChargedOn, acc.UpdatedAt, acc.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err = repo.UpdateAccountMaintenanceFee(acc)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestUpdateAccountProfile_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("21", "01234567890", "John Dii")
+	acc.Id = "13"
+	acc.Email = "john@example.com"
+	acc.Phone = "+5511912345678"
+
+	mock.ExpectExec("UPDATE accounts SET Name = \\$1, Email = \\$2, Phone = \\$3, Address = \\$4, UpdatedAt = \\$5 WHERE Id = \\$6").
+		WithArgs(acc.Name, acc.Email, acc.Phone, acc.Address, acc.UpdatedAt, acc.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err = repo.UpdateAccountProfile(acc)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestUpdateAccountProfile_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("21", "01234567890", "John Dii")
+	acc.Id = "13"
+
+	mock.ExpectExec("UPDATE accounts SET Name = (.+) WHERE Id = (.+)").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err = repo.UpdateAccountProfile(acc)
+
+	// Assert
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestGetAccountsDueMaintenanceFee_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+	expectedAccount := getExpectedAccount()
+	expectedAccount.MaintenanceFeeChargedOn = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"Id", "Number", "Name", "Document", "Email", "Phone", "Address", "Type", "Currency", "Balance", "HeldBalance", "PocketBalance", "OverdraftLimit", "PerTransactionLimit", "DailyLimit", "NightlyLimit", "AccruedInterest", "InterestAccruedOn", "MaintenanceFeeChargedOn", "Status", "CreatedAt", "UpdatedAt"}).
+		AddRow(expectedAccount.Id, expectedAccount.Number, expectedAccount.Name, expectedAccount.Document, expectedAccount.Email, expectedAccount.Phone, expectedAccount.Address, expectedAccount.Type, expectedAccount.Currency, expectedAccount.Balance, expectedAccount.HeldBalance, expectedAccount.PocketBalance, expectedAccount.OverdraftLimit, expectedAccount.TransferLimits.PerTransaction, expectedAccount.TransferLimits.Daily, expectedAccount.TransferLimits.Nightly, expectedAccount.AccruedInterest, nil, expectedAccount.MaintenanceFeeChargedOn, expectedAccount.Status, expectedAccount.CreatedAt, expectedAccount.UpdatedAt)
+	mock.ExpectQuery("FROM accounts WHERE Status <> \\$1 AND COALESCE\\(MaintenanceFeeChargedOn, CAST\\(CreatedAt AS DATE\\)\\) < \\$2 ORDER BY Id LIMIT \\$3").
+		WithArgs(domain.AccountStatusClosed, monthStart, 100).
+		WillReturnRows(rows)
+
+	// Act
+	accounts, err := repo.GetAccountsDueMaintenanceFee(monthStart, 100)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.Account{expectedAccount}, accounts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAccountsByDocument_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+	expectedAccount := getExpectedAccount()
+
+	rows := sqlmock.NewRows([]string{"Id", "Number", "Name", "Document", "Email", "Phone", "Address", "Type", "Currency", "Balance", "HeldBalance", "PocketBalance", "OverdraftLimit", "PerTransactionLimit", "DailyLimit", "NightlyLimit", "AccruedInterest", "InterestAccruedOn", "MaintenanceFeeChargedOn", "Status", "CreatedAt", "UpdatedAt"}).
+		AddRow(expectedAccount.Id, expectedAccount.Number, expectedAccount.Name, expectedAccount.Document, expectedAccount.Email, expectedAccount.Phone, expectedAccount.Address, expectedAccount.Type, expectedAccount.Currency, expectedAccount.Balance, expectedAccount.HeldBalance, expectedAccount.PocketBalance, expectedAccount.OverdraftLimit, expectedAccount.TransferLimits.PerTransaction, expectedAccount.TransferLimits.Daily, expectedAccount.TransferLimits.Nightly, expectedAccount.AccruedInterest, nil, nil, expectedAccount.Status, expectedAccount.CreatedAt, expectedAccount.UpdatedAt)
+	mock.ExpectQuery("FROM accounts WHERE Document = \\$1 OR Number IN \\(SELECT AccountNumber FROM accountholders WHERE Document = \\$1\\) ORDER BY Id").
+		WithArgs("52998224725").
+		WillReturnRows(rows)
+
+	// Act
+	accounts, err := repo.GetAccountsByDocument("529.982.247-25")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.Account{expectedAccount}, accounts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
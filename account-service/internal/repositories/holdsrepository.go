@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type HoldsRepositoryInterface interface {
+	CreateHold(hold *domain.Hold) (string, error)
+	GetHold(accountNumber string, id string) (*domain.Hold, error)
+	GetHoldsByAccount(accountNumber string) ([]*domain.Hold, error)
+	GetExpiredHolds(now time.Time, limit int) ([]*domain.Hold, error)
+	UpdateHold(hold *domain.Hold) error
+}
+
+type HoldsRepository struct {
+	db DBTX
+}
+
+func NewHoldsRepository(db DBTX) *HoldsRepository {
+	return &HoldsRepository{
+		db: db,
+	}
+}
+
+const holdColumns = `Id, AccountNumber, Amount, CapturedAmount, Description, Status, ExpiresAt, CreatedAt, UpdatedAt`
+
+func (r *HoldsRepository) CreateHold(hold *domain.Hold) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO holds (AccountNumber, Amount, CapturedAmount, Description, Status, ExpiresAt, CreatedAt, UpdatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	RETURNING Id`,
+		hold.AccountNumber, hold.Amount, hold.CapturedAmount, hold.Description, hold.Status, hold.ExpiresAt,
+		hold.CreatedAt, hold.UpdatedAt).Scan(&id)
+
+	if err != nil {
+		return "", err
+	}
+
+	hold.Id = id
+
+	return id, nil
+}
+
+func (r *HoldsRepository) GetHold(accountNumber string, id string) (*domain.Hold, error) {
+	row := r.db.QueryRow(`
+		SELECT `+holdColumns+`
+		FROM holds
+		WHERE AccountNumber = $1 AND Id = $2
+	`, accountNumber, id)
+
+	hold, err := scanHold(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (r *HoldsRepository) GetHoldsByAccount(accountNumber string) ([]*domain.Hold, error) {
+	rows, err := r.db.Query(`
+		SELECT `+holdColumns+`
+		FROM holds
+		WHERE AccountNumber = $1
+		ORDER BY Id
+	`, accountNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanHolds(rows)
+}
+
+// GetExpiredHolds returns the active holds past their expiry, the oldest first.
+func (r *HoldsRepository) GetExpiredHolds(now time.Time, limit int) ([]*domain.Hold, error) {
+	rows, err := r.db.Query(`
+		SELECT `+holdColumns+`
+		FROM holds
+		WHERE Status = $1 AND ExpiresAt <= $2
+		ORDER BY ExpiresAt
+		LIMIT $3
+	`, domain.HoldStatusActive, now, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanHolds(rows)
+}
+
+func (r *HoldsRepository) UpdateHold(hold *domain.Hold) error {
+	result, err := r.db.Exec(`UPDATE holds SET CapturedAmount = $1, Status = $2, UpdatedAt = $3 WHERE Id = $4`,
+		hold.CapturedAmount, hold.Status, hold.UpdatedAt, hold.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanHold(row scanner) (*domain.Hold, error) {
+	var hold domain.Hold
+	err := row.Scan(&hold.Id, &hold.AccountNumber, &hold.Amount, &hold.CapturedAmount, &hold.Description, &hold.Status,
+		&hold.ExpiresAt, &hold.CreatedAt, &hold.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hold, nil
+}
+
+func scanHolds(rows *sql.Rows) ([]*domain.Hold, error) {
+	defer rows.Close()
+
+	holds := []*domain.Hold{}
+
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		holds = append(holds, hold)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
@@ -0,0 +1,123 @@
+package repositories_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var holdColumns = []string{"Id", "AccountNumber", "Amount", "CapturedAmount", "Description", "Status", "ExpiresAt", "CreatedAt", "UpdatedAt"}
+
+func TestCreateHold_Success(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewHoldsRepository(db)
+
+	now := time.Now()
+	hold := &domain.Hold{AccountNumber: "19", Amount: 100, Description: "card", Status: domain.HoldStatusActive,
+		ExpiresAt: now.Add(time.Hour), CreatedAt: now, UpdatedAt: now}
+
+	mock.ExpectQuery("INSERT INTO holds (.+) RETURNING Id").
+		WithArgs(hold.AccountNumber, hold.Amount, hold.CapturedAmount, hold.Description, hold.Status, hold.ExpiresAt,
+			hold.CreatedAt, hold.UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("4"))
+
+	// Act
+	id, err := repo.CreateHold(hold)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "4", id)
+	assert.Equal(t, "4", hold.Id)
+}
+
+func TestGetHold_Found(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewHoldsRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT (.+) FROM holds WHERE AccountNumber = \\$1 AND Id = \\$2").
+		WithArgs("19", "4").
+		WillReturnRows(sqlmock.NewRows(holdColumns).AddRow("4", "19", 100, 0, "card", "active", now, now, now))
+
+	// Act
+	hold, err := repo.GetHold("19", "4")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "4", hold.Id)
+	assert.Equal(t, int64(100), hold.Amount)
+	assert.Equal(t, domain.HoldStatusActive, hold.Status)
+}
+
+func TestGetHold_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewHoldsRepository(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM holds WHERE AccountNumber = \\$1 AND Id = \\$2").
+		WithArgs("19", "4").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	hold, err := repo.GetHold("19", "4")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, hold)
+}
+
+func TestGetExpiredHolds_Success(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewHoldsRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT (.+) FROM holds WHERE Status = \\$1 AND ExpiresAt <= \\$2 ORDER BY ExpiresAt LIMIT \\$3").
+		WithArgs(domain.HoldStatusActive, now, 10).
+		WillReturnRows(sqlmock.NewRows(holdColumns).
+			AddRow("4", "19", 100, 0, "", "active", now, now, now).
+			AddRow("5", "27", 200, 0, "", "active", now, now, now))
+
+	// Act
+	holds, err := repo.GetExpiredHolds(now, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, holds, 2)
+	assert.Equal(t, "27", holds[1].AccountNumber)
+}
+
+func TestUpdateHold_NotRowsAffected(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewHoldsRepository(db)
+
+	hold := &domain.Hold{Id: "4", CapturedAmount: 100, Status: domain.HoldStatusCaptured, UpdatedAt: time.Now()}
+
+	mock.ExpectExec("UPDATE holds SET CapturedAmount = \\$1, Status = \\$2, UpdatedAt = \\$3 WHERE Id = \\$4").
+		WithArgs(hold.CapturedAmount, hold.Status, hold.UpdatedAt, hold.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.UpdateHold(hold)
+
+	// Assert
+	assert.Equal(t, sql.ErrNoRows, err)
+}
@@ -2,41 +2,54 @@ package repositories
 
 import (
 	"database/sql"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
 )
 
 type IdempotencyKeysRepositoryInterface interface {
-	HasKey(key string) (bool, error)
-	CreateKey(key string) error
+	GetKey(scope string, subject string, key string) (*domain.IdempotencyKey, error)
+	CreateKey(idempotencyKey *domain.IdempotencyKey) error
+	DeleteKeysCreatedBefore(createdBefore time.Time) (int64, error)
 }
 
 type IdempotencyKeysRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewIdempotencyKeysRepository(db *sql.DB) *IdempotencyKeysRepository {
+func NewIdempotencyKeysRepository(db DBTX) *IdempotencyKeysRepository {
 	return &IdempotencyKeysRepository{
 		db: db,
 	}
 }
 
-func (r *IdempotencyKeysRepository) HasKey(key string) (bool, error) {
-	tmp := ""
-	err := r.db.QueryRow(`SELECT 1 FROM idempotencykeys WHERE Key = $1`, key).Scan(&tmp)
+func (r *IdempotencyKeysRepository) GetKey(scope string, subject string, key string) (*domain.IdempotencyKey, error) {
+	row := r.db.QueryRow(`
+		SELECT Key, Scope, Subject, Operation, RequestHash, StatusCode, Response, CreatedAt
+		FROM idempotencykeys
+		WHERE Scope = $1 AND Subject = $2 AND Key = $3
+	`, scope, subject, key)
 
+	var idempotencyKey domain.IdempotencyKey
+	err := row.Scan(&idempotencyKey.Key, &idempotencyKey.Scope, &idempotencyKey.Subject, &idempotencyKey.Operation, &idempotencyKey.RequestHash,
+		&idempotencyKey.StatusCode, &idempotencyKey.Response, &idempotencyKey.CreatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return false, nil
+			return nil, nil
 		}
 
-		return false, err
+		return nil, err
 	}
 
-	return true, nil
+	return &idempotencyKey, nil
 }
 
-func (r *IdempotencyKeysRepository) CreateKey(key string) error {
+func (r *IdempotencyKeysRepository) CreateKey(idempotencyKey *domain.IdempotencyKey) error {
 	result, err := r.db.Exec(`
-	INSERT INTO idempotencykeys (Key, CreatedAt) VALUES ($1, CURRENT_TIMESTAMP)`, key)
+	INSERT INTO idempotencykeys (Key, Scope, Subject, Operation, RequestHash, StatusCode, Response, CreatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		idempotencyKey.Key, idempotencyKey.Scope, idempotencyKey.Subject, idempotencyKey.Operation, idempotencyKey.RequestHash,
+		idempotencyKey.StatusCode, idempotencyKey.Response, idempotencyKey.CreatedAt)
 
 	if err != nil {
 		return err
@@ -53,3 +66,12 @@ func (r *IdempotencyKeysRepository) CreateKey(key string) error {
 
 	return nil
 }
+
+func (r *IdempotencyKeysRepository) DeleteKeysCreatedBefore(createdBefore time.Time) (int64, error) {
+	result, err := r.db.Exec(`DELETE FROM idempotencykeys WHERE CreatedAt < $1`, createdBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -3,14 +3,16 @@ package repositories_test
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestHasKey_Found(t *testing.T) {
+func TestGetKey_Found(t *testing.T) {
 	// Arrange
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
@@ -18,21 +20,31 @@ func TestHasKey_Found(t *testing.T) {
 	repo := repositories.NewIdempotencyKeysRepository(db)
 
 	expectedKey, _ := uuid.NewRandom()
+	createdAt := time.Now()
 
-	rows := sqlmock.NewRows([]string{"tmp"}).AddRow(1)
-	mock.ExpectQuery("SELECT 1 FROM idempotencykeys WHERE Key = \\$1").
-		WithArgs(expectedKey.String()).
+	rows := sqlmock.NewRows([]string{"Key", "Scope", "Subject", "Operation", "RequestHash", "StatusCode", "Response", "CreatedAt"}).
+		AddRow(expectedKey.String(), "123", "bob", "deposit", "hash", 204, "", createdAt)
+	mock.ExpectQuery("SELECT (.+) FROM idempotencykeys WHERE Scope = \\$1 AND Subject = \\$2 AND Key = \\$3").
+		WithArgs("123", "bob", expectedKey.String()).
 		WillReturnRows(rows)
 
 	// Act
-	result, err := repo.HasKey(expectedKey.String())
+	result, err := repo.GetKey("123", "bob", expectedKey.String())
 
 	// Assert
 	assert.NoError(t, err)
-	assert.True(t, result)
+	assert.Equal(t, &domain.IdempotencyKey{
+		Key:         expectedKey.String(),
+		Scope:       "123",
+		Subject:     "bob",
+		Operation:   "deposit",
+		RequestHash: "hash",
+		StatusCode:  204,
+		CreatedAt:   createdAt,
+	}, result)
 }
 
-func TestHasKey_NotFound(t *testing.T) {
+func TestGetKey_NotFound(t *testing.T) {
 	// Arrange
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
@@ -41,16 +53,46 @@ func TestHasKey_NotFound(t *testing.T) {
 
 	expectedKey, _ := uuid.NewRandom()
 
-	mock.ExpectQuery("SELECT 1 FROM idempotencykeys WHERE Key = \\$1").
-		WithArgs(expectedKey.String()).
+	mock.ExpectQuery("SELECT (.+) FROM idempotencykeys WHERE Scope = \\$1 AND Subject = \\$2 AND Key = \\$3").
+		WithArgs("123", "bob", expectedKey.String()).
 		WillReturnError(sql.ErrNoRows)
 
 	// Act
-	result, err := repo.HasKey(expectedKey.String())
+	result, err := repo.GetKey("123", "bob", expectedKey.String())
 
 	// Assert
 	assert.NoError(t, err)
-	assert.False(t, result)
+	assert.Nil(t, result)
+}
+
+func TestGetKey_DBError(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewIdempotencyKeysRepository(db)
+
+	expectedKey, _ := uuid.NewRandom()
+
+	mock.ExpectQuery("SELECT (.+) FROM idempotencykeys WHERE Scope = \\$1 AND Subject = \\$2 AND Key = \\$3").
+		WithArgs("123", "bob", expectedKey.String()).
+		WillReturnError(sql.ErrConnDone)
+
+	// Act
+	result, err := repo.GetKey("123", "bob", expectedKey.String())
+
+	// Assert
+	assert.Equal(t, sql.ErrConnDone, err)
+	assert.Nil(t, result)
+}
+
+func newIdempotencyKey(t *testing.T) *domain.IdempotencyKey {
+	expectedKey, _ := uuid.NewRandom()
+
+	idempotencyKey, err := domain.NewIdempotencyKey("123", "bob", expectedKey.String(), "deposit", map[string]int64{"value": 10})
+	assert.NoError(t, err)
+
+	return idempotencyKey
 }
 
 func TestCreateKey_Success(t *testing.T) {
@@ -61,13 +103,14 @@ func TestCreateKey_Success(t *testing.T) {
 
 	repo := repositories.NewIdempotencyKeysRepository(db)
 
-	expectedKey, _ := uuid.NewRandom()
+	idempotencyKey := newIdempotencyKey(t)
 	mock.ExpectExec("INSERT INTO idempotencykeys").
-		WithArgs(expectedKey.String()).
+		WithArgs(idempotencyKey.Key, idempotencyKey.Scope, idempotencyKey.Subject, idempotencyKey.Operation, idempotencyKey.RequestHash,
+			idempotencyKey.StatusCode, idempotencyKey.Response, idempotencyKey.CreatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Act
-	err = repo.CreateKey(expectedKey.String())
+	err = repo.CreateKey(idempotencyKey)
 
 	// Assert
 	assert.Nil(t, err)
@@ -81,13 +124,12 @@ func TestCreateKey_DBError(t *testing.T) {
 
 	repo := repositories.NewIdempotencyKeysRepository(db)
 
-	expectedKey, _ := uuid.NewRandom()
+	idempotencyKey := newIdempotencyKey(t)
 	mock.ExpectExec("INSERT INTO idempotencykeys").
-		WithArgs(expectedKey.String()).
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	err = repo.CreateKey(expectedKey.String())
+	err = repo.CreateKey(idempotencyKey)
 
 	// Assert
 	assert.NotNil(t, err)
@@ -102,15 +144,35 @@ func TestCreateKey_NoRowsAffected(t *testing.T) {
 
 	repo := repositories.NewIdempotencyKeysRepository(db)
 
-	expectedKey, _ := uuid.NewRandom()
+	idempotencyKey := newIdempotencyKey(t)
 	mock.ExpectExec("INSERT INTO idempotencykeys").
-		WithArgs(expectedKey.String()).
 		WillReturnResult(sqlmock.NewResult(1, 0))
 
 	// Act
-	err = repo.CreateKey(expectedKey.String())
+	err = repo.CreateKey(idempotencyKey)
 
 	// Assert
 	assert.NotNil(t, err)
 	assert.Equal(t, sql.ErrNoRows, err)
 }
+
+func TestDeleteKeysCreatedBefore_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewIdempotencyKeysRepository(db)
+
+	createdBefore := time.Now().Add(-24 * time.Hour)
+	mock.ExpectExec("DELETE FROM idempotencykeys WHERE CreatedAt < \\$1").
+		WithArgs(createdBefore).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	// Act
+	deleted, err := repo.DeleteKeysCreatedBefore(createdBefore)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+}
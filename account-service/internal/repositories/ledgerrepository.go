@@ -0,0 +1,375 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type LedgerRepositoryInterface interface {
+	CreateTransaction(transaction *domain.LedgerTransaction) error
+	GetTransactionForUpdate(id string) (*domain.LedgerTransaction, error)
+	GetReversedValue(transactionId string) (int64, error)
+	GetOutgoingTransfersTotal(accountNumber string, since time.Time) (int64, error)
+	CountOutgoingTransfers(accountNumber string, since time.Time) (int, error)
+	CountDeposits(accountNumber string, since time.Time) (int, error)
+	HasTransferredTo(fromNumber string, toNumber string) (bool, error)
+	GetEntriesTotal() (int64, error)
+	GetUnbalancedTransactions() ([]string, error)
+	GetBalanceMismatches() ([]domain.LedgerBalanceMismatch, error)
+	GetAccountTransactions(filter domain.AccountTransactionsFilter) ([]*domain.AccountTransaction, error)
+}
+
+type LedgerRepository struct {
+	db DBTX
+}
+
+func NewLedgerRepository(db DBTX) *LedgerRepository {
+	return &LedgerRepository{
+		db: db,
+	}
+}
+
+// CreateTransaction writes the transaction and its entries, it must be called within the
+// unit of work that changes the balances so both are committed together.
+func (r *LedgerRepository) CreateTransaction(transaction *domain.LedgerTransaction) error {
+	err := transaction.Validate()
+	if err != nil {
+		return err
+	}
+
+	row := r.db.QueryRow(`
+	INSERT INTO ledgertransactions (Type, ReversedTransactionId, OriginTransactionId, CreatedAt)
+	VALUES ($1, $2, $3, $4)
+
+	RETURNING Id
+	`, transaction.Type, sql.NullString{String: transaction.ReversedTransactionId, Valid: transaction.ReversedTransactionId != ""},
+		sql.NullString{String: transaction.OriginTransactionId, Valid: transaction.OriginTransactionId != ""}, transaction.CreatedAt)
+
+	err = row.Scan(&transaction.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range transaction.Entries {
+		entry.TransactionId = transaction.Id
+
+		row := r.db.QueryRow(`
+		INSERT INTO ledgerentries (TransactionId, AccountNumber, Amount, Currency, CreatedAt)
+		VALUES ($1, $2, $3, $4, $5)
+
+		RETURNING Id
+		`, entry.TransactionId, entry.AccountNumber, entry.Amount, entry.Currency, entry.CreatedAt)
+
+		err = row.Scan(&entry.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTransactionForUpdate returns the transaction with its entries, locking it so concurrent
+// reversals of the same transfer are serialized.
+func (r *LedgerRepository) GetTransactionForUpdate(id string) (*domain.LedgerTransaction, error) {
+	row := r.db.QueryRow(`
+		SELECT Id, Type, COALESCE(CAST(ReversedTransactionId AS VARCHAR), ''), CreatedAt
+		FROM ledgertransactions
+		WHERE Id = $1
+		FOR UPDATE
+	`, id)
+
+	var transaction domain.LedgerTransaction
+	err := row.Scan(&transaction.Id, &transaction.Type, &transaction.ReversedTransactionId, &transaction.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	rows, err := r.db.Query(`
+		SELECT Id, TransactionId, AccountNumber, Amount, Currency, CreatedAt
+		FROM ledgerentries
+		WHERE TransactionId = $1
+		ORDER BY Id
+	`, id)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transaction.Entries = []*domain.LedgerEntry{}
+
+	for rows.Next() {
+		var entry domain.LedgerEntry
+		err := rows.Scan(&entry.Id, &entry.TransactionId, &entry.AccountNumber, &entry.Amount, &entry.Currency, &entry.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		transaction.Entries = append(transaction.Entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+// GetReversedValue returns how much of the transaction was already moved back by reversals.
+func (r *LedgerRepository) GetReversedValue(transactionId string) (int64, error) {
+	row := r.db.QueryRow(`
+		SELECT COALESCE(SUM(e.Amount), 0)
+		FROM ledgertransactions t
+		JOIN ledgerentries e ON e.TransactionId = t.Id
+		WHERE t.ReversedTransactionId = $1 AND e.Amount > 0
+	`, transactionId)
+
+	var reversedValue int64
+	err := row.Scan(&reversedValue)
+
+	return reversedValue, err
+}
+
+// GetOutgoingTransfersTotal sums the value transferred out of the account since the given time.
+func (r *LedgerRepository) GetOutgoingTransfersTotal(accountNumber string, since time.Time) (int64, error) {
+	row := r.db.QueryRow(`
+		SELECT COALESCE(-SUM(e.Amount), 0)
+		FROM ledgerentries e
+		JOIN ledgertransactions t ON t.Id = e.TransactionId
+		WHERE e.AccountNumber = $1 AND t.Type = $2 AND e.Amount < 0 AND e.CreatedAt >= $3
+	`, accountNumber, domain.TransferLedgerTransaction, since)
+
+	var total int64
+	err := row.Scan(&total)
+
+	return total, err
+}
+
+// CountOutgoingTransfers counts the transfers out of the account since the given time.
+func (r *LedgerRepository) CountOutgoingTransfers(accountNumber string, since time.Time) (int, error) {
+	return r.countTransactions(accountNumber, domain.TransferLedgerTransaction, "e.Amount < 0", since)
+}
+
+// CountDeposits counts the deposits into the account since the given time.
+func (r *LedgerRepository) CountDeposits(accountNumber string, since time.Time) (int, error) {
+	return r.countTransactions(accountNumber, domain.DepositLedgerTransaction, "e.Amount > 0", since)
+}
+
+func (r *LedgerRepository) countTransactions(accountNumber string, transactionType string, entryCondition string, since time.Time) (int, error) {
+	row := r.db.QueryRow(`
+		SELECT COUNT(DISTINCT e.TransactionId)
+		FROM ledgerentries e
+		JOIN ledgertransactions t ON t.Id = e.TransactionId
+		WHERE e.AccountNumber = $1 AND t.Type = $2 AND `+entryCondition+` AND e.CreatedAt >= $3
+	`, accountNumber, transactionType, since)
+
+	var count int
+	err := row.Scan(&count)
+
+	return count, err
+}
+
+// HasTransferredTo tells whether the account ever transferred to toNumber.
+func (r *LedgerRepository) HasTransferredTo(fromNumber string, toNumber string) (bool, error) {
+	row := r.db.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM ledgerentries debit
+			JOIN ledgerentries credit ON credit.TransactionId = debit.TransactionId
+			JOIN ledgertransactions t ON t.Id = debit.TransactionId
+			WHERE debit.AccountNumber = $1 AND debit.Amount < 0 AND credit.AccountNumber = $2 AND credit.Amount > 0 AND t.Type = $3
+		)
+	`, fromNumber, toNumber, domain.TransferLedgerTransaction)
+
+	var exists bool
+	err := row.Scan(&exists)
+
+	return exists, err
+}
+
+func (r *LedgerRepository) GetEntriesTotal() (int64, error) {
+	row := r.db.QueryRow(`SELECT COALESCE(SUM(Amount), 0) FROM ledgerentries`)
+
+	var total int64
+	err := row.Scan(&total)
+
+	return total, err
+}
+
+func (r *LedgerRepository) GetUnbalancedTransactions() ([]string, error) {
+	rows, err := r.db.Query(`
+		SELECT DISTINCT TransactionId
+		FROM ledgerentries
+		GROUP BY TransactionId, Currency
+		HAVING SUM(Amount) <> 0
+		ORDER BY TransactionId
+	`)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transactionIds := []string{}
+
+	for rows.Next() {
+		var transactionId string
+		err := rows.Scan(&transactionId)
+		if err != nil {
+			return nil, err
+		}
+
+		transactionIds = append(transactionIds, transactionId)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactionIds, nil
+}
+
+// GetBalanceMismatches returns the accounts whose stored balance differs from the sum of
+// their ledger entries.
+func (r *LedgerRepository) GetBalanceMismatches() ([]domain.LedgerBalanceMismatch, error) {
+	rows, err := r.db.Query(`
+		SELECT a.Number, a.Balance, COALESCE(SUM(e.Amount), 0)
+		FROM accounts a
+		LEFT JOIN ledgerentries e ON e.AccountNumber = a.Number
+		GROUP BY a.Number, a.Balance
+		HAVING a.Balance <> COALESCE(SUM(e.Amount), 0)
+		ORDER BY a.Number
+	`)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mismatches := []domain.LedgerBalanceMismatch{}
+
+	for rows.Next() {
+		var mismatch domain.LedgerBalanceMismatch
+		err := rows.Scan(&mismatch.AccountNumber, &mismatch.Balance, &mismatch.LedgerBalance)
+		if err != nil {
+			return nil, err
+		}
+
+		mismatches = append(mismatches, mismatch)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mismatches, nil
+}
+
+// GetAccountTransactions returns the account entries newest first, starting before the cursor
+// entry id. The resulting balance is the running sum of the account entries, so it is computed
+// over the whole account history before the filters are applied.
+func (r *LedgerRepository) GetAccountTransactions(filter domain.AccountTransactionsFilter) ([]*domain.AccountTransaction, error) {
+	args := []any{filter.AccountNumber, domain.ExternalLedgerAccount, domain.ExchangeLedgerAccount}
+	conditions := []string{}
+
+	addArg := func(arg any) string {
+		args = append(args, arg)
+		return fmt.Sprint("$", len(args))
+	}
+
+	if filter.Cursor > 0 {
+		conditions = append(conditions, "e.Id < "+addArg(filter.Cursor))
+	}
+
+	if filter.From != nil {
+		conditions = append(conditions, "e.CreatedAt >= "+addArg(*filter.From))
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "e.CreatedAt < "+addArg(*filter.To))
+	}
+
+	if len(filter.Types) > 0 {
+		typeConditions := []string{}
+		for _, transactionType := range filter.Types {
+			ledgerType, credit, err := domain.GetAccountTransactionLedgerType(transactionType)
+			if err != nil {
+				return nil, err
+			}
+
+			direction := "e.Amount < 0"
+			if credit {
+				direction = "e.Amount > 0"
+			}
+
+			typeConditions = append(typeConditions, fmt.Sprintf("(t.Type = %v AND %v)", addArg(ledgerType), direction))
+		}
+
+		conditions = append(conditions, "("+strings.Join(typeConditions, " OR ")+")")
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT e.Id, e.TransactionId, t.Type, e.Amount, e.Balance, e.CreatedAt,
+			COALESCE((
+				SELECT c.AccountNumber
+				FROM ledgerentries c
+				WHERE c.TransactionId = e.TransactionId AND c.Id <> e.Id AND c.AccountNumber NOT IN ($2, $3)
+				ORDER BY c.Id
+				LIMIT 1
+			), '')
+		FROM (
+			SELECT Id, TransactionId, Amount, CreatedAt, (SUM(Amount) OVER (ORDER BY Id))::BIGINT AS Balance
+			FROM ledgerentries
+			WHERE AccountNumber = $1
+		) e
+		JOIN ledgertransactions t ON t.Id = e.TransactionId
+		%v
+		ORDER BY e.Id DESC
+		LIMIT %v
+	`, where, addArg(filter.Limit))
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transactions := []*domain.AccountTransaction{}
+
+	for rows.Next() {
+		var transaction domain.AccountTransaction
+		var ledgerType string
+		err := rows.Scan(&transaction.EntryId, &transaction.TransactionId, &ledgerType, &transaction.Amount,
+			&transaction.Balance, &transaction.CreatedAt, &transaction.Counterparty)
+		if err != nil {
+			return nil, err
+		}
+
+		transaction.Type = domain.GetAccountTransactionType(ledgerType, transaction.Amount)
+		if transaction.Amount < 0 {
+			transaction.Amount = -transaction.Amount
+		}
+
+		transactions = append(transactions, &transaction)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,406 @@
+package repositories
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTransaction_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	transaction := domain.NewTransferLedgerTransaction("1", "2", domain.NewMoney(100, domain.DefaultCurrency))
+
+	mock.ExpectQuery("INSERT INTO ledgertransactions").
+		WithArgs(transaction.Type, sql.NullString{}, sql.NullString{}, transaction.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("10"))
+	mock.ExpectQuery("INSERT INTO ledgerentries").
+		WithArgs("10", "1", int64(-100), domain.DefaultCurrency, transaction.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow(1))
+	mock.ExpectQuery("INSERT INTO ledgerentries").
+		WithArgs("10", "2", int64(100), domain.DefaultCurrency, transaction.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow(2))
+
+	// Act
+	err = repo.CreateTransaction(transaction)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "10", transaction.Id)
+	assert.Equal(t, "10", transaction.Entries[1].TransactionId)
+	assert.Equal(t, int64(2), transaction.Entries[1].Id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateTransaction_Unbalanced(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	transaction := domain.NewLedgerTransaction(domain.TransferLedgerTransaction)
+	transaction.AddEntry("1", domain.NewMoney(-100, domain.DefaultCurrency))
+	transaction.AddEntry("2", domain.NewMoney(90, domain.DefaultCurrency))
+
+	// Act
+	err = repo.CreateTransaction(transaction)
+
+	// Assert
+	assert.Equal(t, domain.ErrUnbalancedLedgerTransaction, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateTransaction_DBError(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	transaction := domain.NewDepositLedgerTransaction("1", domain.NewMoney(100, domain.DefaultCurrency))
+
+	mock.ExpectQuery("INSERT INTO ledgertransactions").
+		WillReturnError(sql.ErrConnDone)
+
+	// Act
+	err = repo.CreateTransaction(transaction)
+
+	// Assert
+	assert.Equal(t, sql.ErrConnDone, err)
+}
+
+func TestGetEntriesTotal_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	mock.ExpectQuery("SELECT COALESCE\\(SUM\\(Amount\\), 0\\) FROM ledgerentries").
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+
+	// Act
+	total, err := repo.GetEntriesTotal()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+}
+
+func TestGetUnbalancedTransactions_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	mock.ExpectQuery("SELECT DISTINCT TransactionId FROM ledgerentries GROUP BY TransactionId, Currency HAVING SUM\\(Amount\\) <> 0").
+		WillReturnRows(sqlmock.NewRows([]string{"TransactionId"}).AddRow("3"))
+
+	// Act
+	transactionIds, err := repo.GetUnbalancedTransactions()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3"}, transactionIds)
+}
+
+func TestGetBalanceMismatches_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	mock.ExpectQuery("SELECT a.Number, a.Balance, COALESCE\\(SUM\\(e.Amount\\), 0\\) FROM accounts a LEFT JOIN ledgerentries e").
+		WillReturnRows(sqlmock.NewRows([]string{"Number", "Balance", "LedgerBalance"}).AddRow("1", 150, 100))
+
+	// Act
+	mismatches, err := repo.GetBalanceMismatches()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.LedgerBalanceMismatch{{AccountNumber: "1", Balance: 150, LedgerBalance: 100}}, mismatches)
+}
+
+func TestGetBalanceMismatches_DBError(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	mock.ExpectQuery("SELECT a.Number").
+		WillReturnError(sql.ErrConnDone)
+
+	// Act
+	mismatches, err := repo.GetBalanceMismatches()
+
+	// Assert
+	assert.Equal(t, sql.ErrConnDone, err)
+	assert.Nil(t, mismatches)
+}
+
+func TestGetAccountTransactions_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT e.Id, e.TransactionId, t.Type, e.Amount, e.Balance, e.CreatedAt").
+		WithArgs("1", domain.ExternalLedgerAccount, domain.ExchangeLedgerAccount, int64(10), from, domain.TransferLedgerTransaction, 21).
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "TransactionId", "Type", "Amount", "Balance", "CreatedAt", "Counterparty"}).
+			AddRow(8, "4", "transfer", -50, 100, createdAt, "2"))
+
+	// Act
+	transactions, err := repo.GetAccountTransactions(domain.AccountTransactionsFilter{
+		AccountNumber: "1",
+		Cursor:        10,
+		From:          &from,
+		Types:         []string{domain.TransferOutAccountTransaction},
+		Limit:         21,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.AccountTransaction{{
+		EntryId:       8,
+		TransactionId: "4",
+		Type:          domain.TransferOutAccountTransaction,
+		Counterparty:  "2",
+		Amount:        50,
+		Balance:       100,
+		CreatedAt:     createdAt,
+	}}, transactions)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAccountTransactions_DBError(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	mock.ExpectQuery("SELECT e.Id").
+		WillReturnError(sql.ErrConnDone)
+
+	// Act
+	transactions, err := repo.GetAccountTransactions(domain.AccountTransactionsFilter{AccountNumber: "1", Limit: 21})
+
+	// Assert
+	assert.Equal(t, sql.ErrConnDone, err)
+	assert.Nil(t, transactions)
+}
+
+func TestCreateTransaction_Reversal(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	transfer := domain.NewTransferLedgerTransaction("1", "2", domain.NewMoney(100, domain.DefaultCurrency))
+	transfer.Id = "10"
+	transaction, _ := domain.NewTransferReversalLedgerTransaction(transfer, 0, 0)
+
+	mock.ExpectQuery("INSERT INTO ledgertransactions").
+		WithArgs(domain.TransferReversalLedgerTransaction, sql.NullString{String: "10", Valid: true}, sql.NullString{}, transaction.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("11"))
+	mock.ExpectQuery("INSERT INTO ledgerentries").
+		WithArgs("11", "2", int64(-100), domain.DefaultCurrency, transaction.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow(3))
+	mock.ExpectQuery("INSERT INTO ledgerentries").
+		WithArgs("11", "1", int64(100), domain.DefaultCurrency, transaction.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow(4))
+
+	// Act
+	err = repo.CreateTransaction(transaction)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTransactionForUpdate_Found(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT Id, Type, (.+) FROM ledgertransactions WHERE Id = \\$1 FOR UPDATE").
+		WithArgs("10").
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "Type", "ReversedTransactionId", "CreatedAt"}).AddRow("10", "transfer", "", createdAt))
+	mock.ExpectQuery("SELECT Id, TransactionId, AccountNumber, Amount, Currency, CreatedAt FROM ledgerentries WHERE TransactionId = \\$1").
+		WithArgs("10").
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "TransactionId", "AccountNumber", "Amount", "Currency", "CreatedAt"}).
+			AddRow(1, "10", "1", -100, "BRL", createdAt).
+			AddRow(2, "10", "2", 100, "BRL", createdAt))
+
+	// Act
+	transaction, err := repo.GetTransactionForUpdate("10")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer", transaction.Type)
+	assert.Len(t, transaction.Entries, 2)
+
+	fromNumber, toNumber, value, err := transaction.TransferParties()
+	assert.NoError(t, err)
+	assert.Equal(t, "1", fromNumber)
+	assert.Equal(t, "2", toNumber)
+	assert.Equal(t, domain.NewMoney(100, domain.DefaultCurrency), value)
+}
+
+func TestGetTransactionForUpdate_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	mock.ExpectQuery("SELECT Id, Type, (.+) FROM ledgertransactions WHERE Id = \\$1 FOR UPDATE").
+		WithArgs("10").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	transaction, err := repo.GetTransactionForUpdate("10")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, transaction)
+}
+
+func TestGetReversedValue_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	mock.ExpectQuery("SELECT COALESCE\\(SUM\\(e.Amount\\), 0\\) FROM ledgertransactions t JOIN ledgerentries e ON e.TransactionId = t.Id WHERE t.ReversedTransactionId = \\$1 AND e.Amount > 0").
+		WithArgs("10").
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(40))
+
+	// Act
+	reversedValue, err := repo.GetReversedValue("10")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), reversedValue)
+}
+
+func TestGetOutgoingTransfersTotal_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT COALESCE\\(-SUM\\(e.Amount\\), 0\\) FROM ledgerentries e JOIN ledgertransactions t ON t.Id = e.TransactionId WHERE e.AccountNumber = \\$1 AND t.Type = \\$2 AND e.Amount < 0 AND e.CreatedAt >= \\$3").
+		WithArgs("1", domain.TransferLedgerTransaction, since).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(250))
+
+	// Act
+	total, err := repo.GetOutgoingTransfersTotal("1", since)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(250), total)
+}
+
+func TestCountOutgoingTransfers_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT e.TransactionId\\) FROM ledgerentries e JOIN ledgertransactions t ON t.Id = e.TransactionId WHERE e.AccountNumber = \\$1 AND t.Type = \\$2 AND e.Amount < 0 AND e.CreatedAt >= \\$3").
+		WithArgs("1", domain.TransferLedgerTransaction, since).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	// Act
+	count, err := repo.CountOutgoingTransfers("1", since)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+}
+
+func TestCountDeposits_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT e.TransactionId\\) FROM ledgerentries e JOIN ledgertransactions t ON t.Id = e.TransactionId WHERE e.AccountNumber = \\$1 AND t.Type = \\$2 AND e.Amount > 0 AND e.CreatedAt >= \\$3").
+		WithArgs("1", domain.DepositLedgerTransaction, since).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	// Act
+	count, err := repo.CountDeposits("1", since)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestHasTransferredTo_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLedgerRepository(db)
+
+	mock.ExpectQuery("SELECT EXISTS (.+) FROM ledgerentries debit JOIN ledgerentries credit").
+		WithArgs("1", "2", domain.TransferLedgerTransaction).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	// Act
+	exists, err := repo.HasTransferredTo("1", "2")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"database/sql"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type OutboxRepositoryInterface interface {
+	CreateMessage(message *domain.OutboxMessage) error
+	TryLockRelay() (bool, error)
+	GetPendingMessagesForUpdate(limit int) ([]*domain.OutboxMessage, error)
+	UpdateMessage(message *domain.OutboxMessage) error
+	WithTransaction(fn func(uow UnitOfWorkInterface) error) error
+}
+
+// outboxRelayLockKey identifies the advisory lock held by the relay publishing the outbox.
+const outboxRelayLockKey = 1001
+
+type OutboxRepository struct {
+	db DBTX
+}
+
+func NewOutboxRepository(db DBTX) *OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+func (r *OutboxRepository) CreateMessage(message *domain.OutboxMessage) error {
+	result, err := r.db.Exec(`
+	INSERT INTO outbox (EventId, Topic, Type, Data, Attempts, CreatedAt, NextAttemptAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, message.EventId, message.Topic, message.Type, message.Data, message.Attempts, message.CreatedAt, message.NextAttemptAt)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// TryLockRelay takes the advisory lock of the relay until the end of the transaction and returns
+// false when another relay holds it, so a single relay publishes at a time.
+func (r *OutboxRepository) TryLockRelay() (bool, error) {
+	var locked bool
+	err := r.db.QueryRow(`SELECT pg_try_advisory_xact_lock($1)`, outboxRelayLockKey).Scan(&locked)
+	if err != nil {
+		return false, err
+	}
+
+	return locked, nil
+}
+
+// GetPendingMessagesForUpdate returns the oldest messages not sent nor dead-lettered yet, locking
+// them so concurrent relays skip rows that are already being published.
+func (r *OutboxRepository) GetPendingMessagesForUpdate(limit int) ([]*domain.OutboxMessage, error) {
+	rows, err := r.db.Query(`
+		SELECT Id, EventId, Topic, Type, Data, Attempts, COALESCE(LastError, ''), CreatedAt, NextAttemptAt
+		FROM outbox
+		WHERE SentAt IS NULL AND DeadLetteredAt IS NULL
+		ORDER BY Id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, limit)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []*domain.OutboxMessage{}
+
+	for rows.Next() {
+		var message domain.OutboxMessage
+		err := rows.Scan(&message.Id, &message.EventId, &message.Topic, &message.Type, &message.Data, &message.Attempts, &message.LastError, &message.CreatedAt, &message.NextAttemptAt)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, &message)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) UpdateMessage(message *domain.OutboxMessage) error {
+	result, err := r.db.Exec(`UPDATE outbox SET Attempts = $1, LastError = $2, NextAttemptAt = $3, SentAt = $4, DeadLetteredAt = $5 WHERE Id = $6`,
+		message.Attempts, message.LastError, message.NextAttemptAt, message.SentAt, message.DeadLetteredAt, message.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) WithTransaction(fn func(uow UnitOfWorkInterface) error) error {
+	return runInTransaction(r.db, fn)
+}
@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMessage_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+
+	message := domain.NewOutboxMessage("account", "FundsDeposited", `{"number":"1","value":100}`)
+
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(message.EventId, message.Topic, message.Type, message.Data, message.Attempts, message.CreatedAt, message.NextAttemptAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Act
+	err = repo.CreateMessage(message)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestCreateMessage_DBError(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+
+	message := domain.NewOutboxMessage("account", "FundsDeposited", `{"number":"1","value":100}`)
+
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(message.EventId, message.Topic, message.Type, message.Data, message.Attempts, message.CreatedAt, message.NextAttemptAt).
+		WillReturnError(sql.ErrConnDone)
+
+	// Act
+	err = repo.CreateMessage(message)
+
+	// Assert
+	assert.Equal(t, sql.ErrConnDone, err)
+}
+
+func TestTryLockRelay(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+
+	mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+		WithArgs(outboxRelayLockKey).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(false))
+
+	// Act
+	locked, err := repo.TryLockRelay()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, locked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPendingMessagesForUpdate_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+
+	createdAt := time.Now()
+
+	rows := sqlmock.NewRows([]string{"Id", "EventId", "Topic", "Type", "Data", "Attempts", "LastError", "CreatedAt", "NextAttemptAt"}).
+		AddRow("1", "c7a3f1de-5a34-4a8e-9f4b-0d1f3a6b2e10", "account", "AccountCreated", "{}", 0, "", createdAt, createdAt).
+		AddRow("2", "5d0b6e2a-8c1f-4b7e-a3d9-6f2e1c4b8a77", "account", "FundsDeposited", "{}", 2, "broker unavailable", createdAt, createdAt)
+
+	mock.ExpectQuery("SELECT Id, EventId, Topic, Type, Data, Attempts, COALESCE\\(LastError, ''\\), CreatedAt, NextAttemptAt FROM outbox WHERE SentAt IS NULL AND DeadLetteredAt IS NULL ORDER BY Id LIMIT \\$1 FOR UPDATE SKIP LOCKED").
+		WithArgs(50).
+		WillReturnRows(rows)
+
+	// Act
+	messages, err := repo.GetPendingMessagesForUpdate(50)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, "AccountCreated", messages[0].Type)
+	assert.Equal(t, "c7a3f1de-5a34-4a8e-9f4b-0d1f3a6b2e10", messages[0].EventId)
+	assert.Equal(t, 2, messages[1].Attempts)
+	assert.Equal(t, "broker unavailable", messages[1].LastError)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateMessage_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+
+	message := domain.NewOutboxMessage("account", "FundsDeposited", "{}")
+	message.Id = "1"
+	message.SetAsSent()
+
+	mock.ExpectExec("UPDATE outbox SET Attempts = \\$1, LastError = \\$2, NextAttemptAt = \\$3, SentAt = \\$4, DeadLetteredAt = \\$5 WHERE Id = \\$6").
+		WithArgs(message.Attempts, message.LastError, message.NextAttemptAt, message.SentAt, message.DeadLetteredAt, message.Id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Act
+	err = repo.UpdateMessage(message)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestUpdateMessage_NotRowsAffected(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+
+	message := domain.NewOutboxMessage("account", "FundsDeposited", "{}")
+	message.Id = "1"
+
+	mock.ExpectExec("UPDATE outbox SET Attempts = \\$1, LastError = \\$2, NextAttemptAt = \\$3, SentAt = \\$4, DeadLetteredAt = \\$5 WHERE Id = \\$6").
+		WithArgs(message.Attempts, message.LastError, message.NextAttemptAt, message.SentAt, message.DeadLetteredAt, message.Id).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
+	// Act
+	err = repo.UpdateMessage(message)
+
+	// Assert
+	assert.Equal(t, sql.ErrNoRows, err)
+}
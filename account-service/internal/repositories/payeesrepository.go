@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"database/sql"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type PayeesRepositoryInterface interface {
+	CreatePayee(payee *domain.Payee) (string, error)
+	GetPayee(accountNumber string, id string) (*domain.Payee, error)
+	GetPayeesByAccount(accountNumber string) ([]*domain.Payee, error)
+	UpdatePayee(payee *domain.Payee) error
+	DeletePayee(accountNumber string, id string) (bool, error)
+}
+
+type PayeesRepository struct {
+	db DBTX
+}
+
+func NewPayeesRepository(db DBTX) *PayeesRepository {
+	return &PayeesRepository{
+		db: db,
+	}
+}
+
+const payeeColumns = `Id, AccountNumber, Nickname, ToNumber, COALESCE(PixKey, ''), CreatedAt, UpdatedAt`
+
+func scanPayee(row scanner) (*domain.Payee, error) {
+	var payee domain.Payee
+	err := row.Scan(&payee.Id, &payee.AccountNumber, &payee.Nickname, &payee.ToNumber, &payee.PixKey, &payee.CreatedAt, &payee.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payee, nil
+}
+
+func (r *PayeesRepository) CreatePayee(payee *domain.Payee) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO payees (AccountNumber, Nickname, ToNumber, PixKey, CreatedAt, UpdatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING Id`,
+		payee.AccountNumber, payee.Nickname, payee.ToNumber, sql.NullString{String: payee.PixKey, Valid: payee.PixKey != ""},
+		payee.CreatedAt, payee.UpdatedAt).Scan(&id)
+
+	if err != nil {
+		return "", err
+	}
+
+	payee.Id = id
+
+	return id, nil
+}
+
+// GetPayee returns the payee only when it belongs to the account, nil otherwise.
+func (r *PayeesRepository) GetPayee(accountNumber string, id string) (*domain.Payee, error) {
+	row := r.db.QueryRow(`
+		SELECT `+payeeColumns+`
+		FROM payees
+		WHERE AccountNumber = $1 AND Id = $2
+	`, accountNumber, id)
+
+	payee, err := scanPayee(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return payee, nil
+}
+
+func (r *PayeesRepository) GetPayeesByAccount(accountNumber string) ([]*domain.Payee, error) {
+	rows, err := r.db.Query(`
+		SELECT `+payeeColumns+`
+		FROM payees
+		WHERE AccountNumber = $1
+		ORDER BY Nickname, Id
+	`, accountNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	payees := []*domain.Payee{}
+	for rows.Next() {
+		payee, err := scanPayee(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		payees = append(payees, payee)
+	}
+
+	return payees, rows.Err()
+}
+
+func (r *PayeesRepository) UpdatePayee(payee *domain.Payee) error {
+	result, err := r.db.Exec(`UPDATE payees SET Nickname = $1, UpdatedAt = $2 WHERE Id = $3`,
+		payee.Nickname, payee.UpdatedAt, payee.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeletePayee removes the payee when it belongs to the account, returning whether it was found.
+func (r *PayeesRepository) DeletePayee(accountNumber string, id string) (bool, error) {
+	result, err := r.db.Exec(`DELETE FROM payees WHERE AccountNumber = $1 AND Id = $2`, accountNumber, id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePayee_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPayeesRepository(db)
+
+	payee := &domain.Payee{AccountNumber: "19", Nickname: "Rent", ToNumber: "35", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	mock.ExpectQuery("INSERT INTO payees").
+		WithArgs("19", "Rent", "35", sql.NullString{}, payee.CreatedAt, payee.UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("5"))
+
+	// Act
+	id, err := repo.CreatePayee(payee)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "5", id)
+	assert.Equal(t, "5", payee.Id)
+}
+
+func TestGetPayee_Found(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPayeesRepository(db)
+
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM payees WHERE AccountNumber = \\$1 AND Id = \\$2").
+		WithArgs("19", "5").
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "AccountNumber", "Nickname", "ToNumber", "PixKey", "CreatedAt", "UpdatedAt"}).
+			AddRow("5", "19", "Jane", "35", "jane@mail.com", createdAt, createdAt))
+
+	// Act
+	payee, err := repo.GetPayee("19", "5")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.Payee{Id: "5", AccountNumber: "19", Nickname: "Jane", ToNumber: "35", PixKey: "jane@mail.com", CreatedAt: createdAt, UpdatedAt: createdAt}, payee)
+}
+
+func TestGetPayee_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPayeesRepository(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM payees WHERE AccountNumber = \\$1 AND Id = \\$2").
+		WithArgs("19", "5").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	payee, err := repo.GetPayee("19", "5")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, payee)
+}
+
+func TestGetPayeesByAccount_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPayeesRepository(db)
+
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM payees WHERE AccountNumber = \\$1 ORDER BY Nickname, Id").
+		WithArgs("19").
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "AccountNumber", "Nickname", "ToNumber", "PixKey", "CreatedAt", "UpdatedAt"}).
+			AddRow("6", "19", "Jane", "35", "jane@mail.com", createdAt, createdAt).
+			AddRow("5", "19", "Rent", "43", "", createdAt, createdAt))
+
+	// Act
+	payees, err := repo.GetPayeesByAccount("19")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, payees, 2)
+	assert.Equal(t, "Rent", payees[1].Nickname)
+}
+
+func TestUpdatePayee_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPayeesRepository(db)
+
+	payee := &domain.Payee{Id: "5", Nickname: "Landlord", UpdatedAt: time.Now()}
+
+	mock.ExpectExec("UPDATE payees SET Nickname = \\$1, UpdatedAt = \\$2 WHERE Id = \\$3").
+		WithArgs("Landlord", payee.UpdatedAt, "5").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err = repo.UpdatePayee(payee)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestDeletePayee_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPayeesRepository(db)
+
+	mock.ExpectExec("DELETE FROM payees WHERE AccountNumber = \\$1 AND Id = \\$2").
+		WithArgs("19", "5").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	deleted, err := repo.DeletePayee("19", "5")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+}
@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"database/sql"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type PixKeysRepositoryInterface interface {
+	CreatePixKey(pixKey *domain.PixKey) (string, error)
+	GetPixKey(key string) (*domain.PixKey, error)
+	GetPixKeysByAccount(accountNumber string) ([]*domain.PixKey, error)
+	DeletePixKey(accountNumber string, key string) (bool, error)
+}
+
+type PixKeysRepository struct {
+	db DBTX
+}
+
+func NewPixKeysRepository(db DBTX) *PixKeysRepository {
+	return &PixKeysRepository{
+		db: db,
+	}
+}
+
+func (r *PixKeysRepository) CreatePixKey(pixKey *domain.PixKey) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO pixkeys (AccountNumber, Type, Key, CreatedAt)
+	VALUES ($1, $2, $3, $4)
+	RETURNING Id`,
+		pixKey.AccountNumber, pixKey.Type, pixKey.Key, pixKey.CreatedAt).Scan(&id)
+
+	if err != nil {
+		return "", err
+	}
+
+	pixKey.Id = id
+
+	return id, nil
+}
+
+func (r *PixKeysRepository) GetPixKey(key string) (*domain.PixKey, error) {
+	row := r.db.QueryRow(`
+		SELECT Id, AccountNumber, Type, Key, CreatedAt
+		FROM pixkeys
+		WHERE Key = $1
+	`, key)
+
+	var pixKey domain.PixKey
+	err := row.Scan(&pixKey.Id, &pixKey.AccountNumber, &pixKey.Type, &pixKey.Key, &pixKey.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &pixKey, nil
+}
+
+func (r *PixKeysRepository) GetPixKeysByAccount(accountNumber string) ([]*domain.PixKey, error) {
+	rows, err := r.db.Query(`
+		SELECT Id, AccountNumber, Type, Key, CreatedAt
+		FROM pixkeys
+		WHERE AccountNumber = $1
+		ORDER BY Id
+	`, accountNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	pixKeys := []*domain.PixKey{}
+	for rows.Next() {
+		var pixKey domain.PixKey
+		err = rows.Scan(&pixKey.Id, &pixKey.AccountNumber, &pixKey.Type, &pixKey.Key, &pixKey.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		pixKeys = append(pixKeys, &pixKey)
+	}
+
+	return pixKeys, rows.Err()
+}
+
+// DeletePixKey removes key when it belongs to the account, returning whether it was found.
+func (r *PixKeysRepository) DeletePixKey(accountNumber string, key string) (bool, error) {
+	result, err := r.db.Exec(`DELETE FROM pixkeys WHERE AccountNumber = $1 AND Key = $2`, accountNumber, key)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
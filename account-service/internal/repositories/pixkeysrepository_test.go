@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePixKey_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPixKeysRepository(db)
+
+	pixKey := &domain.PixKey{AccountNumber: "19", Type: domain.PixKeyEmail, Key: "john@mail.com", CreatedAt: time.Now()}
+
+	mock.ExpectQuery("INSERT INTO pixkeys").
+		WithArgs("19", domain.PixKeyEmail, "john@mail.com", pixKey.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("3"))
+
+	// Act
+	id, err := repo.CreatePixKey(pixKey)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "3", id)
+	assert.Equal(t, "3", pixKey.Id)
+}
+
+func TestGetPixKey_Found(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPixKeysRepository(db)
+
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT Id, AccountNumber, Type, Key, CreatedAt FROM pixkeys WHERE Key = \\$1").
+		WithArgs("john@mail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "AccountNumber", "Type", "Key", "CreatedAt"}).
+			AddRow("3", "19", "email", "john@mail.com", createdAt))
+
+	// Act
+	pixKey, err := repo.GetPixKey("john@mail.com")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.PixKey{Id: "3", AccountNumber: "19", Type: domain.PixKeyEmail, Key: "john@mail.com", CreatedAt: createdAt}, pixKey)
+}
+
+func TestGetPixKey_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPixKeysRepository(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM pixkeys WHERE Key = \\$1").
+		WithArgs("john@mail.com").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	pixKey, err := repo.GetPixKey("john@mail.com")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, pixKey)
+}
+
+func TestGetPixKeysByAccount_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPixKeysRepository(db)
+
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM pixkeys WHERE AccountNumber = \\$1 ORDER BY Id").
+		WithArgs("19").
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "AccountNumber", "Type", "Key", "CreatedAt"}).
+			AddRow("3", "19", "email", "john@mail.com", createdAt).
+			AddRow("4", "19", "document", "01234567890", createdAt))
+
+	// Act
+	pixKeys, err := repo.GetPixKeysByAccount("19")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, pixKeys, 2)
+	assert.Equal(t, domain.PixKeyDocument, pixKeys[1].Type)
+}
+
+func TestDeletePixKey_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPixKeysRepository(db)
+
+	mock.ExpectExec("DELETE FROM pixkeys WHERE AccountNumber = \\$1 AND Key = \\$2").
+		WithArgs("19", "john@mail.com").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	deleted, err := repo.DeletePixKey("19", "john@mail.com")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"database/sql"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type PocketsRepositoryInterface interface {
+	CreatePocket(pocket *domain.Pocket) (string, error)
+	GetPocket(accountNumber string, id string) (*domain.Pocket, error)
+	GetPocketsByAccount(accountNumber string) ([]*domain.Pocket, error)
+	UpdatePocket(pocket *domain.Pocket) error
+}
+
+type PocketsRepository struct {
+	db DBTX
+}
+
+func NewPocketsRepository(db DBTX) *PocketsRepository {
+	return &PocketsRepository{
+		db: db,
+	}
+}
+
+const pocketColumns = `Id, AccountNumber, Name, Balance, Status, CreatedAt, UpdatedAt`
+
+func (r *PocketsRepository) CreatePocket(pocket *domain.Pocket) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO pockets (AccountNumber, Name, Balance, Status, CreatedAt, UpdatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING Id`,
+		pocket.AccountNumber, pocket.Name, pocket.Balance, pocket.Status, pocket.CreatedAt, pocket.UpdatedAt).Scan(&id)
+
+	if err != nil {
+		return "", err
+	}
+
+	pocket.Id = id
+
+	return id, nil
+}
+
+// GetPocket returns the pocket only when it belongs to the account, nil otherwise.
+func (r *PocketsRepository) GetPocket(accountNumber string, id string) (*domain.Pocket, error) {
+	row := r.db.QueryRow(`
+		SELECT `+pocketColumns+`
+		FROM pockets
+		WHERE AccountNumber = $1 AND Id = $2
+	`, accountNumber, id)
+
+	pocket, err := scanPocket(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return pocket, nil
+}
+
+// GetPocketsByAccount returns the active pockets of the account, closed pockets are kept only
+// as the record of past moves.
+func (r *PocketsRepository) GetPocketsByAccount(accountNumber string) ([]*domain.Pocket, error) {
+	rows, err := r.db.Query(`
+		SELECT `+pocketColumns+`
+		FROM pockets
+		WHERE AccountNumber = $1 AND Status = $2
+		ORDER BY Name, Id
+	`, accountNumber, domain.PocketStatusActive)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	pockets := []*domain.Pocket{}
+	for rows.Next() {
+		pocket, err := scanPocket(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		pockets = append(pockets, pocket)
+	}
+
+	return pockets, rows.Err()
+}
+
+func (r *PocketsRepository) UpdatePocket(pocket *domain.Pocket) error {
+	result, err := r.db.Exec(`UPDATE pockets SET Balance = $1, Status = $2, UpdatedAt = $3 WHERE Id = $4`,
+		pocket.Balance, pocket.Status, pocket.UpdatedAt, pocket.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanPocket(row scanner) (*domain.Pocket, error) {
+	var pocket domain.Pocket
+	err := row.Scan(&pocket.Id, &pocket.AccountNumber, &pocket.Name, &pocket.Balance, &pocket.Status, &pocket.CreatedAt, &pocket.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pocket, nil
+}
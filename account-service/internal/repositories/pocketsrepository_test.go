@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePocket_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPocketsRepository(db)
+
+	pocket := &domain.Pocket{AccountNumber: "19", Name: "Vacation", Status: domain.PocketStatusActive, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	mock.ExpectQuery("INSERT INTO pockets (.+) RETURNING Id").
+		WithArgs("19", "Vacation", int64(0), domain.PocketStatusActive, pocket.CreatedAt, pocket.UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("4"))
+
+	// Act
+	id, err := repo.CreatePocket(pocket)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "4", id)
+	assert.Equal(t, "4", pocket.Id)
+}
+
+func TestGetPocket_Found(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPocketsRepository(db)
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"Id", "AccountNumber", "Name", "Balance", "Status", "CreatedAt", "UpdatedAt"}).
+		AddRow("4", "19", "Vacation", int64(500), domain.PocketStatusActive, now, now)
+
+	mock.ExpectQuery("SELECT Id, AccountNumber, Name, Balance, Status, CreatedAt, UpdatedAt FROM pockets WHERE AccountNumber = \\$1 AND Id = \\$2").
+		WithArgs("19", "4").
+		WillReturnRows(rows)
+
+	// Act
+	pocket, err := repo.GetPocket("19", "4")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Vacation", pocket.Name)
+	assert.Equal(t, int64(500), pocket.Balance)
+}
+
+func TestGetPocket_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPocketsRepository(db)
+
+	mock.ExpectQuery("FROM pockets WHERE AccountNumber = \\$1 AND Id = \\$2").
+		WithArgs("19", "4").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	pocket, err := repo.GetPocket("19", "4")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, pocket)
+}
+
+func TestGetPocketsByAccount_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPocketsRepository(db)
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"Id", "AccountNumber", "Name", "Balance", "Status", "CreatedAt", "UpdatedAt"}).
+		AddRow("5", "19", "Taxes", int64(100), domain.PocketStatusActive, now, now).
+		AddRow("4", "19", "Vacation", int64(500), domain.PocketStatusActive, now, now)
+
+	mock.ExpectQuery("FROM pockets WHERE AccountNumber = \\$1 AND Status = \\$2 ORDER BY Name, Id").
+		WithArgs("19", domain.PocketStatusActive).
+		WillReturnRows(rows)
+
+	// Act
+	pockets, err := repo.GetPocketsByAccount("19")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, pockets, 2)
+	assert.Equal(t, "Taxes", pockets[0].Name)
+}
+
+func TestUpdatePocket_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPocketsRepository(db)
+
+	pocket := &domain.Pocket{Id: "4", Balance: 300, Status: domain.PocketStatusActive, UpdatedAt: time.Now()}
+
+	mock.ExpectExec("UPDATE pockets SET Balance = \\$1, Status = \\$2, UpdatedAt = \\$3 WHERE Id = \\$4").
+		WithArgs(pocket.Balance, pocket.Status, pocket.UpdatedAt, pocket.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err = repo.UpdatePocket(pocket)
+
+	// Assert
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
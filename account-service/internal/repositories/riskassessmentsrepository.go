@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type RiskAssessmentsRepositoryInterface interface {
+	CreateRiskAssessment(assessment *domain.RiskAssessment) (string, error)
+}
+
+type RiskAssessmentsRepository struct {
+	db DBTX
+}
+
+func NewRiskAssessmentsRepository(db DBTX) *RiskAssessmentsRepository {
+	return &RiskAssessmentsRepository{
+		db: db,
+	}
+}
+
+func (r *RiskAssessmentsRepository) CreateRiskAssessment(assessment *domain.RiskAssessment) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO riskassessments (Operation, AccountNumber, CounterpartyNumber, Value, Decision, Rule, CreatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING Id`,
+		assessment.Operation, assessment.AccountNumber, assessment.CounterpartyNumber, assessment.Value, assessment.Decision,
+		assessment.Rule, assessment.CreatedAt).Scan(&id)
+
+	if err != nil {
+		return "", err
+	}
+
+	assessment.Id = id
+
+	return id, nil
+}
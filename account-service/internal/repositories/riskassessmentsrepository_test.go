@@ -0,0 +1,56 @@
+package repositories_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRiskAssessment_Success(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewRiskAssessmentsRepository(db)
+
+	assessment := domain.NewRiskAssessment(domain.RiskOperation{Type: domain.RiskOperationTransfer, AccountNumber: "19", CounterpartyNumber: "27", Value: 5000})
+	assessment.Record(domain.RiskRule{Name: "large-amount", Decision: domain.RiskDecisionReview})
+
+	mock.ExpectQuery("INSERT INTO riskassessments (.+) RETURNING Id").
+		WithArgs(assessment.Operation, assessment.AccountNumber, assessment.CounterpartyNumber, assessment.Value,
+			assessment.Decision, assessment.Rule, assessment.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("7"))
+
+	// Act
+	id, err := repo.CreateRiskAssessment(assessment)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "7", id)
+	assert.Equal(t, "7", assessment.Id)
+}
+
+func TestCreateRiskAssessment_Error(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewRiskAssessmentsRepository(db)
+
+	assessment := domain.NewRiskAssessment(domain.RiskOperation{Type: domain.RiskOperationDeposit, AccountNumber: "19", Value: 5000})
+
+	mock.ExpectQuery("INSERT INTO riskassessments (.+) RETURNING Id").
+		WillReturnError(errors.New("generic error"))
+
+	// Act
+	id, err := repo.CreateRiskAssessment(assessment)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, id)
+	assert.Empty(t, assessment.Id)
+}
@@ -0,0 +1,171 @@
+package repositories
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type ScheduledTransfersRepositoryInterface interface {
+	CreateScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer) (string, error)
+	GetScheduledTransfer(fromNumber string, id string) (*domain.ScheduledTransfer, error)
+	GetScheduledTransfersByAccount(fromNumber string) ([]*domain.ScheduledTransfer, error)
+	GetDueScheduledTransfers(now time.Time, limit int) ([]*domain.ScheduledTransfer, error)
+	UpdateScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer) error
+}
+
+type ScheduledTransfersRepository struct {
+	db DBTX
+}
+
+func NewScheduledTransfersRepository(db DBTX) *ScheduledTransfersRepository {
+	return &ScheduledTransfersRepository{
+		db: db,
+	}
+}
+
+const scheduledTransferColumns = `Id, FromNumber, ToNumber, Value, Frequency, DayOfMonth, EndDate, MaxOccurrences,
+		Occurrences, FailedOccurrences, PendingOccurrences, COALESCE(LastTransferRequestId, ''), ScheduledFor, NextRunAt,
+		Attempts, COALESCE(LastError, ''), LastAttemptAt, Status, COALESCE(CreatedBy, ''), CreatedAt, UpdatedAt`
+
+func (r *ScheduledTransfersRepository) CreateScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO scheduledtransfers (FromNumber, ToNumber, Value, Frequency, DayOfMonth, EndDate, MaxOccurrences,
+		Occurrences, FailedOccurrences, ScheduledFor, NextRunAt, Attempts, LastError, Status, CreatedBy, CreatedAt, UpdatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+	RETURNING Id`,
+		scheduledTransfer.FromNumber, scheduledTransfer.ToNumber, scheduledTransfer.Value, scheduledTransfer.Frequency,
+		scheduledTransfer.DayOfMonth, scheduledTransfer.EndDate, scheduledTransfer.MaxOccurrences,
+		scheduledTransfer.Occurrences, scheduledTransfer.FailedOccurrences, scheduledTransfer.ScheduledFor,
+		scheduledTransfer.NextRunAt, scheduledTransfer.Attempts, scheduledTransfer.LastError, scheduledTransfer.Status,
+		scheduledTransfer.CreatedBy, scheduledTransfer.CreatedAt, scheduledTransfer.UpdatedAt).Scan(&id)
+
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *ScheduledTransfersRepository) GetScheduledTransfer(fromNumber string, id string) (*domain.ScheduledTransfer, error) {
+	row := r.db.QueryRow(`
+		SELECT `+scheduledTransferColumns+`
+		FROM scheduledtransfers
+		WHERE FromNumber = $1 AND Id = $2
+	`, fromNumber, id)
+
+	scheduledTransfer, err := scanScheduledTransfer(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return scheduledTransfer, nil
+}
+
+func (r *ScheduledTransfersRepository) GetScheduledTransfersByAccount(fromNumber string) ([]*domain.ScheduledTransfer, error) {
+	rows, err := r.db.Query(`
+		SELECT `+scheduledTransferColumns+`
+		FROM scheduledtransfers
+		WHERE FromNumber = $1
+		ORDER BY Id
+	`, fromNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanScheduledTransfers(rows)
+}
+
+// GetDueScheduledTransfers returns the active scheduled transfers whose next run is due, the
+// oldest first.
+func (r *ScheduledTransfersRepository) GetDueScheduledTransfers(now time.Time, limit int) ([]*domain.ScheduledTransfer, error) {
+	rows, err := r.db.Query(`
+		SELECT `+scheduledTransferColumns+`
+		FROM scheduledtransfers
+		WHERE Status = $1 AND NextRunAt <= $2
+		ORDER BY NextRunAt
+		LIMIT $3
+	`, domain.ScheduledTransferActive, now, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanScheduledTransfers(rows)
+}
+
+func (r *ScheduledTransfersRepository) UpdateScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer) error {
+	result, err := r.db.Exec(`
+	UPDATE scheduledtransfers
+	SET Value = $1, EndDate = $2, MaxOccurrences = $3, Occurrences = $4, FailedOccurrences = $5, PendingOccurrences = $6,
+		LastTransferRequestId = $7, ScheduledFor = $8, NextRunAt = $9, Attempts = $10, LastError = $11, LastAttemptAt = $12,
+		Status = $13, UpdatedAt = $14
+	WHERE Id = $15`,
+		scheduledTransfer.Value, scheduledTransfer.EndDate, scheduledTransfer.MaxOccurrences, scheduledTransfer.Occurrences,
+		scheduledTransfer.FailedOccurrences, scheduledTransfer.PendingOccurrences, scheduledTransfer.LastTransferRequestId,
+		scheduledTransfer.ScheduledFor, scheduledTransfer.NextRunAt,
+		scheduledTransfer.Attempts, scheduledTransfer.LastError, scheduledTransfer.LastAttemptAt, scheduledTransfer.Status,
+		scheduledTransfer.UpdatedAt, scheduledTransfer.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanScheduledTransfer(row scanner) (*domain.ScheduledTransfer, error) {
+	var scheduledTransfer domain.ScheduledTransfer
+	err := row.Scan(&scheduledTransfer.Id, &scheduledTransfer.FromNumber, &scheduledTransfer.ToNumber, &scheduledTransfer.Value,
+		&scheduledTransfer.Frequency, &scheduledTransfer.DayOfMonth, &scheduledTransfer.EndDate, &scheduledTransfer.MaxOccurrences,
+		&scheduledTransfer.Occurrences, &scheduledTransfer.FailedOccurrences, &scheduledTransfer.PendingOccurrences,
+		&scheduledTransfer.LastTransferRequestId, &scheduledTransfer.ScheduledFor,
+		&scheduledTransfer.NextRunAt, &scheduledTransfer.Attempts, &scheduledTransfer.LastError, &scheduledTransfer.LastAttemptAt,
+		&scheduledTransfer.Status, &scheduledTransfer.CreatedBy, &scheduledTransfer.CreatedAt, &scheduledTransfer.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduledTransfer, nil
+}
+
+func scanScheduledTransfers(rows *sql.Rows) ([]*domain.ScheduledTransfer, error) {
+	defer rows.Close()
+
+	scheduledTransfers := []*domain.ScheduledTransfer{}
+
+	for rows.Next() {
+		scheduledTransfer, err := scanScheduledTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduledTransfers = append(scheduledTransfers, scheduledTransfer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return scheduledTransfers, nil
+}
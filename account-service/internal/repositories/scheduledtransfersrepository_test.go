@@ -0,0 +1,143 @@
+package repositories_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var scheduledTransferColumns = []string{"Id", "FromNumber", "ToNumber", "Value", "Frequency", "DayOfMonth", "EndDate", "MaxOccurrences",
+	"Occurrences", "FailedOccurrences", "PendingOccurrences", "LastTransferRequestId", "ScheduledFor", "NextRunAt", "Attempts", "LastError", "LastAttemptAt",
+	"Status", "CreatedBy", "CreatedAt", "UpdatedAt"}
+
+func TestCreateScheduledTransfer_Success(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewScheduledTransfersRepository(db)
+
+	st := &domain.ScheduledTransfer{FromNumber: "1", ToNumber: "2", Value: 100, Frequency: domain.ScheduledTransferOnce,
+		ScheduledFor: time.Now(), NextRunAt: time.Now(), Status: domain.ScheduledTransferActive, CreatedBy: "bob"}
+
+	mock.ExpectQuery("INSERT INTO scheduledtransfers (.+) RETURNING Id").
+		WithArgs(st.FromNumber, st.ToNumber, st.Value, st.Frequency, st.DayOfMonth, st.EndDate, st.MaxOccurrences,
+			st.Occurrences, st.FailedOccurrences, st.ScheduledFor, st.NextRunAt, st.Attempts, st.LastError, st.Status,
+			st.CreatedBy, st.CreatedAt, st.UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("8"))
+
+	// Act
+	id, err := repo.CreateScheduledTransfer(st)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "8", id)
+}
+
+func TestGetScheduledTransfer_Found(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewScheduledTransfersRepository(db)
+
+	now := time.Now()
+	rows := sqlmock.NewRows(scheduledTransferColumns).
+		AddRow("8", "1", "2", 100, "monthly", 5, nil, 3, 1, 0, 0, "", now, now, 0, "", nil, "active", "bob", now, now)
+	mock.ExpectQuery("SELECT (.+) FROM scheduledtransfers WHERE FromNumber = \\$1 AND Id = \\$2").
+		WithArgs("1", "8").
+		WillReturnRows(rows)
+
+	// Act
+	result, err := repo.GetScheduledTransfer("1", "8")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.ScheduledTransfer{
+		Id:             "8",
+		FromNumber:     "1",
+		ToNumber:       "2",
+		Value:          100,
+		Frequency:      domain.ScheduledTransferMonthly,
+		DayOfMonth:     5,
+		MaxOccurrences: 3,
+		Occurrences:    1,
+		ScheduledFor:   now,
+		NextRunAt:      now,
+		Status:         domain.ScheduledTransferActive,
+		CreatedBy:      "bob",
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, result)
+}
+
+func TestGetScheduledTransfer_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewScheduledTransfersRepository(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM scheduledtransfers WHERE FromNumber = \\$1 AND Id = \\$2").
+		WithArgs("1", "8").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	result, err := repo.GetScheduledTransfer("1", "8")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestGetDueScheduledTransfers_Success(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewScheduledTransfersRepository(db)
+
+	now := time.Now()
+	rows := sqlmock.NewRows(scheduledTransferColumns).
+		AddRow("8", "1", "2", 100, "weekly", 0, nil, 0, 0, 0, 0, "", now, now, 1, "insufficient funds", now, "active", "bob", now, now).
+		AddRow("9", "3", "2", 200, "once", 0, nil, 0, 1, 1, 1, "12", now, now, 0, "", nil, "active", "", now, now)
+	mock.ExpectQuery("SELECT (.+) FROM scheduledtransfers WHERE Status = \\$1 AND NextRunAt <= \\$2 ORDER BY NextRunAt LIMIT \\$3").
+		WithArgs(domain.ScheduledTransferActive, now, 50).
+		WillReturnRows(rows)
+
+	// Act
+	result, err := repo.GetDueScheduledTransfers(now, 50)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "insufficient funds", result[0].LastError)
+	assert.Equal(t, &now, result[0].LastAttemptAt)
+	assert.Nil(t, result[1].LastAttemptAt)
+	assert.Equal(t, 1, result[1].PendingOccurrences)
+	assert.Equal(t, "12", result[1].LastTransferRequestId)
+}
+
+func TestUpdateScheduledTransfer_NotRowsAffected(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewScheduledTransfersRepository(db)
+
+	st := &domain.ScheduledTransfer{Id: "8", Status: domain.ScheduledTransferCancelled}
+
+	mock.ExpectExec("UPDATE scheduledtransfers SET (.+) WHERE Id = \\$15").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.UpdateScheduledTransfer(st)
+
+	// Assert
+	assert.Equal(t, sql.ErrNoRows, err)
+}
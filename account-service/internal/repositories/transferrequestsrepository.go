@@ -0,0 +1,154 @@
+package repositories
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type TransferRequestsRepositoryInterface interface {
+	CreateTransferRequest(transferRequest *domain.TransferRequest) (string, error)
+	GetTransferRequestForUpdate(id string) (*domain.TransferRequest, error)
+	GetTransferRequestsByAccount(accountNumber string) ([]*domain.TransferRequest, error)
+	GetExpiredTransferRequests(now time.Time, limit int) ([]*domain.TransferRequest, error)
+	UpdateTransferRequest(transferRequest *domain.TransferRequest) error
+}
+
+type TransferRequestsRepository struct {
+	db DBTX
+}
+
+func NewTransferRequestsRepository(db DBTX) *TransferRequestsRepository {
+	return &TransferRequestsRepository{
+		db: db,
+	}
+}
+
+const transferRequestColumns = `Id, FromNumber, ToNumber, PixKey, Value, Status, RequestedBy, ReviewedBy, RejectionReason, ExpiresAt, CreatedAt, UpdatedAt`
+
+func (r *TransferRequestsRepository) CreateTransferRequest(transferRequest *domain.TransferRequest) (string, error) {
+	var id string
+	err := r.db.QueryRow(`
+	INSERT INTO transferrequests (FromNumber, ToNumber, PixKey, Value, Status, RequestedBy, ReviewedBy, RejectionReason, ExpiresAt, CreatedAt, UpdatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	RETURNING Id`,
+		transferRequest.FromNumber, transferRequest.ToNumber, transferRequest.PixKey, transferRequest.Value, transferRequest.Status,
+		transferRequest.RequestedBy, transferRequest.ReviewedBy, transferRequest.RejectionReason, transferRequest.ExpiresAt,
+		transferRequest.CreatedAt, transferRequest.UpdatedAt).Scan(&id)
+
+	if err != nil {
+		return "", err
+	}
+
+	transferRequest.Id = id
+
+	return id, nil
+}
+
+// GetTransferRequestForUpdate locks the request until the transaction ends, so it is reviewed
+// or expired only once.
+func (r *TransferRequestsRepository) GetTransferRequestForUpdate(id string) (*domain.TransferRequest, error) {
+	row := r.db.QueryRow(`
+		SELECT `+transferRequestColumns+`
+		FROM transferrequests
+		WHERE Id = $1
+		FOR UPDATE
+	`, id)
+
+	transferRequest, err := scanTransferRequest(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return transferRequest, nil
+}
+
+func (r *TransferRequestsRepository) GetTransferRequestsByAccount(accountNumber string) ([]*domain.TransferRequest, error) {
+	rows, err := r.db.Query(`
+		SELECT `+transferRequestColumns+`
+		FROM transferrequests
+		WHERE FromNumber = $1
+		ORDER BY Id
+	`, accountNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanTransferRequests(rows)
+}
+
+// GetExpiredTransferRequests returns the requests still pending approval past their expiry, the oldest first.
+func (r *TransferRequestsRepository) GetExpiredTransferRequests(now time.Time, limit int) ([]*domain.TransferRequest, error) {
+	rows, err := r.db.Query(`
+		SELECT `+transferRequestColumns+`
+		FROM transferrequests
+		WHERE Status = $1 AND ExpiresAt <= $2
+		ORDER BY ExpiresAt
+		LIMIT $3
+	`, domain.TransferRequestPendingApproval, now, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanTransferRequests(rows)
+}
+
+func (r *TransferRequestsRepository) UpdateTransferRequest(transferRequest *domain.TransferRequest) error {
+	result, err := r.db.Exec(`UPDATE transferrequests SET Status = $1, ReviewedBy = $2, RejectionReason = $3, UpdatedAt = $4 WHERE Id = $5`,
+		transferRequest.Status, transferRequest.ReviewedBy, transferRequest.RejectionReason, transferRequest.UpdatedAt, transferRequest.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanTransferRequest(row scanner) (*domain.TransferRequest, error) {
+	var transferRequest domain.TransferRequest
+	err := row.Scan(&transferRequest.Id, &transferRequest.FromNumber, &transferRequest.ToNumber, &transferRequest.PixKey,
+		&transferRequest.Value, &transferRequest.Status, &transferRequest.RequestedBy, &transferRequest.ReviewedBy,
+		&transferRequest.RejectionReason, &transferRequest.ExpiresAt, &transferRequest.CreatedAt, &transferRequest.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transferRequest, nil
+}
+
+func scanTransferRequests(rows *sql.Rows) ([]*domain.TransferRequest, error) {
+	defer rows.Close()
+
+	transferRequests := []*domain.TransferRequest{}
+
+	for rows.Next() {
+		transferRequest, err := scanTransferRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		transferRequests = append(transferRequests, transferRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transferRequests, nil
+}
@@ -0,0 +1,125 @@
+package repositories_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var transferRequestColumns = []string{"Id", "FromNumber", "ToNumber", "PixKey", "Value", "Status", "RequestedBy", "ReviewedBy",
+	"RejectionReason", "ExpiresAt", "CreatedAt", "UpdatedAt"}
+
+func TestCreateTransferRequest_Success(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewTransferRequestsRepository(db)
+
+	transferRequest := domain.NewTransferRequest("19", "27", "", 50000, "user-1", time.Hour)
+
+	mock.ExpectQuery("INSERT INTO transferrequests (.+) RETURNING Id").
+		WithArgs(transferRequest.FromNumber, transferRequest.ToNumber, transferRequest.PixKey, transferRequest.Value,
+			transferRequest.Status, transferRequest.RequestedBy, transferRequest.ReviewedBy, transferRequest.RejectionReason,
+			transferRequest.ExpiresAt, transferRequest.CreatedAt, transferRequest.UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow("3"))
+
+	// Act
+	id, err := repo.CreateTransferRequest(transferRequest)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "3", id)
+	assert.Equal(t, "3", transferRequest.Id)
+}
+
+func TestGetTransferRequestForUpdate_Found(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewTransferRequestsRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT (.+) FROM transferrequests WHERE Id = \\$1 FOR UPDATE").
+		WithArgs("3").
+		WillReturnRows(sqlmock.NewRows(transferRequestColumns).
+			AddRow("3", "19", "27", "", 50000, "pending_approval", "user-1", "", "", now, now, now))
+
+	// Act
+	transferRequest, err := repo.GetTransferRequestForUpdate("3")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "27", transferRequest.ToNumber)
+	assert.Equal(t, int64(50000), transferRequest.Value)
+	assert.Equal(t, domain.TransferRequestPendingApproval, transferRequest.Status)
+}
+
+func TestGetTransferRequestForUpdate_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewTransferRequestsRepository(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM transferrequests WHERE Id = \\$1 FOR UPDATE").
+		WithArgs("3").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	transferRequest, err := repo.GetTransferRequestForUpdate("3")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, transferRequest)
+}
+
+func TestGetExpiredTransferRequests_Success(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewTransferRequestsRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT (.+) FROM transferrequests WHERE Status = \\$1 AND ExpiresAt <= \\$2 ORDER BY ExpiresAt LIMIT \\$3").
+		WithArgs(domain.TransferRequestPendingApproval, now, 10).
+		WillReturnRows(sqlmock.NewRows(transferRequestColumns).
+			AddRow("3", "19", "27", "", 50000, "pending_approval", "user-1", "", "", now, now, now).
+			AddRow("4", "35", "", "a@b.com", 70000, "pending_approval", "user-2", "", "", now, now, now))
+
+	// Act
+	transferRequests, err := repo.GetExpiredTransferRequests(now, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, transferRequests, 2)
+	assert.Equal(t, "a@b.com", transferRequests[1].PixKey)
+}
+
+func TestUpdateTransferRequest_Success(t *testing.T) {
+	// Arrange
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := repositories.NewTransferRequestsRepository(db)
+
+	transferRequest := &domain.TransferRequest{Id: "3", Status: domain.TransferRequestRejected, ReviewedBy: "user-2",
+		RejectionReason: "unknown payee", UpdatedAt: time.Now()}
+
+	mock.ExpectExec("UPDATE transferrequests SET Status = \\$1, ReviewedBy = \\$2, RejectionReason = \\$3, UpdatedAt = \\$4 WHERE Id = \\$5").
+		WithArgs(transferRequest.Status, transferRequest.ReviewedBy, transferRequest.RejectionReason, transferRequest.UpdatedAt, transferRequest.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err := repo.UpdateTransferRequest(transferRequest)
+
+	// Assert
+	assert.NoError(t, err)
+}
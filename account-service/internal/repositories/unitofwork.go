@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+)
+
+// DBTX is implemented by both *sql.DB and *sql.Tx, so the same repository
+// code can run standalone or inside a unit of work.
+type DBTX interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+type UnitOfWorkInterface interface {
+	AccountRepository() AccountRepositoryInterface
+	IdempotencyKeysRepository() IdempotencyKeysRepositoryInterface
+	OutboxRepository() OutboxRepositoryInterface
+	LedgerRepository() LedgerRepositoryInterface
+	HoldsRepository() HoldsRepositoryInterface
+	TransferRequestsRepository() TransferRequestsRepositoryInterface
+	RiskAssessmentsRepository() RiskAssessmentsRepositoryInterface
+	AccountProfileChangesRepository() AccountProfileChangesRepositoryInterface
+	PocketsRepository() PocketsRepositoryInterface
+	AccountHoldersRepository() AccountHoldersRepositoryInterface
+}
+
+type UnitOfWork struct {
+	tx *sql.Tx
+}
+
+func NewUnitOfWork(tx *sql.Tx) *UnitOfWork {
+	return &UnitOfWork{
+		tx: tx,
+	}
+}
+
+func (u *UnitOfWork) AccountRepository() AccountRepositoryInterface {
+	return NewAccountRepository(u.tx)
+}
+
+func (u *UnitOfWork) IdempotencyKeysRepository() IdempotencyKeysRepositoryInterface {
+	return NewIdempotencyKeysRepository(u.tx)
+}
+
+func (u *UnitOfWork) OutboxRepository() OutboxRepositoryInterface {
+	return NewOutboxRepository(u.tx)
+}
+
+func (u *UnitOfWork) LedgerRepository() LedgerRepositoryInterface {
+	return NewLedgerRepository(u.tx)
+}
+
+func (u *UnitOfWork) HoldsRepository() HoldsRepositoryInterface {
+	return NewHoldsRepository(u.tx)
+}
+
+func (u *UnitOfWork) TransferRequestsRepository() TransferRequestsRepositoryInterface {
+	return NewTransferRequestsRepository(u.tx)
+}
+
+func (u *UnitOfWork) RiskAssessmentsRepository() RiskAssessmentsRepositoryInterface {
+	return NewRiskAssessmentsRepository(u.tx)
+}
+
+func (u *UnitOfWork) AccountProfileChangesRepository() AccountProfileChangesRepositoryInterface {
+	return NewAccountProfileChangesRepository(u.tx)
+}
+
+func (u *UnitOfWork) PocketsRepository() PocketsRepositoryInterface {
+	return NewPocketsRepository(u.tx)
+}
+
+func (u *UnitOfWork) AccountHoldersRepository() AccountHoldersRepositoryInterface {
+	return NewAccountHoldersRepository(u.tx)
+}
+
+// runInTransaction executes fn inside a database transaction, committing when fn
+// succeeds and rolling back otherwise. When db is already a transaction fn joins it.
+func runInTransaction(db DBTX, fn func(uow UnitOfWorkInterface) error) error {
+	if tx, ok := db.(*sql.Tx); ok {
+		return fn(NewUnitOfWork(tx))
+	}
+
+	beginner, ok := db.(interface{ Begin() (*sql.Tx, error) })
+	if !ok {
+		return errors.New("connection does not support transactions")
+	}
+
+	tx, err := beginner.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = fn(NewUnitOfWork(tx))
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			slog.Error("error rolling back transaction", "error", rollbackErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type AccrueInterestUseCaseInterface interface {
+	Handle() (int, error)
+}
+
+type AccrueInterestUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+	interestRate      *domain.InterestRate
+	batchSize         int
+}
+
+func NewAccrueInterestUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	interestRate *domain.InterestRate,
+	batchSize int) *AccrueInterestUseCase {
+	return &AccrueInterestUseCase{
+		accountRepository: accountRepository,
+		interestRate:      interestRate,
+		batchSize:         batchSize,
+	}
+}
+
+// Handle accrues the interest of a batch of savings accounts for every day ended since their
+// last accrual and returns how many accounts were accrued. Interest is credited like a deposit
+// when a month ends, and each account is read again after being locked so a day is never
+// accrued twice by concurrent runs.
+func (us *AccrueInterestUseCase) Handle() (int, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.AddDate(0, 0, -1)
+
+	pendingAccounts, err := us.accountRepository.GetAccountsToAccrueInterest(yesterday, us.batchSize)
+	if err != nil {
+		slog.Error("error getting accounts to accrue interest", "error", err)
+		return 0, err
+	}
+
+	accrued := 0
+	var accrueErr error
+
+	for _, pendingAccount := range pendingAccounts {
+		accruedAccount := false
+
+		err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+			accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(pendingAccount.Number)
+			if err != nil {
+				return err
+			}
+
+			acc := accounts[pendingAccount.Number]
+			if acc == nil || !acc.InterestAccruedThrough().Before(yesterday) {
+				return nil
+			}
+
+			err = us.accrue(uow, acc, today)
+			if err != nil {
+				return err
+			}
+
+			accruedAccount = true
+
+			return nil
+		})
+
+		if err != nil {
+			slog.Error("error accruing interest", "error", err, "number", pendingAccount.Number)
+			accrueErr = err
+			continue
+		}
+
+		if accruedAccount {
+			accrued++
+		}
+	}
+
+	if accrued > 0 {
+		slog.Info("savings interest accrued", "count", accrued)
+	}
+
+	return accrued, accrueErr
+}
+
+func (us *AccrueInterestUseCase) accrue(uow repositories.UnitOfWorkInterface, acc *domain.Account, today time.Time) error {
+	balanceChanged := false
+
+	for day := acc.InterestAccruedThrough().AddDate(0, 0, 1); day.Before(today); day = day.AddDate(0, 0, 1) {
+		credited, err := acc.AccrueInterest(us.interestRate, day)
+		if err != nil {
+			return err
+		}
+
+		if credited.Amount == 0 {
+			continue
+		}
+
+		balanceChanged = true
+
+		err = uow.LedgerRepository().CreateTransaction(domain.NewInterestLedgerTransaction(acc.Number, credited))
+		if err != nil {
+			slog.Error("error creating ledger transaction", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewInterestCredited(acc.Number, credited, acc.Money(acc.Balance), day))
+		if err != nil {
+			slog.Error("error adding interest credited event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("interest credited", "number", acc.Number, "value", credited, "period", day.Format("2006-01"))
+	}
+
+	if balanceChanged {
+		err := uow.AccountRepository().UpdateAccountBalance(acc)
+		if err != nil {
+			slog.Error("error updating account balance", "error", err)
+			return err
+		}
+	}
+
+	return uow.AccountRepository().UpdateAccountInterest(acc)
+}
@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccrueInterestUseCase_Handle_CreditsEndedMonth(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	rate, _ := domain.NewInterestRate("0.365")
+	useCase := NewAccrueInterestUseCase(mockRepo, rate, 10)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	firstOfMonth := today.AddDate(0, 0, 1-today.Day())
+	lastOfPreviousMonth := firstOfMonth.AddDate(0, 0, -1)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Type = domain.AccountTypeSavings
+	acc.Balance = 100000
+	acc.InterestAccruedOn = lastOfPreviousMonth.AddDate(0, 0, -1)
+
+	mockRepo.On("GetAccountsToAccrueInterest", today.AddDate(0, 0, -1), 10).Return([]*domain.Account{acc}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.InterestLedgerTransaction && transaction.Validate() == nil &&
+			transaction.Entries[1].AccountNumber == acc.Number && transaction.Entries[1].Amount == 100
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "InterestCredited" &&
+			message.Data == `{"number":"19","value":{"amount":100,"currency":"BRL"},"balance":{"amount":100100,"currency":"BRL"},"period":"`+lastOfPreviousMonth.Format("2006-01")+`"}`
+	})).Return(nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockRepo.On("UpdateAccountInterest", acc).Return(nil)
+
+	// act
+	accrued, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, accrued)
+	assert.Equal(t, today.AddDate(0, 0, -1), acc.InterestAccruedOn)
+	mockRepo.AssertExpectations(t)
+	mockLedgerRepository.AssertNumberOfCalls(t, "CreateTransaction", 1)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestAccrueInterestUseCase_Handle_AlreadyAccrued(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	rate, _ := domain.NewInterestRate("0.365")
+	useCase := NewAccrueInterestUseCase(mockRepo, rate, 10)
+
+	yesterday := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+
+	listed := domain.NewAccount("19", "01234567890", "John Doe")
+	listed.Type = domain.AccountTypeSavings
+	listed.InterestAccruedOn = yesterday.AddDate(0, 0, -1)
+
+	// accrued by another run after being listed
+	current := *listed
+	current.InterestAccruedOn = yesterday
+
+	mockRepo.On("GetAccountsToAccrueInterest", yesterday, 10).Return([]*domain.Account{listed}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{listed.Number}).Return(map[string]*domain.Account{listed.Number: &current}, nil)
+
+	// act
+	accrued, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, accrued)
+	mockRepo.AssertNotCalled(t, "UpdateAccountInterest", mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+}
@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type ApproveTransferRequestUseCaseInterface interface {
+	Handle(id string, approvedBy string) error
+}
+
+type ApproveTransferRequestUseCase struct {
+	accountRepository      repositories.AccountRepositoryInterface
+	transferAccountUseCase *TransferAccountUseCase
+}
+
+func NewApproveTransferRequestUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	transferAccountUseCase *TransferAccountUseCase) *ApproveTransferRequestUseCase {
+	return &ApproveTransferRequestUseCase{
+		accountRepository:      accountRepository,
+		transferAccountUseCase: transferAccountUseCase,
+	}
+}
+
+// Handle executes the transfer of a request pending approval in the same transaction that marks
+// it approved, so when the transfer fails the request stays pending and can be approved again.
+func (us *ApproveTransferRequestUseCase) Handle(id string, approvedBy string) error {
+	return us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		transferRequest, err := uow.TransferRequestsRepository().GetTransferRequestForUpdate(id)
+		if err != nil {
+			slog.Error("error getting transfer request", "error", err, "id", id)
+			return err
+		}
+
+		if transferRequest == nil {
+			slog.Info("transfer request not found", "id", id)
+			return errors.New("transfer request not found")
+		}
+
+		err = transferRequest.Approve(approvedBy, time.Now())
+		if err != nil {
+			slog.Info("transfer request not approved", "error", err, "id", id, "status", transferRequest.Status)
+			return err
+		}
+
+		_, err = us.transferAccountUseCase.ExecuteTransferRequest(uow, transferRequest)
+		if err != nil {
+			slog.Info("approved transfer failed", "error", err, "id", id)
+			return err
+		}
+
+		err = uow.TransferRequestsRepository().UpdateTransferRequest(transferRequest)
+		if err != nil {
+			slog.Error("error updating transfer request", "error", err, "id", id)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewTransferApproved(transferRequest.Id, transferRequest.FromNumber,
+			transferRequest.ToNumber, transferRequest.Value, approvedBy))
+		if err != nil {
+			slog.Error("error adding transfer approved event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("Transfer request approved", "id", transferRequest.Id, "fromNumber", transferRequest.FromNumber, "approvedBy", approvedBy)
+
+		return nil
+	})
+}
@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApproveTransferRequestUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	transferAccountUseCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{Threshold: 50, TTL: time.Hour}, domain.PayeePolicy{}, nil, nil, nil)
+	useCase := NewApproveTransferRequestUseCase(mockRepo, transferAccountUseCase)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	transferRequest := domain.NewTransferRequest("123", "456", "", 100, "user-1", time.Hour)
+	transferRequest.Id = "3"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository).
+		WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockTransferRequestsRepository.On("GetTransferRequestForUpdate", "3").Return(transferRequest, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockIdempotencyRepository.On("GetKey", "123", "", "system:transfer-request-3").Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+	mockTransferRequestsRepository.On("UpdateTransferRequest", transferRequest).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	// act
+	err := useCase.Handle("3", "user-2")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.TransferRequestApproved, transferRequest.Status)
+	assert.Equal(t, "user-2", transferRequest.ReviewedBy)
+	assert.Equal(t, int64(50), fromAcc.Balance)
+	assert.Equal(t, int64(100), toAcc.Balance)
+
+	mockOutboxRepository.AssertCalled(t, "CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferApproved" &&
+			message.Data == `{"transferRequestId":"3","fromNumber":"123","toNumber":"456","value":100,"approvedBy":"user-2"}`
+	}))
+	mockOutboxRepository.AssertCalled(t, "CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferRealized"
+	}))
+	mockTransferRequestsRepository.AssertExpectations(t)
+}
+
+func TestApproveTransferRequestUseCase_Handle_SelfApproval(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	useCase := NewApproveTransferRequestUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil))
+
+	transferRequest := domain.NewTransferRequest("123", "456", "", 100, "user-1", time.Hour)
+	transferRequest.Id = "3"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil).
+		WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockTransferRequestsRepository.On("GetTransferRequestForUpdate", "3").Return(transferRequest, nil)
+
+	// act
+	err := useCase.Handle("3", "user-1")
+
+	// assert
+	assert.Equal(t, domain.ErrTransferRequestSelfReview, err)
+	assert.Equal(t, domain.TransferRequestPendingApproval, transferRequest.Status)
+	mockRepo.AssertNotCalled(t, "GetAccountsByNumbersForUpdate", mock.Anything)
+	mockTransferRequestsRepository.AssertNotCalled(t, "UpdateTransferRequest", mock.Anything)
+}
+
+func TestApproveTransferRequestUseCase_Handle_NotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	useCase := NewApproveTransferRequestUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil))
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil).
+		WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockTransferRequestsRepository.On("GetTransferRequestForUpdate", "3").Return((*domain.TransferRequest)(nil), nil)
+
+	// act
+	err := useCase.Handle("3", "user-2")
+
+	// assert
+	assert.EqualError(t, err, "transfer request not found")
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type CancelScheduledTransferUseCaseInterface interface {
+	Handle(number string, id string) error
+}
+
+type CancelScheduledTransferUseCase struct {
+	scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface
+}
+
+func NewCancelScheduledTransferUseCase(scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface) *CancelScheduledTransferUseCase {
+	return &CancelScheduledTransferUseCase{
+		scheduledTransfersRepository: scheduledTransfersRepository,
+	}
+}
+
+func (us *CancelScheduledTransferUseCase) Handle(number string, id string) error {
+	scheduledTransfer, err := us.scheduledTransfersRepository.GetScheduledTransfer(number, id)
+	if err != nil {
+		slog.Error("error getting scheduled transfer", "error", err, "number", number, "id", id)
+		return err
+	}
+
+	if scheduledTransfer == nil {
+		slog.Info("scheduled transfer not found", "number", number, "id", id)
+		return errors.New("scheduled transfer not found")
+	}
+
+	err = scheduledTransfer.Cancel()
+	if err != nil {
+		slog.Info("scheduled transfer cancel not allowed", "error", err, "id", id)
+		return err
+	}
+
+	err = us.scheduledTransfersRepository.UpdateScheduledTransfer(scheduledTransfer)
+	if err != nil {
+		slog.Error("error updating scheduled transfer", "error", err, "id", id)
+		return err
+	}
+
+	slog.Info("scheduled transfer cancelled", "number", number, "id", id)
+
+	return nil
+}
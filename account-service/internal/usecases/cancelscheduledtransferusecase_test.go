@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCancelScheduledTransferUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	useCase := NewCancelScheduledTransferUseCase(mockScheduledTransfersRepository)
+
+	scheduledTransfer := &domain.ScheduledTransfer{Id: "5", FromNumber: "1", Status: domain.ScheduledTransferActive}
+
+	mockScheduledTransfersRepository.On("GetScheduledTransfer", "1", "5").Return(scheduledTransfer, nil)
+	mockScheduledTransfersRepository.On("UpdateScheduledTransfer", scheduledTransfer).Return(nil)
+
+	// act
+	err := useCase.Handle("1", "5")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ScheduledTransferCancelled, scheduledTransfer.Status)
+	mockScheduledTransfersRepository.AssertExpectations(t)
+}
+
+func TestCancelScheduledTransferUseCase_Handle_NotFound(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	useCase := NewCancelScheduledTransferUseCase(mockScheduledTransfersRepository)
+
+	mockScheduledTransfersRepository.On("GetScheduledTransfer", "1", "5").Return((*domain.ScheduledTransfer)(nil), nil)
+
+	// act
+	err := useCase.Handle("1", "5")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "scheduled transfer not found", err.Error())
+}
+
+func TestCancelScheduledTransferUseCase_Handle_NotActive(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	useCase := NewCancelScheduledTransferUseCase(mockScheduledTransfersRepository)
+
+	scheduledTransfer := &domain.ScheduledTransfer{Id: "5", FromNumber: "1", Status: domain.ScheduledTransferCompleted}
+
+	mockScheduledTransfersRepository.On("GetScheduledTransfer", "1", "5").Return(scheduledTransfer, nil)
+
+	// act
+	err := useCase.Handle("1", "5")
+
+	// assert
+	assert.Equal(t, domain.ErrScheduledTransferNotActive, err)
+	mockScheduledTransfersRepository.AssertNotCalled(t, "UpdateScheduledTransfer", mock.Anything)
+}
@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type CaptureHoldUseCaseInterface interface {
+	Handle(number string, holdId string, value int64, toNumber string) (*domain.Hold, error)
+}
+
+type CaptureHoldUseCase struct {
+	accountRepository      repositories.AccountRepositoryInterface
+	transferAccountUseCase *TransferAccountUseCase
+}
+
+func NewCaptureHoldUseCase(accountRepository repositories.AccountRepositoryInterface, transferAccountUseCase *TransferAccountUseCase) *CaptureHoldUseCase {
+	return &CaptureHoldUseCase{
+		accountRepository:      accountRepository,
+		transferAccountUseCase: transferAccountUseCase,
+	}
+}
+
+// Handle captures value of the hold, a zero value captures all of it. The captured value is
+// withdrawn when toNumber is empty or transferred to toNumber through the same checks as any
+// other transfer, and the rest is released. A hold is captured only once, so retries are
+// rejected as the hold is no longer active. Both accounts of a transfer are locked at once, and
+// a transfer blocked by the risk screening keeps the hold and only commits the assessment.
+func (us *CaptureHoldUseCase) Handle(number string, holdId string, value int64, toNumber string) (*domain.Hold, error) {
+	numbers := []string{number}
+	if toNumber != "" {
+		numbers = append(numbers, toNumber)
+	}
+
+	var hold *domain.Hold
+	blocked := false
+	err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(numbers...)
+		if err != nil {
+			slog.Error("Error getting accounts by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		hold, err = uow.HoldsRepository().GetHold(number, holdId)
+		if err != nil {
+			slog.Error("error getting hold", "error", err, "holdId", holdId)
+			return err
+		}
+
+		if hold == nil {
+			slog.Info("hold not found", "number", number, "holdId", holdId)
+			return errors.New("hold not found")
+		}
+
+		wasInOverdraft := acc.InOverdraft()
+
+		var captured int64
+		if toNumber == "" {
+			captured, err = acc.CaptureHold(hold, value)
+		} else {
+			captured, err = acc.CaptureHoldForTransfer(hold, value)
+		}
+
+		if err != nil {
+			slog.Info("hold capture not allowed", "error", err, "number", number, "holdId", holdId)
+			return err
+		}
+
+		var transactionId string
+		if toNumber == "" {
+			err = uow.AccountRepository().UpdateAccountBalance(acc)
+			if err != nil {
+				slog.Error("error updating account balance", "error", err)
+				return err
+			}
+
+			transactionId, err = withdrawCapturedFunds(uow, acc, captured, wasInOverdraft)
+		} else {
+			// the transfer updates the balance of acc, released from the hold in memory, so a
+			// blocked transfer leaves the hold untouched and commits only the risk assessment
+			transactionId, err = us.transferAccountUseCase.TransferCapturedHold(uow, accounts, hold, toNumber)
+			if errors.Is(err, domain.ErrOperationBlocked) {
+				blocked = true
+				return nil
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = uow.HoldsRepository().UpdateHold(hold)
+		if err != nil {
+			slog.Error("error updating hold", "error", err, "holdId", holdId)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewHoldCaptured(acc.Number, hold.Id, captured, toNumber, transactionId))
+		if err != nil {
+			slog.Error("error adding hold captured event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("Hold captured", "accountNumber", acc.Number, "holdId", hold.Id, "value", captured, "toNumber", toNumber)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if blocked {
+		return nil, domain.ErrOperationBlocked
+	}
+
+	return hold, nil
+}
+
+// withdrawCapturedFunds records the captured value withdrawn from acc and emits the same events
+// as a withdrawal, so it shows up in the statements like any other movement.
+func withdrawCapturedFunds(uow repositories.UnitOfWorkInterface, acc *domain.Account, captured int64, wasInOverdraft bool) (string, error) {
+	ledgerTransaction := domain.NewWithdrawLedgerTransaction(acc.Number, acc.Money(captured))
+
+	err := uow.LedgerRepository().CreateTransaction(ledgerTransaction)
+	if err != nil {
+		slog.Error("error creating ledger transaction", "error", err)
+		return "", err
+	}
+
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewFundsWithdrawn(acc.Number, acc.Money(captured)))
+	if err != nil {
+		slog.Error("error adding funds withdrawn event to outbox", "error", err)
+		return "", err
+	}
+
+	err = addEnteredOverdraftEventToOutbox(uow.OutboxRepository(), acc, wasInOverdraft)
+	if err != nil {
+		slog.Error("error adding account entered overdraft event to outbox", "error", err)
+		return "", err
+	}
+
+	return ledgerTransaction.Id, nil
+}
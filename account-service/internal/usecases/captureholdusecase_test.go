@@ -0,0 +1,260 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCaptureHoldUseCase_Handle_Withdraw(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+
+	useCase := NewCaptureHoldUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil))
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+	hold.Id = "7"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, mockLedgerRepository).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return(hold, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockHoldsRepository.On("UpdateHold", hold).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == "withdraw" && transaction.Entries[0].AccountNumber == acc.Number && transaction.Entries[0].Amount == -300
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FundsWithdrawn" && message.Data == `{"number":"19","value":{"amount":300,"currency":"BRL"}}`
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "HoldCaptured" && message.Data == `{"number":"19","holdId":"7","value":300,"transactionId":""}`
+	})).Return(nil)
+
+	// act
+	result, err := useCase.Handle(acc.Number, "7", 300, "")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.HoldStatusCaptured, result.Status)
+	assert.Equal(t, int64(300), result.CapturedAmount)
+	assert.Equal(t, int64(700), acc.Balance)
+	assert.Equal(t, int64(0), acc.HeldBalance)
+
+	mockRepo.AssertExpectations(t)
+	mockHoldsRepository.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestCaptureHoldUseCase_Handle_Transfer(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+
+	useCase := NewCaptureHoldUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil))
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	toAcc := domain.NewAccount("27", "52998224725", "Jane Doe")
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+	hold.Id = "7"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number, toAcc.Number}).Return(map[string]*domain.Account{acc.Number: acc, toAcc.Number: toAcc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return(hold, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockRepo.On("UpdateAccountBalance", toAcc).Return(nil)
+	mockHoldsRepository.On("UpdateHold", hold).Return(nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "", "system:hold-capture-7").Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.MatchedBy(func(key *domain.IdempotencyKey) bool {
+		return key.Key == "system:hold-capture-7" && key.Operation == "transfer"
+	})).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == "transfer" && transaction.Entries[1].AccountNumber == toAcc.Number && transaction.Entries[1].Amount == 400
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "77"
+	}).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferRealized" || message.Type == "TransferReceived"
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "HoldCaptured" && message.Data == `{"number":"19","holdId":"7","value":400,"toNumber":"27","transactionId":"77"}`
+	})).Return(nil)
+
+	// act
+	result, err := useCase.Handle(acc.Number, "7", 0, toAcc.Number)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(400), result.CapturedAmount)
+	assert.Equal(t, int64(600), acc.Balance)
+	assert.Equal(t, int64(0), acc.HeldBalance)
+	assert.Equal(t, int64(400), toAcc.Balance)
+
+	mockOutboxRepository.AssertNumberOfCalls(t, "CreateMessage", 3)
+	mockRepo.AssertNumberOfCalls(t, "GetAccountsByNumbersForUpdate", 1)
+	mockRepo.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+	mockIdempotencyRepository.AssertExpectations(t)
+}
+
+func TestCaptureHoldUseCase_Handle_TransferAboveLimit(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+
+	limits := domain.DefaultTransferLimits{domain.DocumentTypePerson: {PerTransaction: 300}}
+	useCase := NewCaptureHoldUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, limits, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil))
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	toAcc := domain.NewAccount("27", "52998224725", "Jane Doe")
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+	hold.Id = "7"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, nil, mockLedgerRepository).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number, toAcc.Number}).Return(map[string]*domain.Account{acc.Number: acc, toAcc.Number: toAcc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return(hold, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockHoldsRepository.On("UpdateHold", hold).Return(nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "", "system:hold-capture-7").Return((*domain.IdempotencyKey)(nil), nil)
+	mockLedgerRepository.On("GetOutgoingTransfersTotal", acc.Number, mock.Anything).Return(int64(0), nil)
+
+	// act
+	result, err := useCase.Handle(acc.Number, "7", 0, toAcc.Number)
+
+	// assert
+	var limitErr *domain.TransferLimitExceededError
+	assert.ErrorAs(t, err, &limitErr)
+	assert.Nil(t, result)
+	assert.Equal(t, int64(0), toAcc.Balance)
+	mockLedgerRepository.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestCaptureHoldUseCase_Handle_TransferAboveApprovalThreshold(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	useCase := NewCaptureHoldUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{Threshold: 300, TTL: time.Hour}, domain.PayeePolicy{}, nil, nil, nil))
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	toAcc := domain.NewAccount("27", "52998224725", "Jane Doe")
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+	hold.Id = "7"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository).
+		WithHoldsRepository(mockHoldsRepository).WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number, toAcc.Number}).Return(map[string]*domain.Account{acc.Number: acc, toAcc.Number: toAcc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return(hold, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockHoldsRepository.On("UpdateHold", hold).Return(nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "", "system:hold-capture-7").Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+	mockTransferRequestsRepository.On("CreateTransferRequest", mock.Anything).Return("3", nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	// act
+	result, err := useCase.Handle(acc.Number, "7", 0, toAcc.Number)
+
+	// assert
+	assert.Equal(t, domain.ErrCaptureRequiresApproval, err)
+	assert.Nil(t, result)
+	assert.Equal(t, int64(0), toAcc.Balance)
+	mockLedgerRepository.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "HoldCaptured"
+	}))
+}
+
+func TestCaptureHoldUseCase_Handle_TransferBlockedByRiskScreening(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockRiskAssessmentsRepository := new(usecases_mock.MockRiskAssessmentsRepository)
+
+	riskRules := domain.RiskRules{
+		{Name: "transfers-velocity", Type: domain.RiskRuleVelocity, Decision: domain.RiskDecisionBlock, MaxCount: 3, Window: 10 * time.Minute},
+	}
+
+	useCase := NewCaptureHoldUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, riskRules, nil, nil))
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	toAcc := domain.NewAccount("27", "52998224725", "Jane Doe")
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+	hold.Id = "7"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository).
+		WithHoldsRepository(mockHoldsRepository).WithRiskAssessmentsRepository(mockRiskAssessmentsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number, toAcc.Number}).Return(map[string]*domain.Account{acc.Number: acc, toAcc.Number: toAcc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return(hold, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "", "system:hold-capture-7").Return((*domain.IdempotencyKey)(nil), nil)
+	mockLedgerRepository.On("GetOutgoingTransfersTotal", acc.Number, mock.Anything).Return(int64(0), nil).Maybe()
+	mockLedgerRepository.On("CountOutgoingTransfers", acc.Number, mock.Anything).Return(3, nil)
+	mockRiskAssessmentsRepository.On("CreateRiskAssessment", mock.MatchedBy(func(assessment *domain.RiskAssessment) bool {
+		return assessment.Decision == domain.RiskDecisionBlock && assessment.AccountNumber == acc.Number && assessment.CounterpartyNumber == toAcc.Number
+	})).Return("5", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "SuspiciousActivityDetected"
+	})).Return(nil)
+
+	// act
+	result, err := useCase.Handle(acc.Number, "7", 0, toAcc.Number)
+
+	// assert
+	assert.Equal(t, domain.ErrOperationBlocked, err)
+	assert.Nil(t, result)
+	assert.Equal(t, int64(0), toAcc.Balance)
+	mockRiskAssessmentsRepository.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockHoldsRepository.AssertNotCalled(t, "UpdateHold", mock.Anything)
+	mockLedgerRepository.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "HoldCaptured"
+	}))
+}
+
+func TestCaptureHoldUseCase_Handle_HoldNotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+
+	useCase := NewCaptureHoldUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil))
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return((*domain.Hold)(nil), nil)
+
+	// act
+	result, err := useCase.Handle(acc.Number, "7", 0, "")
+
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, "hold not found", err.Error())
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type ChangeAccountStatusUseCaseInterface interface {
+	Handle(number string, status domain.AccountStatus) error
+}
+
+type ChangeAccountStatusUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewChangeAccountStatusUseCase(accountRepository repositories.AccountRepositoryInterface) *ChangeAccountStatusUseCase {
+	return &ChangeAccountStatusUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handle moves the account to status. The account is locked so a concurrent deposit or
+// transfer cannot change the balance between the closing check and the update. Closing a
+// savings account credits the interest accrued in the month and withdraws it with the closing.
+func (us *ChangeAccountStatusUseCase) Handle(number string, status domain.AccountStatus) error {
+	return us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		previousStatus := acc.Status
+
+		err = acc.ChangeStatus(status)
+		if err != nil {
+			slog.Info("account status change not allowed", "error", err, "number", number, "from", previousStatus, "to", status)
+			return err
+		}
+
+		if acc.Status == domain.AccountStatusClosed && acc.AccruedInterest != 0 {
+			err = payOutAccruedInterest(uow, acc)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = uow.AccountRepository().UpdateAccountStatus(acc)
+		if err != nil {
+			slog.Error("error updating account status", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), newAccountStatusEvent(acc))
+		if err != nil {
+			slog.Error("error adding account status event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("account status changed", "number", number, "from", previousStatus, "to", status)
+
+		return nil
+	})
+}
+
+// payOutAccruedInterest credits the interest accrued and not credited yet to an account being
+// closed and withdraws it, so the account closes with a zero balance and nothing left to credit.
+func payOutAccruedInterest(uow repositories.UnitOfWorkInterface, acc *domain.Account) error {
+	credited, err := acc.CreditAccruedInterest()
+	if err != nil {
+		slog.Error("error crediting accrued interest", "error", err, "number", acc.Number)
+		return err
+	}
+
+	err = uow.LedgerRepository().CreateTransaction(domain.NewInterestLedgerTransaction(acc.Number, credited))
+	if err != nil {
+		slog.Error("error creating ledger transaction", "error", err)
+		return err
+	}
+
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewInterestCredited(acc.Number, credited, acc.Money(acc.Balance), time.Now()))
+	if err != nil {
+		slog.Error("error adding interest credited event to outbox", "error", err)
+		return err
+	}
+
+	err = acc.Withdraw(credited)
+	if err != nil {
+		slog.Error("error withdrawing credited interest", "error", err, "number", acc.Number)
+		return err
+	}
+
+	err = uow.LedgerRepository().CreateTransaction(domain.NewWithdrawLedgerTransaction(acc.Number, credited))
+	if err != nil {
+		slog.Error("error creating ledger transaction", "error", err)
+		return err
+	}
+
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewFundsWithdrawn(acc.Number, credited))
+	if err != nil {
+		slog.Error("error adding funds withdrawn event to outbox", "error", err)
+		return err
+	}
+
+	err = uow.AccountRepository().UpdateAccountInterest(acc)
+	if err != nil {
+		slog.Error("error updating account interest", "error", err)
+		return err
+	}
+
+	slog.Info("accrued interest paid out on closing", "number", acc.Number, "value", credited)
+
+	return nil
+}
+
+func newAccountStatusEvent(acc *domain.Account) any {
+	switch acc.Status {
+	case domain.AccountStatusBlocked:
+		return events.NewAccountBlocked(acc.Number)
+	case domain.AccountStatusClosed:
+		return events.NewAccountClosed(acc.Number)
+	default:
+		return events.NewAccountUnblocked(acc.Number)
+	}
+}
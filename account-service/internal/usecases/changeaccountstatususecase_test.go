@@ -0,0 +1,169 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChangeAccountStatusUseCase_Handle_Block(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewChangeAccountStatusUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountStatus", acc).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "AccountBlocked" && message.Data == `{"number":"1"}`
+	})).Return(nil)
+
+	// act
+	err := useCase.Handle(acc.Number, domain.AccountStatusBlocked)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AccountStatusBlocked, acc.Status)
+
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestChangeAccountStatusUseCase_Handle_Close(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewChangeAccountStatusUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountStatus", acc).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "AccountClosed"
+	})).Return(nil)
+
+	// act
+	err := useCase.Handle(acc.Number, domain.AccountStatusClosed)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AccountStatusClosed, acc.Status)
+
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestChangeAccountStatusUseCase_Handle_ClosePaysOutAccruedInterest(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewChangeAccountStatusUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+	acc.Type = domain.AccountTypeSavings
+	acc.AccruedInterest = 3
+
+	messageTypes := []string{}
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountInterest", acc).Return(nil)
+	mockRepo.On("UpdateAccountStatus", acc).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.InterestLedgerTransaction && transaction.Entries[1].AccountNumber == acc.Number && transaction.Entries[1].Amount == 3
+	})).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.WithdrawLedgerTransaction && transaction.Entries[0].AccountNumber == acc.Number && transaction.Entries[0].Amount == -3
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Run(func(args mock.Arguments) {
+		messageTypes = append(messageTypes, args.Get(0).(*domain.OutboxMessage).Type)
+	}).Return(nil)
+
+	// act
+	err := useCase.Handle(acc.Number, domain.AccountStatusClosed)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AccountStatusClosed, acc.Status)
+	assert.Equal(t, int64(0), acc.Balance)
+	assert.Equal(t, int64(0), acc.AccruedInterest)
+	assert.Equal(t, []string{"InterestCredited", "FundsWithdrawn", "AccountClosed"}, messageTypes)
+
+	mockRepo.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+}
+
+func TestChangeAccountStatusUseCase_Handle_CloseWithBalance(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewChangeAccountStatusUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+	acc.Balance = 10
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	// act
+	err := useCase.Handle(acc.Number, domain.AccountStatusClosed)
+
+	// assert
+	assert.Equal(t, domain.ErrCloseAccountWithBalance, err)
+	assert.Equal(t, domain.AccountStatusActive, acc.Status)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountStatus", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestChangeAccountStatusUseCase_Handle_AccountNotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewChangeAccountStatusUseCase(mockRepo)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"1"}).Return(map[string]*domain.Account{}, nil)
+
+	// act
+	err := useCase.Handle("1", domain.AccountStatusBlocked)
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "account not found", err.Error())
+}
+
+func TestChangeAccountStatusUseCase_Handle_ErrorUpdatingStatus(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewChangeAccountStatusUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountStatus", acc).Return(errors.New("update error"))
+
+	// act
+	err := useCase.Handle(acc.Number, domain.AccountStatusBlocked)
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "update error", err.Error())
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type ChargeMaintenanceFeesUseCaseInterface interface {
+	Handle() (int, error)
+}
+
+type ChargeMaintenanceFeesUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+	feeSchedule       *domain.FeeSchedule
+	batchSize         int
+}
+
+func NewChargeMaintenanceFeesUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	feeSchedule *domain.FeeSchedule,
+	batchSize int) *ChargeMaintenanceFeesUseCase {
+	return &ChargeMaintenanceFeesUseCase{
+		accountRepository: accountRepository,
+		feeSchedule:       feeSchedule,
+		batchSize:         batchSize,
+	}
+}
+
+// Handle charges the maintenance fee of the current month to a batch of accounts not charged
+// yet and returns how many were charged. Each account is read again after being locked, so a
+// month is never charged twice by concurrent runs nor charged to an account closed meanwhile.
+func (us *ChargeMaintenanceFeesUseCase) Handle() (int, error) {
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	dueAccounts, err := us.accountRepository.GetAccountsDueMaintenanceFee(monthStart, us.batchSize)
+	if err != nil {
+		slog.Error("error getting accounts due maintenance fee", "error", err)
+		return 0, err
+	}
+
+	charged := 0
+	var chargeErr error
+
+	for _, dueAccount := range dueAccounts {
+		chargedAccount := false
+
+		err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+			accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(dueAccount.Number)
+			if err != nil {
+				return err
+			}
+
+			acc := accounts[dueAccount.Number]
+			if acc == nil || acc.Status == domain.AccountStatusClosed || !acc.MaintenanceFeeDue(now) {
+				return nil
+			}
+
+			err = us.charge(uow, acc, now)
+			if err != nil {
+				return err
+			}
+
+			chargedAccount = true
+
+			return nil
+		})
+
+		if err != nil {
+			slog.Error("error charging maintenance fee", "error", err, "number", dueAccount.Number)
+			chargeErr = err
+			continue
+		}
+
+		if chargedAccount {
+			charged++
+		}
+	}
+
+	if charged > 0 {
+		slog.Info("maintenance fees charged", "count", charged)
+	}
+
+	return charged, chargeErr
+}
+
+func (us *ChargeMaintenanceFeesUseCase) charge(uow repositories.UnitOfWorkInterface, acc *domain.Account, now time.Time) error {
+	wasInOverdraft := acc.InOverdraft()
+	fee, err := us.feeSchedule.MaintenanceFee(acc)
+	if err != nil {
+		slog.Error("error computing maintenance fee", "error", err, "number", acc.Number)
+		return err
+	}
+
+	err = acc.ChargeMaintenanceFee(fee, now)
+	if err != nil {
+		return err
+	}
+
+	if fee.Amount > 0 {
+		err = uow.AccountRepository().UpdateAccountBalance(acc)
+		if err != nil {
+			slog.Error("error updating account balance", "error", err)
+			return err
+		}
+
+		err = recordFee(uow, acc, domain.FeeOperationMaintenance, fee, "")
+		if err != nil {
+			return err
+		}
+
+		err = addEnteredOverdraftEventToOutbox(uow.OutboxRepository(), acc, wasInOverdraft)
+		if err != nil {
+			slog.Error("error adding account entered overdraft event to outbox", "error", err)
+			return err
+		}
+	}
+
+	return uow.AccountRepository().UpdateAccountMaintenanceFee(acc)
+}
@@ -0,0 +1,192 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChargeMaintenanceFeesUseCase_Handle_ChargesDueAccounts(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, nil, map[domain.DocumentType]int64{domain.DocumentTypePerson: 1290}, nil)
+	useCase := NewChargeMaintenanceFeesUseCase(mockRepo, feeSchedule, 10)
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	acc := domain.NewAccount("21", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.CreatedAt = monthStart.AddDate(0, -2, 0)
+	acc.MaintenanceFeeChargedOn = monthStart.AddDate(0, -1, 0)
+
+	mockRepo.On("GetAccountsDueMaintenanceFee", monthStart, 10).Return([]*domain.Account{acc}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockRepo.On("UpdateAccountMaintenanceFee", acc).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.FeeLedgerTransaction && transaction.Validate() == nil && transaction.OriginTransactionId == "" &&
+			transaction.Entries[0].AccountNumber == acc.Number && transaction.Entries[0].Amount == -1290
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "90"
+	}).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FeeCharged" &&
+			message.Data == `{"number":"21","operation":"maintenance","value":{"amount":1290,"currency":"BRL"},"balance":{"amount":-290,"currency":"BRL"},"transactionId":"90"}`
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "AccountEnteredOverdraft"
+	})).Return(nil)
+
+	// act
+	charged, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, charged)
+	assert.Equal(t, int64(-290), acc.Balance)
+	assert.False(t, acc.MaintenanceFeeDue(now))
+	mockRepo.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestChargeMaintenanceFeesUseCase_Handle_AlreadyCharged(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, nil, map[domain.DocumentType]int64{domain.DocumentTypePerson: 1290}, nil)
+	useCase := NewChargeMaintenanceFeesUseCase(mockRepo, feeSchedule, 10)
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	listed := domain.NewAccount("21", "01234567890", "John Doe")
+	listed.MaintenanceFeeChargedOn = monthStart.AddDate(0, -1, 0)
+
+	// charged by another run after being listed
+	current := *listed
+	current.MaintenanceFeeChargedOn = monthStart
+
+	mockRepo.On("GetAccountsDueMaintenanceFee", monthStart, 10).Return([]*domain.Account{listed}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{listed.Number}).Return(map[string]*domain.Account{listed.Number: &current}, nil)
+
+	// act
+	charged, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, charged)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateAccountMaintenanceFee", mock.Anything)
+}
+
+func TestChargeMaintenanceFeesUseCase_Handle_ClosedAfterListed(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, nil, map[domain.DocumentType]int64{domain.DocumentTypePerson: 1290}, nil)
+	useCase := NewChargeMaintenanceFeesUseCase(mockRepo, feeSchedule, 10)
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	listed := domain.NewAccount("21", "01234567890", "John Doe")
+	listed.MaintenanceFeeChargedOn = monthStart.AddDate(0, -1, 0)
+
+	// closed by an admin after being listed
+	current := *listed
+	current.Status = domain.AccountStatusClosed
+
+	mockRepo.On("GetAccountsDueMaintenanceFee", monthStart, 10).Return([]*domain.Account{listed}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{listed.Number}).Return(map[string]*domain.Account{listed.Number: &current}, nil)
+
+	// act
+	charged, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, charged)
+	assert.Equal(t, int64(0), current.Balance)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateAccountMaintenanceFee", mock.Anything)
+}
+
+func TestChargeMaintenanceFeesUseCase_Handle_FreeTierOnlyMarksMonth(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, nil, map[domain.DocumentType]int64{domain.DocumentTypeCompany: 4990}, nil)
+	useCase := NewChargeMaintenanceFeesUseCase(mockRepo, feeSchedule, 10)
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	acc := domain.NewAccount("21", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.CreatedAt = monthStart.AddDate(0, -1, 0)
+
+	mockRepo.On("GetAccountsDueMaintenanceFee", monthStart, 10).Return([]*domain.Account{acc}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountMaintenanceFee", acc).Return(nil)
+
+	// act
+	charged, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, charged)
+	assert.Equal(t, int64(1000), acc.Balance)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+}
+
+func TestChargeMaintenanceFeesUseCase_Handle_ConvertsFeeToAccountCurrency(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	fxRates, _ := domain.NewFxRates([]domain.FxRate{{From: "BRL", To: "USD", Rate: "0.19"}})
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, nil, map[domain.DocumentType]int64{domain.DocumentTypePerson: 1290}, fxRates)
+	useCase := NewChargeMaintenanceFeesUseCase(mockRepo, feeSchedule, 10)
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	acc := domain.NewAccount("21", "01234567890", "John Doe")
+	acc.Currency = "USD"
+	acc.Balance = 1000
+	acc.CreatedAt = monthStart.AddDate(0, -2, 0)
+
+	mockRepo.On("GetAccountsDueMaintenanceFee", monthStart, 10).Return([]*domain.Account{acc}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockRepo.On("UpdateAccountMaintenanceFee", acc).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FeeCharged" &&
+			strings.Contains(message.Data, `"value":{"amount":245,"currency":"USD"}`)
+	})).Return(nil)
+
+	// act
+	charged, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, charged)
+	assert.Equal(t, int64(755), acc.Balance)
+	mockOutboxRepository.AssertExpectations(t)
+}
@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type ClosePocketUseCaseInterface interface {
+	Handle(number string, pocketId string) error
+}
+
+type ClosePocketUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewClosePocketUseCase(accountRepository repositories.AccountRepositoryInterface) *ClosePocketUseCase {
+	return &ClosePocketUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handle closes the pocket, moving what is left in it back to the main balance first.
+func (us *ClosePocketUseCase) Handle(number string, pocketId string) error {
+	return us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		pocket, err := uow.PocketsRepository().GetPocket(number, pocketId)
+		if err != nil {
+			slog.Error("error getting pocket", "error", err, "number", number, "pocketId", pocketId)
+			return err
+		}
+
+		if pocket == nil {
+			slog.Info("pocket not found", "number", number, "pocketId", pocketId)
+			return errors.New("pocket not found")
+		}
+
+		returned, err := acc.ClosePocket(pocket)
+		if err != nil {
+			slog.Info("pocket close not allowed", "error", err, "number", number, "pocketId", pocketId)
+			return err
+		}
+
+		err = uow.AccountRepository().UpdateAccountBalance(acc)
+		if err != nil {
+			slog.Error("error updating account balance", "error", err)
+			return err
+		}
+
+		err = uow.PocketsRepository().UpdatePocket(pocket)
+		if err != nil {
+			slog.Error("error updating pocket", "error", err)
+			return err
+		}
+
+		if returned.Amount > 0 {
+			err = addEventToOutbox(uow.OutboxRepository(), events.NewPocketFundsMoved(acc.Number, pocket.Id, pocket.Name, domain.PocketMoveOut, returned, acc.Money(0)))
+			if err != nil {
+				slog.Error("error adding pocket funds moved event to outbox", "error", err)
+				return err
+			}
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewPocketClosed(acc.Number, pocket.Id, pocket.Name))
+		if err != nil {
+			slog.Error("error adding pocket closed event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("pocket closed", "number", number, "pocketId", pocket.Id, "returned", returned)
+
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClosePocketUseCase_Handle_ReturnsBalance(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewClosePocketUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.PocketBalance = 300
+	pocket := &domain.Pocket{Id: "4", AccountNumber: acc.Number, Name: "Vacation", Balance: 300, Status: domain.PocketStatusActive}
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockPocketsRepository.On("GetPocket", acc.Number, "4").Return(pocket, nil)
+	mockPocketsRepository.On("UpdatePocket", pocket).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "PocketFundsMoved" &&
+			message.Data == `{"number":"19","pocketId":"4","pocketName":"Vacation","direction":"out","value":{"amount":300,"currency":"BRL"},"pocketBalance":{"amount":0,"currency":"BRL"}}`
+	})).Return(nil).Once()
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "PocketClosed" && message.Data == `{"number":"19","pocketId":"4","name":"Vacation"}`
+	})).Return(nil).Once()
+
+	// act
+	err := useCase.Handle(acc.Number, "4")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PocketStatusClosed, pocket.Status)
+	assert.Equal(t, int64(0), acc.PocketBalance)
+
+	mockRepo.AssertExpectations(t)
+	mockPocketsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestClosePocketUseCase_Handle_EmptyPocket(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewClosePocketUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	pocket := &domain.Pocket{Id: "4", AccountNumber: acc.Number, Name: "Vacation", Status: domain.PocketStatusActive}
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockPocketsRepository.On("GetPocket", acc.Number, "4").Return(pocket, nil)
+	mockPocketsRepository.On("UpdatePocket", pocket).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "PocketClosed"
+	})).Return(nil).Once()
+
+	// act
+	err := useCase.Handle(acc.Number, "4")
+
+	// assert
+	assert.NoError(t, err)
+	mockOutboxRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertNumberOfCalls(t, "CreateMessage", 1)
+}
+
+func TestClosePocketUseCase_Handle_AlreadyClosed(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewClosePocketUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	pocket := &domain.Pocket{Id: "4", AccountNumber: acc.Number, Name: "Vacation", Status: domain.PocketStatusClosed}
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockPocketsRepository.On("GetPocket", acc.Number, "4").Return(pocket, nil)
+
+	// act
+	err := useCase.Handle(acc.Number, "4")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrPocketNotActive)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+}
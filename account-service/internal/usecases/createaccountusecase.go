@@ -5,66 +5,113 @@ import (
 	"log/slog"
 
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
-	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/infrastructure/broker"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
 )
 
 type CreateAccountUseCaseInterface interface {
-	Handle(document string, name string) (string, error)
+	Handle(holders []*domain.AccountHolder, currency string, accountType string) (string, error)
 }
 
 type CreateAccountUseCase struct {
-	accountRepository repositories.AccountRepositoryInterface
-	broker            broker.BrokerInterface
+	accountRepository   repositories.AccountRepositoryInterface
+	accountNumberPolicy domain.AccountNumberPolicy
 }
 
-func NewCreateAccountUseCase(accountRepository repositories.AccountRepositoryInterface, broker broker.BrokerInterface) *CreateAccountUseCase {
+func NewCreateAccountUseCase(accountRepository repositories.AccountRepositoryInterface, accountNumberPolicy domain.AccountNumberPolicy) *CreateAccountUseCase {
 	return &CreateAccountUseCase{
-		accountRepository: accountRepository,
-		broker:            broker,
+		accountRepository:   accountRepository,
+		accountNumberPolicy: accountNumberPolicy,
 	}
 }
 
-func (us *CreateAccountUseCase) Handle(document string, name string) (string, error) {
-	acc, err := us.accountRepository.GetAccountByDocument(document)
+// Handle opens an account for holders, one of them the primary holder. A document is the primary
+// holder of a single account, but may be a secondary holder of any number of accounts. Documents
+// are checked before opening the account, and the unique index on primary holders rejects an
+// account opened concurrently for the same document with ErrDocumentInUse.
+func (us *CreateAccountUseCase) Handle(holders []*domain.AccountHolder, currency string, accountType string) (string, error) {
+	for _, holder := range holders {
+		if holder.Role != domain.AccountHolderRolePrimary {
+			continue
+		}
+
+		accounts, err := us.accountRepository.GetAccountsByDocument(holder.Document)
+		if err != nil {
+			slog.Error("Error getting accounts by document", "error", err)
+			return "", err
+		}
+
+		for _, acc := range accounts {
+			if acc.Document == holder.Document {
+				slog.Info("document in use by another account", "document", holder.Document)
+				return "", domain.ErrDocumentInUse
+			}
+		}
+	}
+
+	sequence, err := us.accountRepository.GetNextAccountSequence()
 	if err != nil {
-		slog.Error("Error getting account by document", "error", err)
+		slog.Error("error get next account sequence", "error", err)
 		return "", err
 	}
 
-	if acc != nil {
-		slog.Info("document in use by another account", "document", document)
-		return "", errors.New("document in use by another account")
-	}
+	number := us.accountNumberPolicy.NewNumber(sequence)
 
-	number, err := us.accountRepository.GetNextAccountNumber()
+	account, err := domain.NewJointAccount(number, holders)
 	if err != nil {
-		slog.Error("error get next account number", "error", err)
+		slog.Error("Invalid account holders", "error", err)
 		return "", err
 	}
 
-	account := domain.NewAccount(number, document, name)
+	account.Currency = domain.NormalizeCurrency(currency)
+	if accountType != "" {
+		account.Type = domain.AccountType(accountType)
+	}
+
 	err = account.Validate()
 	if err != nil {
 		slog.Error("Invalid account", "error", err)
 		return "", err
 	}
 
-	id, err := us.accountRepository.CreateAccount(account)
-	if err != nil {
-		slog.Error("error creating account", "error", err)
-		return "", err
-	}
+	var id string
+	err = us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		id, err = uow.AccountRepository().CreateAccount(account)
+		if err != nil {
+			slog.Error("error creating account", "error", err)
+			return err
+		}
+
+		eventHolders := []events.AccountHolder{}
+		for _, holder := range account.Holders {
+			_, err = uow.AccountHoldersRepository().CreateAccountHolder(holder)
+			if errors.Is(err, domain.ErrDocumentInUse) {
+				slog.Info("document in use by another account", "document", holder.Document)
+				return err
+			}
+
+			if err != nil {
+				slog.Error("error creating account holder", "error", err)
+				return err
+			}
+
+			eventHolders = append(eventHolders, events.AccountHolder{Document: holder.Document, Name: holder.Name, Role: string(holder.Role)})
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewAccountCreated(number, account.Name, account.Document, string(account.Currency), eventHolders))
+		if err != nil {
+			slog.Error("error adding account created event to outbox", "error", err)
+			return err
+		}
+
+		return nil
+	})
 
-	event, err := events.NewEventPublish(events.NewAccountCreated(number, name, document))
 	if err != nil {
-		slog.Error("error creating account created event", "error", err)
 		return "", err
 	}
 
-	us.broker.Produce(event, &broker.ProduceConfigs{Topic: "account"})
+	slog.Info("account created", "id", id, "number", number, "document", account.Document, "name", account.Name, "holders", len(account.Holders), "currency", account.Currency, "type", account.Type)
 
-	slog.Info("account created", "id", id, "document", document, "name", name)
-	return id, nil
+	return number, nil
 }
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
@@ -10,47 +11,54 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+func primaryHolder(document string, name string) []*domain.AccountHolder {
+	return []*domain.AccountHolder{domain.NewAccountHolder(document, name, domain.AccountHolderRolePrimary)}
+}
+
 func TestCreateAccountUseCase_Handle_Success(t *testing.T) {
 	// act
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
-	useCase := NewCreateAccountUseCase(mockRepo, mockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("1", nil)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
 
-	document := "12345678901"
+	document := "01234567890"
 
-	mockRepo.On("GetAccountByDocument", document).Return((*domain.Account)(nil), nil)
-	mockRepo.On("GetNextAccountNumber").Return("987654321", nil)
+	mockRepo.On("GetAccountsByDocument", document).Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
 	mockRepo.On("CreateAccount", mock.Anything).Return("1", nil)
-
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Topic == "account" && message.Type == "AccountCreated"
+	})).Return(nil)
 
 	// act
-	id, err := useCase.Handle(document, "John Doe")
+	number, err := useCase.Handle(primaryHolder(document, "John Doe"), "", "")
 
 	// assert
 	assert.NoError(t, err)
-	assert.Equal(t, "1", id)
+	assert.Equal(t, "19", number)
 	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
 }
 
 func TestCreateAccountUseCase_Handle_DocumentInUse(t *testing.T) {
 	// arange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
-	useCase := NewCreateAccountUseCase(mockRepo, mockBroker)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
 
 	existingAccount := &domain.Account{
 		Id:       "1",
 		Number:   "987654321",
 		Name:     "Jane Doe",
-		Document: "12345678901",
+		Document: "01234567890",
 		Balance:  1000.0,
 	}
-	mockRepo.On("GetAccountByDocument", "12345678901").Return(existingAccount, nil)
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{existingAccount}, nil)
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
 	// act
-	id, err := useCase.Handle("12345678901", "John Doe")
+	id, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "")
 
 	// assert
 	assert.Error(t, err)
@@ -59,41 +67,58 @@ func TestCreateAccountUseCase_Handle_DocumentInUse(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
-func TestCreateAccountUseCase_Handle_GetNextAccountNumberError(t *testing.T) {
+func TestCreateAccountUseCase_Handle_DocumentInUseConcurrently(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
-	useCase := NewCreateAccountUseCase(mockRepo, mockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.Anything).Return("1", nil)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("", domain.ErrDocumentInUse)
 
-	mockRepo.On("GetAccountByDocument", "12345678901").Return((*domain.Account)(nil), nil)
-	mockRepo.On("GetNextAccountNumber").Return("", errors.New("error getting next account number"))
+	// act
+	number, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "")
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	// assert
+	assert.Equal(t, domain.ErrDocumentInUse, err)
+	assert.Equal(t, "", number)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestCreateAccountUseCase_Handle_GetNextAccountSequenceError(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(0), errors.New("error getting next account sequence"))
 
 	// act
-	id, err := useCase.Handle("12345678901", "John Doe")
+	id, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "")
 
 	// assert
 	assert.Error(t, err)
 	assert.Equal(t, "", id)
-	assert.Equal(t, "error getting next account number", err.Error())
+	assert.Equal(t, "error getting next account sequence", err.Error())
 	mockRepo.AssertExpectations(t)
 }
 
 func TestCreateAccountUseCase_Handle_CreateAccountError(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
-	useCase := NewCreateAccountUseCase(mockRepo, mockBroker)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
 
-	mockRepo.On("GetAccountByDocument", "12345678901").Return((*domain.Account)(nil), nil)
-	mockRepo.On("GetNextAccountNumber").Return("987654321", nil)
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
 	mockRepo.On("CreateAccount", mock.Anything).Return("", errors.New("error creating account"))
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
-
 	// act
-	id, err := useCase.Handle("12345678901", "John Doe")
+	id, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "")
 
 	// assert
 	assert.Error(t, err)
@@ -101,3 +126,296 @@ func TestCreateAccountUseCase_Handle_CreateAccountError(t *testing.T) {
 	assert.Equal(t, "error creating account", err.Error())
 	mockRepo.AssertExpectations(t)
 }
+
+func TestCreateAccountUseCase_Handle_AddEventToOutboxError(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("1", nil)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.Anything).Return("1", nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(errors.New("error creating outbox message"))
+
+	// act
+	id, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "", id)
+	assert.Equal(t, "error creating outbox message", err.Error())
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestCreateAccountUseCase_Handle_FormattedDocument(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("1", nil)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "11222333000181").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.MatchedBy(func(acc *domain.Account) bool {
+		return acc.Number == "19" && acc.Document == "11222333000181" && acc.DocumentType() == domain.DocumentTypeCompany
+	})).Return("1", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return strings.Contains(message.Data, `"document":"11222333000181"`)
+	})).Return(nil)
+
+	// act
+	number, err := useCase.Handle(primaryHolder("11.222.333/0001-81", "John Doe Company"), "", "")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "19", number)
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestCreateAccountUseCase_Handle_InvalidDocument(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "00000000000").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+
+	// act
+	id, err := useCase.Handle(primaryHolder("000.000.000-00", "John Doe"), "", "")
+
+	// assert
+	assert.Equal(t, domain.ErrInvalidDocument, err)
+	assert.Equal(t, "", id)
+	mockRepo.AssertNotCalled(t, "CreateAccount", mock.Anything)
+}
+
+func TestCreateAccountUseCase_Handle_BranchPrefix(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("1", nil)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{Branch: "0001"})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.MatchedBy(func(acc *domain.Account) bool {
+		return acc.Number == "000116"
+	})).Return("1", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return strings.Contains(message.Data, `"number":"000116"`)
+	})).Return(nil)
+
+	// act
+	number, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "000116", number)
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestCreateAccountUseCase_Handle_AboveLegacyNumbers(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("1", nil)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{LegacyMaxNumber: 100})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.MatchedBy(func(acc *domain.Account) bool {
+		return acc.Number == "1015"
+	})).Return("1", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return strings.Contains(message.Data, `"number":"1015"`)
+	})).Return(nil)
+
+	// act
+	number, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "1015", number)
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestCreateAccountUseCase_Handle_Currency(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("1", nil)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.MatchedBy(func(acc *domain.Account) bool {
+		return acc.Currency == "USD"
+	})).Return("1", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "AccountCreated" && message.Data == `{"number":"19","name":"John Doe","document":"01234567890","currency":"USD","holders":[{"document":"01234567890","name":"John Doe","role":"primary"}]}`
+	})).Return(nil)
+
+	// act
+	number, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "usd", "")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "19", number)
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestCreateAccountUseCase_Handle_UnsupportedCurrency(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+
+	// act
+	_, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "XYZ", "")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrUnsupportedCurrency)
+	mockRepo.AssertNotCalled(t, "CreateAccount", mock.Anything)
+}
+
+func TestCreateAccountUseCase_Handle_SavingsAccount(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("1", nil)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.MatchedBy(func(acc *domain.Account) bool {
+		return acc.Type == domain.AccountTypeSavings
+	})).Return("1", nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	// act
+	number, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "savings")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "19", number)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateAccountUseCase_Handle_InvalidAccountType(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+
+	// act
+	_, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "investment")
+
+	// assert
+	assert.Equal(t, domain.ErrInvalidAccountType, err)
+	mockRepo.AssertNotCalled(t, "CreateAccount", mock.Anything)
+}
+
+func TestCreateAccountUseCase_Handle_JointAccount(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	holders := []*domain.AccountHolder{
+		domain.NewAccountHolder("01234567890", "John Doe", domain.AccountHolderRolePrimary),
+		domain.NewAccountHolder("529.982.247-25", "Jane Doe", domain.AccountHolderRoleSecondary),
+	}
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.MatchedBy(func(acc *domain.Account) bool {
+		return acc.Document == "01234567890" && acc.Name == "John Doe" && len(acc.Holders) == 2
+	})).Return("1", nil)
+	mockHoldersRepository.On("CreateAccountHolder", mock.MatchedBy(func(holder *domain.AccountHolder) bool {
+		return holder.AccountNumber == "19"
+	})).Return("1", nil).Twice()
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return strings.Contains(message.Data, `{"document":"52998224725","name":"Jane Doe","role":"secondary"}`)
+	})).Return(nil)
+
+	// act
+	number, err := useCase.Handle(holders, "", "")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "19", number)
+	mockRepo.AssertExpectations(t)
+	mockHoldersRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetAccountsByDocument", "52998224725")
+}
+
+func TestCreateAccountUseCase_Handle_SecondaryHolderOfAnotherAccount(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	jointAccount := domain.NewAccount("27", "52998224725", "Jane Doe")
+
+	mockRepo.On("GetAccountsByDocument", "01234567890").Return([]*domain.Account{jointAccount}, nil)
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountHoldersRepository(mockHoldersRepository), nil)
+	mockRepo.On("CreateAccount", mock.Anything).Return("1", nil)
+	mockHoldersRepository.On("CreateAccountHolder", mock.Anything).Return("1", nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	// act
+	number, err := useCase.Handle(primaryHolder("01234567890", "John Doe"), "", "")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "19", number)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateAccountUseCase_Handle_WithoutPrimaryHolder(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	useCase := NewCreateAccountUseCase(mockRepo, domain.AccountNumberPolicy{})
+
+	holders := []*domain.AccountHolder{
+		domain.NewAccountHolder("01234567890", "John Doe", domain.AccountHolderRoleSecondary),
+	}
+
+	mockRepo.On("GetNextAccountSequence").Return(int64(1), nil)
+
+	// act
+	_, err := useCase.Handle(holders, "", "")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrInvalidHolders)
+	mockRepo.AssertNotCalled(t, "GetAccountsByDocument", mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateAccount", mock.Anything)
+}
@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type CreatePayeeUseCaseInterface interface {
+	Handle(number string, nickname string, toNumber string, pixKey string) (*domain.Payee, error)
+}
+
+type CreatePayeeUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+	pixKeysRepository repositories.PixKeysRepositoryInterface
+	payeesRepository  repositories.PayeesRepositoryInterface
+}
+
+func NewCreatePayeeUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	pixKeysRepository repositories.PixKeysRepositoryInterface,
+	payeesRepository repositories.PayeesRepositoryInterface) *CreatePayeeUseCase {
+	return &CreatePayeeUseCase{
+		accountRepository: accountRepository,
+		pixKeysRepository: pixKeysRepository,
+		payeesRepository:  payeesRepository,
+	}
+}
+
+// Handle saves a payee for the account after checking the destination exists, the account
+// a pix key resolves to is kept as the payee account number. Each destination is saved once.
+func (us *CreatePayeeUseCase) Handle(number string, nickname string, toNumber string, pixKey string) (*domain.Payee, error) {
+	acc, err := us.accountRepository.GetAccountByNumber(number)
+	if err != nil {
+		slog.Error("error getting account by number", "error", err)
+		return nil, err
+	}
+
+	if acc == nil {
+		slog.Info("account not found", "number", number)
+		return nil, errors.New("account not found")
+	}
+
+	err = acc.EnsureActive()
+	if err != nil {
+		slog.Info("account not active", "number", acc.Number, "status", acc.Status)
+		return nil, err
+	}
+
+	payee, err := domain.NewPayee(acc, nickname, toNumber, pixKey)
+	if err != nil {
+		slog.Info("invalid payee", "error", err, "number", number)
+		return nil, err
+	}
+
+	err = us.resolveDestination(acc, payee)
+	if err != nil {
+		return nil, err
+	}
+
+	payees, err := us.payeesRepository.GetPayeesByAccount(number)
+	if err != nil {
+		slog.Error("error getting payees by account", "error", err)
+		return nil, err
+	}
+
+	for _, existing := range payees {
+		if existing.Matches(payee.ToNumber, payee.PixKey) {
+			slog.Info("payee already registered", "number", number, "payeeId", existing.Id)
+			return nil, domain.ErrPayeeAlreadyRegistered
+		}
+	}
+
+	_, err = us.payeesRepository.CreatePayee(payee)
+	if err != nil {
+		slog.Error("error creating payee", "error", err)
+		return nil, err
+	}
+
+	slog.Info("payee created", "number", number, "id", payee.Id)
+
+	return payee, nil
+}
+
+func (us *CreatePayeeUseCase) resolveDestination(acc *domain.Account, payee *domain.Payee) error {
+	if payee.PixKey != "" {
+		resolved, err := us.pixKeysRepository.GetPixKey(payee.PixKey)
+		if err != nil {
+			slog.Error("error getting pix key", "error", err)
+			return err
+		}
+
+		if resolved == nil {
+			slog.Info("pix key not found", "number", acc.Number)
+			return errors.New("pix key not found")
+		}
+
+		if resolved.AccountNumber == acc.Number {
+			return errors.New("payee should not be the account itself")
+		}
+
+		payee.ToNumber = resolved.AccountNumber
+
+		return nil
+	}
+
+	toAcc, err := us.accountRepository.GetAccountByNumber(payee.ToNumber)
+	if err != nil {
+		slog.Error("error getting account by number", "error", err)
+		return err
+	}
+
+	if toAcc == nil || toAcc.Status == domain.AccountStatusClosed {
+		slog.Info("payee account not found", "number", acc.Number, "toNumber", payee.ToNumber)
+		return errors.New("payee account not found")
+	}
+
+	return nil
+}
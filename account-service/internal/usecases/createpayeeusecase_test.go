@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreatePayeeUseCase_Handle_ToAccountNumber(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewCreatePayeeUseCase(mockAccountRepository, nil, mockPayeesRepository)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+	mockAccountRepository.On("GetAccountByNumber", "27").Return(domain.NewAccount("27", "09876543210", "Jane Doe"), nil)
+	mockPayeesRepository.On("GetPayeesByAccount", "19").Return([]*domain.Payee{{Id: "4", ToNumber: "35"}}, nil)
+	mockPayeesRepository.On("CreatePayee", mock.MatchedBy(func(payee *domain.Payee) bool {
+		return payee.AccountNumber == "19" && payee.Nickname == "Jane" && payee.ToNumber == "27" && payee.PixKey == ""
+	})).Return("5", nil)
+
+	// act
+	payee, err := useCase.Handle("19", "Jane", "27", "")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "27", payee.ToNumber)
+	mockPayeesRepository.AssertExpectations(t)
+}
+
+func TestCreatePayeeUseCase_Handle_ToPixKey(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewCreatePayeeUseCase(mockAccountRepository, mockPixKeysRepository, mockPayeesRepository)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+	mockPixKeysRepository.On("GetPixKey", "jane@mail.com").Return(&domain.PixKey{AccountNumber: "27", Type: domain.PixKeyEmail, Key: "jane@mail.com"}, nil)
+	mockPayeesRepository.On("GetPayeesByAccount", "19").Return([]*domain.Payee{{Id: "4", ToNumber: "27"}}, nil)
+	mockPayeesRepository.On("CreatePayee", mock.MatchedBy(func(payee *domain.Payee) bool {
+		return payee.ToNumber == "27" && payee.PixKey == "jane@mail.com"
+	})).Return("5", nil)
+
+	// act
+	payee, err := useCase.Handle("19", "Jane", "", "Jane@Mail.com")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "jane@mail.com", payee.PixKey)
+	mockPayeesRepository.AssertExpectations(t)
+}
+
+func TestCreatePayeeUseCase_Handle_DestinationNotFound(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewCreatePayeeUseCase(mockAccountRepository, nil, mockPayeesRepository)
+
+	closed := domain.NewAccount("27", "09876543210", "Jane Doe")
+	closed.Status = domain.AccountStatusClosed
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+	mockAccountRepository.On("GetAccountByNumber", "27").Return(closed, nil)
+
+	// act
+	payee, err := useCase.Handle("19", "Jane", "27", "")
+
+	// assert
+	assert.Nil(t, payee)
+	assert.Equal(t, "payee account not found", err.Error())
+	mockPayeesRepository.AssertNotCalled(t, "CreatePayee", mock.Anything)
+}
+
+func TestCreatePayeeUseCase_Handle_OwnPixKey(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewCreatePayeeUseCase(mockAccountRepository, mockPixKeysRepository, mockPayeesRepository)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+	mockPixKeysRepository.On("GetPixKey", "01234567890").Return(&domain.PixKey{AccountNumber: "19", Type: domain.PixKeyDocument, Key: "01234567890"}, nil)
+
+	// act
+	_, err := useCase.Handle("19", "Me", "", "01234567890")
+
+	// assert
+	assert.Error(t, err)
+	mockPayeesRepository.AssertNotCalled(t, "CreatePayee", mock.Anything)
+}
+
+func TestCreatePayeeUseCase_Handle_AlreadyRegistered(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewCreatePayeeUseCase(mockAccountRepository, nil, mockPayeesRepository)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+	mockAccountRepository.On("GetAccountByNumber", "27").Return(domain.NewAccount("27", "09876543210", "Jane Doe"), nil)
+	mockPayeesRepository.On("GetPayeesByAccount", "19").Return([]*domain.Payee{{Id: "4", ToNumber: "27"}}, nil)
+
+	// act
+	_, err := useCase.Handle("19", "Jane", "27", "")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrPayeeAlreadyRegistered)
+	mockPayeesRepository.AssertNotCalled(t, "CreatePayee", mock.Anything)
+}
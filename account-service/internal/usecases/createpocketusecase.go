@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type CreatePocketUseCaseInterface interface {
+	Handle(number string, name string) (*domain.Pocket, error)
+}
+
+type CreatePocketUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewCreatePocketUseCase(accountRepository repositories.AccountRepositoryInterface) *CreatePocketUseCase {
+	return &CreatePocketUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handle creates an empty pocket in the account. The account is locked so concurrent requests
+// cannot exceed the maximum of pockets nor repeat a name.
+func (us *CreatePocketUseCase) Handle(number string, name string) (*domain.Pocket, error) {
+	var pocket *domain.Pocket
+
+	err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		pockets, err := uow.PocketsRepository().GetPocketsByAccount(number)
+		if err != nil {
+			slog.Error("error getting pockets by account", "error", err, "number", number)
+			return err
+		}
+
+		pocket, err = domain.NewPocket(acc, name, pockets)
+		if err != nil {
+			slog.Info("invalid pocket", "error", err, "number", number)
+			return err
+		}
+
+		_, err = uow.PocketsRepository().CreatePocket(pocket)
+		if err != nil {
+			slog.Error("error creating pocket", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewPocketCreated(acc.Number, pocket.Id, pocket.Name))
+		if err != nil {
+			slog.Error("error adding pocket created event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("pocket created", "number", number, "pocketId", pocket.Id)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pocket, nil
+}
@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreatePocketUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewCreatePocketUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockPocketsRepository.On("GetPocketsByAccount", acc.Number).Return([]*domain.Pocket{{Name: "Taxes"}}, nil)
+	mockPocketsRepository.On("CreatePocket", mock.MatchedBy(func(pocket *domain.Pocket) bool {
+		return pocket.AccountNumber == acc.Number && pocket.Name == "Vacation" && pocket.Status == domain.PocketStatusActive
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.Pocket).Id = "4"
+	}).Return("4", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "PocketCreated" && message.Data == `{"number":"19","pocketId":"4","name":"Vacation"}`
+	})).Return(nil)
+
+	// act
+	pocket, err := useCase.Handle(acc.Number, "Vacation")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "4", pocket.Id)
+
+	mockRepo.AssertExpectations(t)
+	mockPocketsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestCreatePocketUseCase_Handle_DuplicatedName(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewCreatePocketUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockPocketsRepository.On("GetPocketsByAccount", acc.Number).Return([]*domain.Pocket{{Name: "Vacation"}}, nil)
+
+	// act
+	pocket, err := useCase.Handle(acc.Number, "vacation")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrPocketAlreadyExists)
+	assert.Nil(t, pocket)
+
+	mockPocketsRepository.AssertNotCalled(t, "CreatePocket", mock.Anything)
+}
+
+func TestCreatePocketUseCase_Handle_AccountNotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewCreatePocketUseCase(mockRepo)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"19"}).Return(map[string]*domain.Account{}, nil)
+
+	// act
+	pocket, err := useCase.Handle("19", "Vacation")
+
+	// assert
+	assert.EqualError(t, err, "account not found")
+	assert.Nil(t, pocket)
+}
@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type CreateScheduledTransferUseCaseInterface interface {
+	Handle(scheduledTransfer *domain.ScheduledTransfer) (string, error)
+}
+
+type CreateScheduledTransferUseCase struct {
+	accountRepository            repositories.AccountRepositoryInterface
+	scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface
+}
+
+func NewCreateScheduledTransferUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface) *CreateScheduledTransferUseCase {
+	return &CreateScheduledTransferUseCase{
+		accountRepository:            accountRepository,
+		scheduledTransfersRepository: scheduledTransfersRepository,
+	}
+}
+
+func (us *CreateScheduledTransferUseCase) Handle(scheduledTransfer *domain.ScheduledTransfer) (string, error) {
+	fromAcc, err := us.accountRepository.GetAccountByNumber(scheduledTransfer.FromNumber)
+	if err != nil {
+		slog.Error("error getting account by number", "error", err)
+		return "", err
+	}
+
+	if fromAcc == nil {
+		slog.Info("from account not found", "fromNumber", scheduledTransfer.FromNumber)
+		return "", errors.New("from account not found")
+	}
+
+	err = fromAcc.EnsureActive()
+	if err != nil {
+		slog.Info("account not active", "number", fromAcc.Number, "status", fromAcc.Status)
+		return "", err
+	}
+
+	toAcc, err := us.accountRepository.GetAccountByNumber(scheduledTransfer.ToNumber)
+	if err != nil {
+		slog.Error("error getting account by number", "error", err)
+		return "", err
+	}
+
+	if toAcc == nil {
+		slog.Info("to account not found", "toNumber", scheduledTransfer.ToNumber)
+		return "", errors.New("to account not found")
+	}
+
+	id, err := us.scheduledTransfersRepository.CreateScheduledTransfer(scheduledTransfer)
+	if err != nil {
+		slog.Error("error creating scheduled transfer", "error", err)
+		return "", err
+	}
+
+	slog.Info("scheduled transfer created", "id", id, "fromNumber", scheduledTransfer.FromNumber, "nextRunAt", scheduledTransfer.NextRunAt)
+
+	return id, nil
+}
@@ -0,0 +1,93 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateScheduledTransferUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+
+	useCase := NewCreateScheduledTransferUseCase(mockRepo, mockScheduledTransfersRepository)
+
+	scheduledTransfer := &domain.ScheduledTransfer{FromNumber: "1", ToNumber: "2", Value: 100}
+
+	mockRepo.On("GetAccountByNumber", "1").Return(domain.NewAccount("1", "01234567890", "John Doe"), nil)
+	mockRepo.On("GetAccountByNumber", "2").Return(domain.NewAccount("2", "01234567891", "Jane Doe"), nil)
+	mockScheduledTransfersRepository.On("CreateScheduledTransfer", scheduledTransfer).Return("10", nil)
+
+	// act
+	id, err := useCase.Handle(scheduledTransfer)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "10", id)
+	mockRepo.AssertExpectations(t)
+	mockScheduledTransfersRepository.AssertExpectations(t)
+}
+
+func TestCreateScheduledTransferUseCase_Handle_FromAccountNotActive(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+
+	useCase := NewCreateScheduledTransferUseCase(mockRepo, mockScheduledTransfersRepository)
+
+	fromAcc := domain.NewAccount("1", "01234567890", "John Doe")
+	fromAcc.Status = domain.AccountStatusBlocked
+
+	mockRepo.On("GetAccountByNumber", "1").Return(fromAcc, nil)
+
+	// act
+	id, err := useCase.Handle(&domain.ScheduledTransfer{FromNumber: "1", ToNumber: "2", Value: 100})
+
+	// assert
+	assert.Equal(t, domain.ErrAccountNotActive, err)
+	assert.Equal(t, "", id)
+	mockScheduledTransfersRepository.AssertNotCalled(t, "CreateScheduledTransfer", mock.Anything)
+}
+
+func TestCreateScheduledTransferUseCase_Handle_ToAccountNotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+
+	useCase := NewCreateScheduledTransferUseCase(mockRepo, mockScheduledTransfersRepository)
+
+	mockRepo.On("GetAccountByNumber", "1").Return(domain.NewAccount("1", "01234567890", "John Doe"), nil)
+	mockRepo.On("GetAccountByNumber", "2").Return((*domain.Account)(nil), nil)
+
+	// act
+	_, err := useCase.Handle(&domain.ScheduledTransfer{FromNumber: "1", ToNumber: "2", Value: 100})
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "to account not found", err.Error())
+	mockScheduledTransfersRepository.AssertNotCalled(t, "CreateScheduledTransfer", mock.Anything)
+}
+
+func TestCreateScheduledTransferUseCase_Handle_ErrorCreating(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+
+	useCase := NewCreateScheduledTransferUseCase(mockRepo, mockScheduledTransfersRepository)
+
+	mockRepo.On("GetAccountByNumber", "1").Return(domain.NewAccount("1", "01234567890", "John Doe"), nil)
+	mockRepo.On("GetAccountByNumber", "2").Return(domain.NewAccount("2", "01234567891", "Jane Doe"), nil)
+	mockScheduledTransfersRepository.On("CreateScheduledTransfer", mock.Anything).Return("", errors.New("insert error"))
+
+	// act
+	_, err := useCase.Handle(&domain.ScheduledTransfer{FromNumber: "1", ToNumber: "2", Value: 100})
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "insert error", err.Error())
+}
@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type DeletePayeeUseCaseInterface interface {
+	Handle(number string, id string) error
+}
+
+type DeletePayeeUseCase struct {
+	payeesRepository repositories.PayeesRepositoryInterface
+}
+
+func NewDeletePayeeUseCase(payeesRepository repositories.PayeesRepositoryInterface) *DeletePayeeUseCase {
+	return &DeletePayeeUseCase{
+		payeesRepository: payeesRepository,
+	}
+}
+
+// Handle deletes the payee only when it belongs to the account, payees of other accounts are
+// reported as not found.
+func (us *DeletePayeeUseCase) Handle(number string, id string) error {
+	deleted, err := us.payeesRepository.DeletePayee(number, id)
+	if err != nil {
+		slog.Error("error deleting payee", "error", err, "number", number)
+		return err
+	}
+
+	if !deleted {
+		slog.Info("payee not found", "number", number, "id", id)
+		return errors.New("payee not found")
+	}
+
+	slog.Info("payee deleted", "number", number, "id", id)
+
+	return nil
+}
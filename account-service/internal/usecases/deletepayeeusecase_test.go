@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"testing"
+
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletePayeeUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewDeletePayeeUseCase(mockPayeesRepository)
+
+	mockPayeesRepository.On("DeletePayee", "19", "5").Return(true, nil)
+
+	// act
+	err := useCase.Handle("19", "5")
+
+	// assert
+	assert.NoError(t, err)
+	mockPayeesRepository.AssertExpectations(t)
+}
+
+func TestDeletePayeeUseCase_Handle_NotOwned(t *testing.T) {
+	// arrange
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewDeletePayeeUseCase(mockPayeesRepository)
+
+	mockPayeesRepository.On("DeletePayee", "19", "5").Return(false, nil)
+
+	// act
+	err := useCase.Handle("19", "5")
+
+	// assert
+	assert.Equal(t, "payee not found", err.Error())
+}
@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type DeletePixKeyUseCaseInterface interface {
+	Handle(number string, key string) error
+}
+
+type DeletePixKeyUseCase struct {
+	pixKeysRepository repositories.PixKeysRepositoryInterface
+}
+
+func NewDeletePixKeyUseCase(pixKeysRepository repositories.PixKeysRepositoryInterface) *DeletePixKeyUseCase {
+	return &DeletePixKeyUseCase{
+		pixKeysRepository: pixKeysRepository,
+	}
+}
+
+// Handle deletes the key only when it is registered for the account, keys of other
+// accounts are reported as not found.
+func (us *DeletePixKeyUseCase) Handle(number string, key string) error {
+	deleted, err := us.pixKeysRepository.DeletePixKey(number, domain.NormalizePixKey(key))
+	if err != nil {
+		slog.Error("error deleting pix key", "error", err, "number", number)
+		return err
+	}
+
+	if !deleted {
+		slog.Info("pix key not found", "number", number)
+		return errors.New("pix key not found")
+	}
+
+	slog.Info("pix key deleted", "number", number)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"testing"
+
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletePixKeyUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	useCase := NewDeletePixKeyUseCase(mockPixKeysRepository)
+
+	mockPixKeysRepository.On("DeletePixKey", "19", "john@mail.com").Return(true, nil)
+
+	// act
+	err := useCase.Handle("19", "John@mail.com")
+
+	// assert
+	assert.NoError(t, err)
+	mockPixKeysRepository.AssertExpectations(t)
+}
+
+func TestDeletePixKeyUseCase_Handle_NotOwned(t *testing.T) {
+	// arrange
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	useCase := NewDeletePixKeyUseCase(mockPixKeysRepository)
+
+	mockPixKeysRepository.On("DeletePixKey", "19", "john@mail.com").Return(false, nil)
+
+	// act
+	err := useCase.Handle("19", "john@mail.com")
+
+	// assert
+	assert.Equal(t, "pix key not found", err.Error())
+}
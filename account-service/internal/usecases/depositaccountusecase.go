@@ -3,83 +3,164 @@ package usecases
 import (
 	"errors"
 	"log/slog"
+	"net/http"
+	"time"
 
-	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/infrastructure/broker"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
 )
 
+const depositOperation = "deposit"
+
 type DepositAccountUseCaseInterface interface {
-	Handle(number string, value int64, idempotencyKey string) error
+	Handle(number string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error)
 }
 
 type DepositAccountUseCase struct {
-	accountRepository         repositories.AccountRepositoryInterface
-	broker                    broker.BrokerInterface
-	idempotencyKeysRepository repositories.IdempotencyKeysRepositoryInterface
+	accountRepository repositories.AccountRepositoryInterface
+	riskRules         domain.RiskRules
+	feeSchedule       *domain.FeeSchedule
 }
 
-func NewDepositAccountUseCase(
-	accountRepository repositories.AccountRepositoryInterface,
-	broker broker.BrokerInterface,
-	idempotencyKeysRepository repositories.IdempotencyKeysRepositoryInterface) *DepositAccountUseCase {
-	return &DepositAccountUseCase{
-		accountRepository:         accountRepository,
-		broker:                    broker,
-		idempotencyKeysRepository: idempotencyKeysRepository,
-	}
+type depositRequest struct {
+	Value    int64           `json:"value"`
+	Currency domain.Currency `json:"currency,omitempty"`
 }
 
-func (us *DepositAccountUseCase) Handle(number string, value int64, idempotencyKey string) error {
-	hasKey, err := us.idempotencyKeysRepository.HasKey(idempotencyKey)
-	if err != nil {
-		slog.Error("Error getting idempotencyKey", "error", err)
-		return err
-	}
-
-	if hasKey {
-		slog.Error("idempotency key already processed", "idempotencyKey", idempotencyKey)
-		return errors.New("idempotency key already processed")
-	}
-
-	acc, err := us.accountRepository.GetAccountByNumber(number)
-	if err != nil {
-		slog.Error("Error getting account by document", "error", err)
-		return err
-	}
-
-	if acc == nil {
-		slog.Info("account not found", "number", number)
-		return errors.New("account not found")
+func NewDepositAccountUseCase(accountRepository repositories.AccountRepositoryInterface, riskRules domain.RiskRules, feeSchedule *domain.FeeSchedule) *DepositAccountUseCase {
+	return &DepositAccountUseCase{
+		accountRepository: accountRepository,
+		riskRules:         riskRules,
+		feeSchedule:       feeSchedule,
 	}
+}
 
-	acc.Deposit(value)
-
-	err = us.accountRepository.UpdateAccountBalance(acc)
+// Handle deposits value into the account and returns the outcome recorded for the idempotency key.
+// A retry with the same key and request returns the outcome of the first execution. Deposits
+// blocked by the risk screening fail with ErrOperationBlocked, keeping the recorded decision.
+// The money of a deposit has already arrived, so a review decision does not stop it, the deposit
+// is credited and the decision is only recorded and published in SuspiciousActivityDetected.
+func (us *DepositAccountUseCase) Handle(number string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	key, err := domain.NewIdempotencyKey(number, requestedBy, idempotencyKey, depositOperation, depositRequest{Value: value.Amount, Currency: value.Currency})
 	if err != nil {
-		slog.Error("error updating account balance", "error", err)
-		return err
+		slog.Info("invalid idempotency key", "error", err)
+		return nil, err
 	}
 
-	event, err := events.NewEventPublish(events.NewFundsDeposited(acc.Number, value))
-	if err != nil {
-		slog.Error("error creating funds deposited event", "error", err)
-		return err
-	}
+	var outcome *domain.IdempotencyKey
+	blocked := false
+	err = us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		outcome, err = getRecordedOutcome(uow.IdempotencyKeysRepository(), key)
+		if err != nil || outcome != nil {
+			return err
+		}
+
+		err = acc.EnsureActive()
+		if err != nil {
+			slog.Info("account not active", "number", acc.Number, "status", acc.Status)
+			return err
+		}
+
+		value = value.OrCurrency(acc.Currency)
+
+		assessment, err := screenRisk(uow, us.riskRules, domain.RiskOperation{
+			Type:          domain.RiskOperationDeposit,
+			AccountNumber: acc.Number,
+			Value:         value.Amount,
+		}, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if assessment.Decision == domain.RiskDecisionBlock {
+			slog.Info("deposit blocked by risk screening", "number", number, "rule", assessment.Rule)
+			blocked = true
+			return nil
+		}
+
+		err = acc.Deposit(value)
+		if err != nil {
+			slog.Info("invalid deposit", "error", err, "number", number)
+			return err
+		}
+
+		fee, err := us.feeSchedule.DepositFee(value)
+		if err != nil {
+			slog.Info("deposit fee not computed", "error", err, "number", number)
+			return err
+		}
+
+		if fee.Amount > 0 {
+			err = acc.ChargeFee(fee)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = uow.AccountRepository().UpdateAccountBalance(acc)
+		if err != nil {
+			slog.Error("error updating account balance", "error", err)
+			return err
+		}
+
+		ledgerTransaction := domain.NewDepositLedgerTransaction(acc.Number, value)
+		err = uow.LedgerRepository().CreateTransaction(ledgerTransaction)
+		if err != nil {
+			slog.Error("error creating ledger transaction", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewFundsDeposited(acc.Number, value))
+		if err != nil {
+			slog.Error("error adding funds deposited event to outbox", "error", err)
+			return err
+		}
+
+		if fee.Amount > 0 {
+			err = recordFee(uow, acc, domain.FeeOperationDeposit, fee, ledgerTransaction.Id)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = key.SetResponse(http.StatusNoContent, nil)
+		if err != nil {
+			return err
+		}
+
+		err = uow.IdempotencyKeysRepository().CreateKey(key)
+		if err != nil {
+			slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", idempotencyKey)
+			return err
+		}
+
+		outcome = key
+
+		slog.Info("Deposit created", "accountNumber", acc.Number, "value", value, "idempotencyKey", idempotencyKey)
+
+		return nil
+	})
 
-	err = us.broker.Produce(event, &broker.ProduceConfigs{Topic: "account"})
 	if err != nil {
-		slog.Error("error producing event", "error", err)
-		return err
+		return nil, err
 	}
 
-	err = us.idempotencyKeysRepository.CreateKey(idempotencyKey)
-	if err != nil {
-		slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", idempotencyKey)
-		return err
+	if blocked {
+		return nil, domain.ErrOperationBlocked
 	}
 
-	slog.Info("Deposit created", "accountNumber", acc.Number, "value", value, "idempotencyKey", idempotencyKey)
-
-	return err
+	return outcome, nil
 }
@@ -2,6 +2,8 @@ package usecases
 
 import (
 	"errors"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -14,26 +16,25 @@ import (
 func TestDepositAccountUseCase_Handle_ErrorGettingAccount(t *testing.T) {
 	// act
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewDepositAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
 
 	acc := domain.NewAccount("4", "01234567890", "John Doo")
 
-	mockRepo.On("GetAccountByNumber", acc.Number).Return(acc, errors.New("generic error"))
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account(nil), errors.New("generic error"))
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
-
 	// act
-	err := useCase.Handle(acc.Number, 150, idempotencyKey.String())
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
 
 	// assert
 	assert.Error(t, err)
+	assert.Nil(t, outcome)
 
 	mockRepo.AssertExpectations(t)
 }
@@ -41,23 +42,21 @@ func TestDepositAccountUseCase_Handle_ErrorGettingAccount(t *testing.T) {
 func TestDepositAccountUseCase_Handle_AccountNotFound(t *testing.T) {
 	// act
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewDepositAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
 
 	acc := domain.NewAccount("4", "01234567890", "John Doo")
 
-	mockRepo.On("GetAccountByNumber", acc.Number).Return((*domain.Account)(nil), nil)
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{}, nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
-
 	// act
-	err := useCase.Handle(acc.Number, 150, idempotencyKey.String())
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
 
 	// assert
 	assert.Error(t, err)
@@ -69,108 +68,326 @@ func TestDepositAccountUseCase_Handle_AccountNotFound(t *testing.T) {
 func TestDepositAccountUseCase_Handle_Success(t *testing.T) {
 	// act
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewDepositAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
 
 	acc := domain.NewAccount("4", "01234567890", "John Doo")
 
-	mockRepo.On("GetAccountByNumber", acc.Number).Return(acc, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
 	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == "deposit" && transaction.Validate() == nil &&
+			transaction.Entries[0].AccountNumber == domain.ExternalLedgerAccount && transaction.Entries[0].Amount == -150 &&
+			transaction.Entries[1].AccountNumber == acc.Number && transaction.Entries[1].Amount == 150
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.MatchedBy(func(key *domain.IdempotencyKey) bool {
+		return key.Key == idempotencyKey.String() && key.Scope == acc.Number && key.Operation == "deposit" && key.StatusCode == http.StatusNoContent
+	})).Return(nil)
 
 	// act
-	err := useCase.Handle(acc.Number, 150, idempotencyKey.String())
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
 
 	// assert
 	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(150), acc.Balance)
 
 	mockRepo.AssertExpectations(t)
 	mockIdempotencyRepository.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
 }
 
-func TestDepositAccountUseCase_Handle_IdempotencyKeyAlreadyUsed(t *testing.T) {
+func TestDepositAccountUseCase_Handle_ReviewByRiskScreeningIsCredited(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockRiskAssessmentsRepository := new(usecases_mock.MockRiskAssessmentsRepository)
+
+	riskRules := domain.RiskRules{
+		{Name: "large-deposits", Type: domain.RiskRuleAmountThreshold, Decision: domain.RiskDecisionReview, MinValue: 100},
+	}
+
+	useCase := NewDepositAccountUseCase(mockRepo, riskRules, nil)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository).
+		WithRiskAssessmentsRepository(mockRiskAssessmentsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockRiskAssessmentsRepository.On("CreateRiskAssessment", mock.MatchedBy(func(assessment *domain.RiskAssessment) bool {
+		return assessment.Decision == domain.RiskDecisionReview && assessment.Rule == "large-deposits"
+	})).Return("7", nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "SuspiciousActivityDetected"
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FundsDeposited"
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
 	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(150), acc.Balance)
+
+	mockRepo.AssertExpectations(t)
+	mockRiskAssessmentsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestDepositAccountUseCase_Handle_ChargesFee(t *testing.T) {
+	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewDepositAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, []domain.FeeRule{{Operation: domain.FeeOperationDeposit, Flat: 100}}, nil, nil)
+	useCase := NewDepositAccountUseCase(mockRepo, nil, feeSchedule)
 
 	acc := domain.NewAccount("4", "01234567890", "John Doo")
 
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.DepositLedgerTransaction
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "31"
+	}).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.FeeLedgerTransaction && transaction.Validate() == nil && transaction.OriginTransactionId == "31" &&
+			transaction.Entries[0].AccountNumber == acc.Number && transaction.Entries[0].Amount == -100
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "32"
+	}).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FundsDeposited"
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FeeCharged" &&
+			message.Data == `{"number":"4","operation":"deposit","value":{"amount":100,"currency":"BRL"},"balance":{"amount":900,"currency":"BRL"},"transactionId":"32","originTransactionId":"31"}`
+	})).Return(nil)
+
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(true, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
 
 	// act
-	err := useCase.Handle(acc.Number, 150, idempotencyKey.String())
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 1000}, idempotencyKey.String(), "bob")
 
 	// assert
-	assert.Error(t, err)
-	assert.Equal(t, err, errors.New("idempotency key already processed"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(900), acc.Balance)
 
 	mockRepo.AssertExpectations(t)
-	mockBroker.AssertExpectations(t)
-	mockIdempotencyRepository.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
 }
 
-func TestDepositAccountUseCase_Handle_HasKeyErrorRetriving(t *testing.T) {
+func TestDepositAccountUseCase_Handle_FeeCappedAtDepositedValue(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, []domain.FeeRule{{Operation: domain.FeeOperationDeposit, Flat: 100}}, nil, nil)
+	useCase := NewDepositAccountUseCase(mockRepo, nil, feeSchedule)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.DepositLedgerTransaction
+	})).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.FeeLedgerTransaction && transaction.Entries[0].Amount == -60
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FundsDeposited"
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FeeCharged" && strings.Contains(message.Data, `"value":{"amount":60,"currency":"BRL"},"balance":{"amount":0,"currency":"BRL"}`)
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 60}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(0), acc.Balance)
+
+	mockRepo.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestDepositAccountUseCase_Handle_IdempotencyKeyReplayed(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	recorded, _ := domain.NewIdempotencyKey(acc.Number, "bob", idempotencyKey.String(), "deposit", depositRequest{Value: 150})
+	_ = recorded.SetResponse(http.StatusNoContent, nil)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return(recorded, nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, outcome)
+	assert.Equal(t, int64(0), acc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestDepositAccountUseCase_Handle_IdempotencyKeyReusedWithDifferentRequest(t *testing.T) {
 	// act
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewDepositAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
 
 	acc := domain.NewAccount("4", "01234567890", "John Doo")
 
 	idempotencyKey, _ := uuid.NewUUID()
 
+	recorded, _ := domain.NewIdempotencyKey(acc.Number, "bob", idempotencyKey.String(), "deposit", depositRequest{Value: 100})
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return(recorded, nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrIdempotencyKeyReused)
+	assert.Nil(t, outcome)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestDepositAccountUseCase_Handle_EmptyIdempotencyKey(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
+
+	// act
+	outcome, err := useCase.Handle("4", domain.Money{Amount: 150}, "", "bob")
+
+	// assert
+	assert.Error(t, err)
+	assert.Nil(t, outcome)
+
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
+
+func TestDepositAccountUseCase_Handle_GetKeyError(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
 	genericError := errors.New("generic error")
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, genericError)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), genericError)
 
 	// act
-	err := useCase.Handle(acc.Number, 150, idempotencyKey.String())
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
 
 	// assert
 	assert.Error(t, err)
 	assert.Equal(t, err, genericError)
 
 	mockRepo.AssertExpectations(t)
-	mockBroker.AssertExpectations(t)
 	mockIdempotencyRepository.AssertExpectations(t)
 }
 
-func TestDepositAccountUseCase_Handle_SuccessButErrorSavingIdempotencyKeyUsed(t *testing.T) {
+func TestDepositAccountUseCase_Handle_ErrorSavingIdempotencyKeyUsed(t *testing.T) {
 	// act
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewDepositAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
 
 	acc := domain.NewAccount("4", "01234567890", "John Doo")
 
-	mockRepo.On("GetAccountByNumber", acc.Number).Return(acc, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
 	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
 
 	errorSavingUsedIdempotencyKey := errors.New("error saving used idempotency key")
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(errorSavingUsedIdempotencyKey)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(errorSavingUsedIdempotencyKey)
 
 	// act
-	err := useCase.Handle(acc.Number, 150, idempotencyKey.String())
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
 
 	// assert
 	assert.Error(t, err)
@@ -179,3 +396,115 @@ func TestDepositAccountUseCase_Handle_SuccessButErrorSavingIdempotencyKeyUsed(t
 	mockRepo.AssertExpectations(t)
 	mockIdempotencyRepository.AssertExpectations(t)
 }
+
+func TestDepositAccountUseCase_Handle_ErrorCreatingLedgerTransaction(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	ledgerError := errors.New("ledger error")
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(ledgerError)
+
+	// act
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.Equal(t, ledgerError, err)
+
+	mockRepo.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestDepositAccountUseCase_Handle_AccountNotActive(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewDepositAccountUseCase(mockRepo, nil, nil)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Status = domain.AccountStatusBlocked
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.Equal(t, domain.ErrAccountNotActive, err)
+	assert.Equal(t, int64(0), acc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockLedgerRepository.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+}
+
+func TestDepositAccountUseCase_Handle_FlaggedForReview(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockRiskAssessmentsRepository := new(usecases_mock.MockRiskAssessmentsRepository)
+
+	riskRules := domain.RiskRules{
+		{Name: "large-amount", Type: domain.RiskRuleAmountThreshold, Decision: domain.RiskDecisionReview, MinValue: 100},
+		{Name: "transfers-only", Type: domain.RiskRuleAmountThreshold, Operations: []domain.RiskOperationType{domain.RiskOperationTransfer},
+			Decision: domain.RiskDecisionBlock, MinValue: 100},
+	}
+
+	useCase := NewDepositAccountUseCase(mockRepo, riskRules, nil)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository).
+		WithRiskAssessmentsRepository(mockRiskAssessmentsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockRiskAssessmentsRepository.On("CreateRiskAssessment", mock.MatchedBy(func(assessment *domain.RiskAssessment) bool {
+		return assessment.Decision == domain.RiskDecisionReview && assessment.Rule == "large-amount" && assessment.Operation == domain.RiskOperationDeposit
+	})).Return("7", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "SuspiciousActivityDetected"
+	})).Return(nil).Once()
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(150), acc.Balance)
+
+	mockRiskAssessmentsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
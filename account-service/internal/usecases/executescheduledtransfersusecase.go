@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type ExecuteScheduledTransfersUseCaseInterface interface {
+	Handle() (int, error)
+}
+
+type ExecuteScheduledTransfersUseCase struct {
+	scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface
+	transferAccountUseCase       TransferAccountUseCaseInterface
+	batchSize                    int
+	maxAttempts                  int
+	retryDelay                   time.Duration
+}
+
+func NewExecuteScheduledTransfersUseCase(
+	scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface,
+	transferAccountUseCase TransferAccountUseCaseInterface,
+	batchSize int,
+	maxAttempts int,
+	retryDelay time.Duration) *ExecuteScheduledTransfersUseCase {
+	return &ExecuteScheduledTransfersUseCase{
+		scheduledTransfersRepository: scheduledTransfersRepository,
+		transferAccountUseCase:       transferAccountUseCase,
+		batchSize:                    batchSize,
+		maxAttempts:                  maxAttempts,
+		retryDelay:                   retryDelay,
+	}
+}
+
+// Handle executes a batch of due scheduled transfers through the transfer use case and returns
+// how many succeeded. Each occurrence uses a key derived from the scheduled transfer, so running
+// it again after a crash replays the recorded outcome instead of transferring twice. Occurrences
+// above the approval threshold are recorded as pending approval, not as executed, requested by
+// who created the scheduled transfer so they cannot approve it themselves.
+func (us *ExecuteScheduledTransfersUseCase) Handle() (int, error) {
+	now := time.Now()
+
+	scheduledTransfers, err := us.scheduledTransfersRepository.GetDueScheduledTransfers(now, us.batchSize)
+	if err != nil {
+		slog.Error("error getting due scheduled transfers", "error", err)
+		return 0, err
+	}
+
+	executed := 0
+	var updateErr error
+
+	for _, scheduledTransfer := range scheduledTransfers {
+		outcome, err := us.transferAccountUseCase.ExecuteScheduledTransfer(scheduledTransfer, scheduledTransfer.CreatedBy)
+
+		switch {
+		case err != nil:
+			slog.Info("scheduled transfer failed", "error", err, "id", scheduledTransfer.Id, "occurrence", scheduledTransfer.Occurrences+1, "attempts", scheduledTransfer.Attempts+1)
+			scheduledTransfer.RecordFailure(err, now, us.maxAttempts, us.retryDelay)
+		case outcome.StatusCode == http.StatusAccepted:
+			transferRequestId := pendingTransferRequestId(outcome)
+			slog.Info("scheduled transfer pending approval", "id", scheduledTransfer.Id, "occurrence", scheduledTransfer.Occurrences+1, "transferRequestId", transferRequestId)
+			scheduledTransfer.RecordPendingApproval(transferRequestId, now)
+		default:
+			scheduledTransfer.RecordSuccess(now)
+			executed++
+		}
+
+		err = us.scheduledTransfersRepository.UpdateScheduledTransfer(scheduledTransfer)
+		if err != nil {
+			slog.Error("error updating scheduled transfer", "error", err, "id", scheduledTransfer.Id)
+			updateErr = err
+		}
+	}
+
+	if executed > 0 {
+		slog.Info("scheduled transfers executed", "count", executed)
+	}
+
+	return executed, updateErr
+}
+
+func pendingTransferRequestId(outcome *domain.IdempotencyKey) string {
+	var response transferPendingApprovalResponse
+	err := json.Unmarshal([]byte(outcome.Response), &response)
+	if err != nil {
+		slog.Error("error reading transfer pending approval response", "error", err)
+	}
+
+	return response.TransferRequestId
+}
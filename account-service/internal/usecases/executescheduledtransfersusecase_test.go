@@ -0,0 +1,176 @@
+package usecases
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newDueScheduledTransfer(id string) *domain.ScheduledTransfer {
+	scheduledFor := time.Now().Add(-time.Minute)
+
+	return &domain.ScheduledTransfer{
+		Id:           id,
+		FromNumber:   "1",
+		ToNumber:     "2",
+		Value:        100,
+		Frequency:    domain.ScheduledTransferWeekly,
+		ScheduledFor: scheduledFor,
+		NextRunAt:    scheduledFor,
+		Status:       domain.ScheduledTransferActive,
+		CreatedBy:    "bob",
+	}
+}
+
+func TestExecuteScheduledTransfersUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	mockTransferUseCase := new(usecases_mock.MockTransferAccountUseCase)
+
+	useCase := NewExecuteScheduledTransfersUseCase(mockScheduledTransfersRepository, mockTransferUseCase, 10, 3, time.Hour)
+
+	scheduledTransfer := newDueScheduledTransfer("5")
+	scheduledFor := scheduledTransfer.ScheduledFor
+
+	mockScheduledTransfersRepository.On("GetDueScheduledTransfers", mock.Anything, 10).Return([]*domain.ScheduledTransfer{scheduledTransfer}, nil)
+	mockTransferUseCase.On("ExecuteScheduledTransfer", scheduledTransfer, "bob").Return(&domain.IdempotencyKey{StatusCode: http.StatusNoContent}, nil)
+	mockScheduledTransfersRepository.On("UpdateScheduledTransfer", scheduledTransfer).Return(nil)
+
+	// act
+	executed, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, executed)
+	assert.Equal(t, 1, scheduledTransfer.Occurrences)
+	assert.Equal(t, scheduledFor.AddDate(0, 0, 7), scheduledTransfer.NextRunAt)
+	mockScheduledTransfersRepository.AssertExpectations(t)
+	mockTransferUseCase.AssertExpectations(t)
+}
+
+func TestExecuteScheduledTransfersUseCase_Handle_TransferFailed(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	mockTransferUseCase := new(usecases_mock.MockTransferAccountUseCase)
+
+	useCase := NewExecuteScheduledTransfersUseCase(mockScheduledTransfersRepository, mockTransferUseCase, 10, 3, time.Hour)
+
+	failing := newDueScheduledTransfer("5")
+	succeeding := newDueScheduledTransfer("6")
+	succeeding.FromNumber = "3"
+
+	mockScheduledTransfersRepository.On("GetDueScheduledTransfers", mock.Anything, 10).Return([]*domain.ScheduledTransfer{failing, succeeding}, nil)
+	mockTransferUseCase.On("ExecuteScheduledTransfer", failing, "bob").Return((*domain.IdempotencyKey)(nil), domain.ErrInsufficientFunds)
+	mockTransferUseCase.On("ExecuteScheduledTransfer", succeeding, "bob").Return(&domain.IdempotencyKey{StatusCode: http.StatusNoContent}, nil)
+	mockScheduledTransfersRepository.On("UpdateScheduledTransfer", mock.Anything).Return(nil)
+
+	// act
+	executed, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, executed)
+	assert.Equal(t, 1, failing.Attempts)
+	assert.Equal(t, 0, failing.Occurrences)
+	assert.Equal(t, domain.ErrInsufficientFunds.Error(), failing.LastError)
+	assert.Equal(t, 1, succeeding.Occurrences)
+	mockScheduledTransfersRepository.AssertNumberOfCalls(t, "UpdateScheduledTransfer", 2)
+}
+
+func TestExecuteScheduledTransfersUseCase_Handle_IdempotencyKeyReusedIsFailure(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	mockTransferUseCase := new(usecases_mock.MockTransferAccountUseCase)
+
+	useCase := NewExecuteScheduledTransfersUseCase(mockScheduledTransfersRepository, mockTransferUseCase, 10, 3, time.Hour)
+
+	scheduledTransfer := newDueScheduledTransfer("5")
+
+	mockScheduledTransfersRepository.On("GetDueScheduledTransfers", mock.Anything, 10).Return([]*domain.ScheduledTransfer{scheduledTransfer}, nil)
+	mockTransferUseCase.On("ExecuteScheduledTransfer", scheduledTransfer, "bob").Return((*domain.IdempotencyKey)(nil), domain.ErrIdempotencyKeyReused)
+	mockScheduledTransfersRepository.On("UpdateScheduledTransfer", scheduledTransfer).Return(nil)
+
+	// act
+	executed, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, executed)
+	assert.Equal(t, 0, scheduledTransfer.Occurrences)
+	assert.Equal(t, 1, scheduledTransfer.Attempts)
+	assert.Equal(t, domain.ErrIdempotencyKeyReused.Error(), scheduledTransfer.LastError)
+}
+
+func TestExecuteScheduledTransfersUseCase_Handle_PendingApproval(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	mockTransferUseCase := new(usecases_mock.MockTransferAccountUseCase)
+
+	useCase := NewExecuteScheduledTransfersUseCase(mockScheduledTransfersRepository, mockTransferUseCase, 10, 3, time.Hour)
+
+	scheduledTransfer := newDueScheduledTransfer("5")
+	scheduledFor := scheduledTransfer.ScheduledFor
+
+	mockScheduledTransfersRepository.On("GetDueScheduledTransfers", mock.Anything, 10).Return([]*domain.ScheduledTransfer{scheduledTransfer}, nil)
+	mockTransferUseCase.On("ExecuteScheduledTransfer", scheduledTransfer, "bob").Return(&domain.IdempotencyKey{
+		StatusCode: http.StatusAccepted,
+		Response:   `{"transferRequestId":"9","status":"pending"}`,
+	}, nil)
+	mockScheduledTransfersRepository.On("UpdateScheduledTransfer", scheduledTransfer).Return(nil)
+
+	// act
+	executed, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, executed)
+	assert.Equal(t, 1, scheduledTransfer.Occurrences)
+	assert.Equal(t, 1, scheduledTransfer.PendingOccurrences)
+	assert.Equal(t, "9", scheduledTransfer.LastTransferRequestId)
+	assert.Equal(t, scheduledFor.AddDate(0, 0, 7), scheduledTransfer.NextRunAt)
+}
+
+func TestExecuteScheduledTransfersUseCase_Handle_ErrorGettingDue(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	mockTransferUseCase := new(usecases_mock.MockTransferAccountUseCase)
+
+	useCase := NewExecuteScheduledTransfersUseCase(mockScheduledTransfersRepository, mockTransferUseCase, 10, 3, time.Hour)
+
+	mockScheduledTransfersRepository.On("GetDueScheduledTransfers", mock.Anything, 10).Return([]*domain.ScheduledTransfer(nil), errors.New("query error"))
+
+	// act
+	executed, err := useCase.Handle()
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, 0, executed)
+	mockTransferUseCase.AssertNotCalled(t, "ExecuteScheduledTransfer", mock.Anything, mock.Anything)
+}
+
+func TestExecuteScheduledTransfersUseCase_Handle_ErrorUpdating(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	mockTransferUseCase := new(usecases_mock.MockTransferAccountUseCase)
+
+	useCase := NewExecuteScheduledTransfersUseCase(mockScheduledTransfersRepository, mockTransferUseCase, 10, 3, time.Hour)
+
+	scheduledTransfer := newDueScheduledTransfer("5")
+
+	mockScheduledTransfersRepository.On("GetDueScheduledTransfers", mock.Anything, 10).Return([]*domain.ScheduledTransfer{scheduledTransfer}, nil)
+	mockTransferUseCase.On("ExecuteScheduledTransfer", scheduledTransfer, "bob").Return(&domain.IdempotencyKey{StatusCode: http.StatusNoContent}, nil)
+	mockScheduledTransfersRepository.On("UpdateScheduledTransfer", scheduledTransfer).Return(errors.New("update error"))
+
+	// act
+	_, err := useCase.Handle()
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "update error", err.Error())
+}
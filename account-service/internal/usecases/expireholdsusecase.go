@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type ExpireHoldsUseCaseInterface interface {
+	Handle() (int, error)
+}
+
+type ExpireHoldsUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+	holdsRepository   repositories.HoldsRepositoryInterface
+	batchSize         int
+}
+
+func NewExpireHoldsUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	holdsRepository repositories.HoldsRepositoryInterface,
+	batchSize int) *ExpireHoldsUseCase {
+	return &ExpireHoldsUseCase{
+		accountRepository: accountRepository,
+		holdsRepository:   holdsRepository,
+		batchSize:         batchSize,
+	}
+}
+
+// Handle releases a batch of holds past their expiry and returns how many were released. Each
+// hold is read again after its account is locked, so one captured or released meanwhile is skipped.
+func (us *ExpireHoldsUseCase) Handle() (int, error) {
+	now := time.Now()
+
+	expiredHolds, err := us.holdsRepository.GetExpiredHolds(now, us.batchSize)
+	if err != nil {
+		slog.Error("error getting expired holds", "error", err)
+		return 0, err
+	}
+
+	expired := 0
+	var expireErr error
+
+	for _, expiredHold := range expiredHolds {
+		released := false
+
+		err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+			accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(expiredHold.AccountNumber)
+			if err != nil {
+				return err
+			}
+
+			hold, err := uow.HoldsRepository().GetHold(expiredHold.AccountNumber, expiredHold.Id)
+			if err != nil {
+				return err
+			}
+
+			acc := accounts[expiredHold.AccountNumber]
+			if acc == nil || hold == nil || !hold.Expired(now) {
+				return nil
+			}
+
+			err = releaseHold(uow, acc, hold, domain.HoldStatusExpired)
+			if err != nil {
+				return err
+			}
+
+			released = true
+
+			return nil
+		})
+
+		if err != nil {
+			slog.Error("error expiring hold", "error", err, "holdId", expiredHold.Id)
+			expireErr = err
+			continue
+		}
+
+		if released {
+			expired++
+		}
+	}
+
+	if expired > 0 {
+		slog.Info("expired holds released", "count", expired)
+	}
+
+	return expired, expireErr
+}
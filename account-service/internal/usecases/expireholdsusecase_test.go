@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpireHoldsUseCase_Handle(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+
+	useCase := NewExpireHoldsUseCase(mockRepo, mockHoldsRepository, 10)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	expiredHold, _ := acc.PlaceHold(400, "", -time.Minute)
+	expiredHold.Id = "7"
+
+	// captured after being listed, so it must be skipped
+	capturedHold := &domain.Hold{Id: "8", AccountNumber: acc.Number, Amount: 100, Status: domain.HoldStatusActive, ExpiresAt: time.Now().Add(-time.Minute)}
+	currentCapturedHold := *capturedHold
+	currentCapturedHold.Status = domain.HoldStatusCaptured
+
+	mockHoldsRepository.On("GetExpiredHolds", mock.Anything, 10).Return([]*domain.Hold{expiredHold, capturedHold}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return(expiredHold, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "8").Return(&currentCapturedHold, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockHoldsRepository.On("UpdateHold", expiredHold).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "HoldReleased" && message.Data == `{"number":"19","holdId":"7","amount":400,"status":"expired"}`
+	})).Return(nil)
+
+	// act
+	expired, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, domain.HoldStatusExpired, expiredHold.Status)
+	assert.Equal(t, int64(0), acc.HeldBalance)
+
+	mockRepo.AssertNumberOfCalls(t, "UpdateAccountBalance", 1)
+	mockHoldsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type ExpireTransferRequestsUseCaseInterface interface {
+	Handle() (int, error)
+}
+
+type ExpireTransferRequestsUseCase struct {
+	accountRepository          repositories.AccountRepositoryInterface
+	transferRequestsRepository repositories.TransferRequestsRepositoryInterface
+	batchSize                  int
+}
+
+func NewExpireTransferRequestsUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	transferRequestsRepository repositories.TransferRequestsRepositoryInterface,
+	batchSize int) *ExpireTransferRequestsUseCase {
+	return &ExpireTransferRequestsUseCase{
+		accountRepository:          accountRepository,
+		transferRequestsRepository: transferRequestsRepository,
+		batchSize:                  batchSize,
+	}
+}
+
+// Handle expires a batch of transfer requests not reviewed in time and returns how many expired.
+// Each request is read again while locked, so one reviewed meanwhile is skipped.
+func (us *ExpireTransferRequestsUseCase) Handle() (int, error) {
+	now := time.Now()
+
+	expiredTransferRequests, err := us.transferRequestsRepository.GetExpiredTransferRequests(now, us.batchSize)
+	if err != nil {
+		slog.Error("error getting expired transfer requests", "error", err)
+		return 0, err
+	}
+
+	expired := 0
+	var expireErr error
+
+	for _, expiredTransferRequest := range expiredTransferRequests {
+		wasExpired := false
+
+		err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+			transferRequest, err := uow.TransferRequestsRepository().GetTransferRequestForUpdate(expiredTransferRequest.Id)
+			if err != nil {
+				return err
+			}
+
+			if transferRequest == nil || !transferRequest.Expired(now) {
+				return nil
+			}
+
+			err = transferRequest.Expire(now)
+			if err != nil {
+				return err
+			}
+
+			err = uow.TransferRequestsRepository().UpdateTransferRequest(transferRequest)
+			if err != nil {
+				return err
+			}
+
+			err = addEventToOutbox(uow.OutboxRepository(), events.NewTransferRequestExpired(transferRequest.Id, transferRequest.FromNumber,
+				transferRequest.ToNumber, transferRequest.Value))
+			if err != nil {
+				return err
+			}
+
+			wasExpired = true
+
+			return nil
+		})
+
+		if err != nil {
+			slog.Error("error expiring transfer request", "error", err, "id", expiredTransferRequest.Id)
+			expireErr = err
+			continue
+		}
+
+		if wasExpired {
+			expired++
+		}
+	}
+
+	if expired > 0 {
+		slog.Info("transfer requests expired", "count", expired)
+	}
+
+	return expired, expireErr
+}
@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpireTransferRequestsUseCase_Handle(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	useCase := NewExpireTransferRequestsUseCase(mockRepo, mockTransferRequestsRepository, 10)
+
+	expiredTransferRequest := domain.NewTransferRequest("123", "456", "", 100, "user-1", -time.Minute)
+	expiredTransferRequest.Id = "3"
+
+	// approved after being listed, so it must be skipped
+	approvedTransferRequest := domain.NewTransferRequest("123", "789", "", 100, "user-1", -time.Minute)
+	approvedTransferRequest.Id = "4"
+	currentApprovedTransferRequest := *approvedTransferRequest
+	currentApprovedTransferRequest.Status = domain.TransferRequestApproved
+
+	mockTransferRequestsRepository.On("GetExpiredTransferRequests", mock.Anything, 10).
+		Return([]*domain.TransferRequest{expiredTransferRequest, approvedTransferRequest}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).
+		WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockTransferRequestsRepository.On("GetTransferRequestForUpdate", "3").Return(expiredTransferRequest, nil)
+	mockTransferRequestsRepository.On("GetTransferRequestForUpdate", "4").Return(&currentApprovedTransferRequest, nil)
+	mockTransferRequestsRepository.On("UpdateTransferRequest", expiredTransferRequest).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferRequestExpired" &&
+			message.Data == `{"transferRequestId":"3","fromNumber":"123","toNumber":"456","value":100}`
+	})).Return(nil)
+
+	// act
+	expired, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, domain.TransferRequestExpired, expiredTransferRequest.Status)
+
+	mockTransferRequestsRepository.AssertNumberOfCalls(t, "UpdateTransferRequest", 1)
+	mockOutboxRepository.AssertExpectations(t)
+}
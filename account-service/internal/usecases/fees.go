@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+// recordFee records in the ledger a fee already charged from acc, linked to the transaction of
+// the operation charged when there is one, and publishes FeeCharged so it gets its own line in
+// the statement.
+func recordFee(uow repositories.UnitOfWorkInterface, acc *domain.Account, operation domain.FeeOperation, fee domain.Money, originTransactionId string) error {
+	transaction := domain.NewFeeLedgerTransaction(acc.Number, fee, originTransactionId)
+
+	err := uow.LedgerRepository().CreateTransaction(transaction)
+	if err != nil {
+		slog.Error("error creating fee ledger transaction", "error", err)
+		return err
+	}
+
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewFeeCharged(acc.Number, operation, fee, acc.Money(acc.Balance), transaction.Id, originTransactionId))
+	if err != nil {
+		slog.Error("error adding fee charged event to outbox", "error", err)
+		return err
+	}
+
+	slog.Info("fee charged", "number", acc.Number, "operation", operation, "value", fee, "originTransactionId", originTransactionId)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetAccountProfileChangesUseCaseInterface interface {
+	Handle(number string) ([]*domain.AccountProfileChange, error)
+}
+
+type GetAccountProfileChangesUseCase struct {
+	accountProfileChangesRepository repositories.AccountProfileChangesRepositoryInterface
+}
+
+func NewGetAccountProfileChangesUseCase(accountProfileChangesRepository repositories.AccountProfileChangesRepositoryInterface) *GetAccountProfileChangesUseCase {
+	return &GetAccountProfileChangesUseCase{
+		accountProfileChangesRepository: accountProfileChangesRepository,
+	}
+}
+
+func (us *GetAccountProfileChangesUseCase) Handle(number string) ([]*domain.AccountProfileChange, error) {
+	changes, err := us.accountProfileChangesRepository.GetAccountProfileChanges(number)
+	if err != nil {
+		slog.Error("error getting account profile changes", "error", err, "number", number)
+		return nil, err
+	}
+
+	return changes, nil
+}
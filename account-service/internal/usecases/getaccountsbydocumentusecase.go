@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetAccountsByDocumentUseCaseInterface interface {
+	Handle(document string) ([]*domain.Account, error)
+}
+
+type GetAccountsByDocumentUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+	holdersRepository repositories.AccountHoldersRepositoryInterface
+}
+
+func NewGetAccountsByDocumentUseCase(accountRepository repositories.AccountRepositoryInterface, holdersRepository repositories.AccountHoldersRepositoryInterface) *GetAccountsByDocumentUseCase {
+	return &GetAccountsByDocumentUseCase{
+		accountRepository: accountRepository,
+		holdersRepository: holdersRepository,
+	}
+}
+
+// Handle returns the accounts held by document, as the primary or a secondary holder, with
+// their holders.
+func (us *GetAccountsByDocumentUseCase) Handle(document string) ([]*domain.Account, error) {
+	accounts, err := us.accountRepository.GetAccountsByDocument(document)
+	if err != nil {
+		slog.Error("error getting accounts by document", "error", err)
+		return nil, err
+	}
+
+	for _, acc := range accounts {
+		acc.Holders, err = us.holdersRepository.GetAccountHolders(acc.Number)
+		if err != nil {
+			slog.Error("error getting account holders", "error", err, "number", acc.Number)
+			return nil, err
+		}
+	}
+
+	return accounts, nil
+}
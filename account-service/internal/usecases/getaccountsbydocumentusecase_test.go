@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountsByDocumentUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockAccountRepo := new(usecases_mock.MockAccountRepository)
+	mockHoldersRepo := new(usecases_mock.MockAccountHoldersRepository)
+	useCase := NewGetAccountsByDocumentUseCase(mockAccountRepo, mockHoldersRepo)
+
+	own := domain.NewAccount("19", "52998224725", "Jane Doe")
+	joint := domain.NewAccount("27", "01234567890", "John Doe")
+	jointHolders := []*domain.AccountHolder{
+		{AccountNumber: "27", Document: "01234567890", Name: "John Doe", Role: domain.AccountHolderRolePrimary},
+		{AccountNumber: "27", Document: "52998224725", Name: "Jane Doe", Role: domain.AccountHolderRoleSecondary},
+	}
+
+	mockAccountRepo.On("GetAccountsByDocument", "52998224725").Return([]*domain.Account{own, joint}, nil)
+	mockHoldersRepo.On("GetAccountHolders", "19").Return([]*domain.AccountHolder{}, nil)
+	mockHoldersRepo.On("GetAccountHolders", "27").Return(jointHolders, nil)
+
+	// act
+	accounts, err := useCase.Handle("52998224725")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 2)
+	assert.Len(t, accounts[0].AccountHolders(), 1)
+	assert.Equal(t, jointHolders, accounts[1].Holders)
+	mockAccountRepo.AssertExpectations(t)
+	mockHoldersRepo.AssertExpectations(t)
+}
+
+func TestGetAccountsByDocumentUseCase_Handle_ErrorGettingHolders(t *testing.T) {
+	// arrange
+	mockAccountRepo := new(usecases_mock.MockAccountRepository)
+	mockHoldersRepo := new(usecases_mock.MockAccountHoldersRepository)
+	useCase := NewGetAccountsByDocumentUseCase(mockAccountRepo, mockHoldersRepo)
+
+	mockAccountRepo.On("GetAccountsByDocument", "52998224725").Return([]*domain.Account{domain.NewAccount("19", "52998224725", "Jane Doe")}, nil)
+	mockHoldersRepo.On("GetAccountHolders", "19").Return([]*domain.AccountHolder(nil), errors.New("db error"))
+
+	// act
+	accounts, err := useCase.Handle("52998224725")
+
+	// assert
+	assert.EqualError(t, err, "db error")
+	assert.Nil(t, accounts)
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetAccountTransactionsUseCaseInterface interface {
+	Handle(filter domain.AccountTransactionsFilter) (*domain.AccountTransactionsPage, error)
+}
+
+type GetAccountTransactionsUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+	ledgerRepository  repositories.LedgerRepositoryInterface
+}
+
+func NewGetAccountTransactionsUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	ledgerRepository repositories.LedgerRepositoryInterface) *GetAccountTransactionsUseCase {
+	return &GetAccountTransactionsUseCase{
+		accountRepository: accountRepository,
+		ledgerRepository:  ledgerRepository,
+	}
+}
+
+// Handle returns a page of the account transactions read from the ledger, newest first.
+// NextCursor is zero on the last page.
+func (us *GetAccountTransactionsUseCase) Handle(filter domain.AccountTransactionsFilter) (*domain.AccountTransactionsPage, error) {
+	err := filter.Validate()
+	if err != nil {
+		slog.Info("invalid transactions filter", "error", err)
+		return nil, err
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = domain.DefaultAccountTransactionsLimit
+	}
+
+	acc, err := us.accountRepository.GetAccountByNumber(filter.AccountNumber)
+	if err != nil {
+		slog.Error("error get account by number", "error", err)
+		return nil, err
+	}
+
+	if acc == nil {
+		slog.Info("account not found", "number", filter.AccountNumber)
+		return nil, errors.New("account not found")
+	}
+
+	pageLimit := filter.Limit
+	filter.Limit = pageLimit + 1
+
+	transactions, err := us.ledgerRepository.GetAccountTransactions(filter)
+	if err != nil {
+		slog.Error("error getting account transactions", "error", err, "number", filter.AccountNumber)
+		return nil, err
+	}
+
+	page := &domain.AccountTransactionsPage{
+		Transactions: transactions,
+	}
+
+	if len(transactions) > pageLimit {
+		page.Transactions = transactions[:pageLimit]
+		page.NextCursor = page.Transactions[pageLimit-1].EntryId
+	}
+
+	return page, nil
+}
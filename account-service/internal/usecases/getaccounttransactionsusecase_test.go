@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAccountTransactionsUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockAccountRepo := new(usecases_mock.MockAccountRepository)
+	mockLedgerRepo := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewGetAccountTransactionsUseCase(mockAccountRepo, mockLedgerRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Dee")
+	transactions := []*domain.AccountTransaction{
+		{EntryId: 3, TransactionId: "2", Type: domain.TransferOutAccountTransaction, Counterparty: "2", Amount: 50, Balance: 50},
+	}
+
+	mockAccountRepo.On("GetAccountByNumber", acc.Number).Return(acc, nil)
+	mockLedgerRepo.On("GetAccountTransactions", domain.AccountTransactionsFilter{
+		AccountNumber: acc.Number,
+		Limit:         domain.DefaultAccountTransactionsLimit + 1,
+	}).Return(transactions, nil)
+
+	// act
+	page, err := useCase.Handle(domain.AccountTransactionsFilter{AccountNumber: acc.Number})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, transactions, page.Transactions)
+	assert.Equal(t, int64(0), page.NextCursor)
+
+	mockLedgerRepo.AssertExpectations(t)
+}
+
+func TestGetAccountTransactionsUseCase_Handle_HasNextPage(t *testing.T) {
+	// arrange
+	mockAccountRepo := new(usecases_mock.MockAccountRepository)
+	mockLedgerRepo := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewGetAccountTransactionsUseCase(mockAccountRepo, mockLedgerRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Dee")
+	transactions := []*domain.AccountTransaction{
+		{EntryId: 9, TransactionId: "5"},
+		{EntryId: 7, TransactionId: "4"},
+		{EntryId: 5, TransactionId: "3"},
+	}
+
+	mockAccountRepo.On("GetAccountByNumber", acc.Number).Return(acc, nil)
+	mockLedgerRepo.On("GetAccountTransactions", mock.MatchedBy(func(filter domain.AccountTransactionsFilter) bool {
+		return filter.Limit == 3 && filter.Cursor == 10
+	})).Return(transactions, nil)
+
+	// act
+	page, err := useCase.Handle(domain.AccountTransactionsFilter{AccountNumber: acc.Number, Limit: 2, Cursor: 10})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, page.Transactions, 2)
+	assert.Equal(t, int64(7), page.NextCursor)
+}
+
+func TestGetAccountTransactionsUseCase_Handle_InvalidFilter(t *testing.T) {
+	// arrange
+	mockAccountRepo := new(usecases_mock.MockAccountRepository)
+	mockLedgerRepo := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewGetAccountTransactionsUseCase(mockAccountRepo, mockLedgerRepo)
+
+	// act
+	page, err := useCase.Handle(domain.AccountTransactionsFilter{AccountNumber: "1", Types: []string{"refund"}})
+
+	// assert
+	assert.Error(t, err)
+	assert.Nil(t, page)
+	mockAccountRepo.AssertNotCalled(t, "GetAccountByNumber", mock.Anything)
+}
+
+func TestGetAccountTransactionsUseCase_Handle_AccountNotFound(t *testing.T) {
+	// arrange
+	mockAccountRepo := new(usecases_mock.MockAccountRepository)
+	mockLedgerRepo := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewGetAccountTransactionsUseCase(mockAccountRepo, mockLedgerRepo)
+
+	mockAccountRepo.On("GetAccountByNumber", "1").Return((*domain.Account)(nil), nil)
+
+	// act
+	page, err := useCase.Handle(domain.AccountTransactionsFilter{AccountNumber: "1"})
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "account not found", err.Error())
+	assert.Nil(t, page)
+	mockLedgerRepo.AssertNotCalled(t, "GetAccountTransactions", mock.Anything)
+}
+
+func TestGetAccountTransactionsUseCase_Handle_ErrorGettingTransactions(t *testing.T) {
+	// arrange
+	mockAccountRepo := new(usecases_mock.MockAccountRepository)
+	mockLedgerRepo := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewGetAccountTransactionsUseCase(mockAccountRepo, mockLedgerRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Dee")
+
+	mockAccountRepo.On("GetAccountByNumber", acc.Number).Return(acc, nil)
+	mockLedgerRepo.On("GetAccountTransactions", mock.Anything).Return([]*domain.AccountTransaction(nil), errors.New("db error"))
+
+	// act
+	page, err := useCase.Handle(domain.AccountTransactionsFilter{AccountNumber: acc.Number})
+
+	// assert
+	assert.Error(t, err)
+	assert.Nil(t, page)
+}
@@ -8,26 +8,50 @@ import (
 )
 
 type GetAccountUseCaseInterface interface {
-	Handle(number string) (*domain.Account, error)
+	Handle(number string) (*domain.Account, []*domain.Pocket, error)
 }
 
 type GetAccountUseCase struct {
 	accountRepository repositories.AccountRepositoryInterface
+	pocketsRepository repositories.PocketsRepositoryInterface
+	holdersRepository repositories.AccountHoldersRepositoryInterface
 }
 
-func NewGetAccountUseCase(accountRepository repositories.AccountRepositoryInterface) *GetAccountUseCase {
+func NewGetAccountUseCase(accountRepository repositories.AccountRepositoryInterface,
+	pocketsRepository repositories.PocketsRepositoryInterface,
+	holdersRepository repositories.AccountHoldersRepositoryInterface) *GetAccountUseCase {
 	return &GetAccountUseCase{
 		accountRepository: accountRepository,
+		pocketsRepository: pocketsRepository,
+		holdersRepository: holdersRepository,
 	}
 }
 
-func (us *GetAccountUseCase) Handle(number string) (*domain.Account, error) {
+// Handle returns the account with its holders and active pockets, nil when the account does not exist.
+func (us *GetAccountUseCase) Handle(number string) (*domain.Account, []*domain.Pocket, error) {
 	acc, err := us.accountRepository.GetAccountByNumber(number)
 	if err != nil {
 		slog.Info("error get account by number", "error", err)
-		return nil, err
+		return nil, nil, err
+	}
+
+	if acc == nil {
+		slog.Info("searched account by number", "number", number, "acc", acc)
+		return nil, nil, nil
+	}
+
+	acc.Holders, err = us.holdersRepository.GetAccountHolders(number)
+	if err != nil {
+		slog.Error("error getting account holders", "error", err, "number", number)
+		return nil, nil, err
+	}
+
+	pockets, err := us.pocketsRepository.GetPocketsByAccount(number)
+	if err != nil {
+		slog.Error("error getting pockets by account", "error", err, "number", number)
+		return nil, nil, err
 	}
 
 	slog.Info("searched account by number", "number", number, "acc", acc)
-	return acc, nil
+	return acc, pockets, nil
 }
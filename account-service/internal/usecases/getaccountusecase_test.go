@@ -20,14 +20,23 @@ func TestGetAccountUseCase_Handle_Success(t *testing.T) {
 	mockAccountRepo := new(usecases_mock.MockAccountRepository)
 	mockAccountRepo.On("GetAccountByNumber", acc.Number).Return(acc, nil)
 
-	useCase := NewGetAccountUseCase(mockAccountRepo)
+	pocket := &domain.Pocket{Id: "4", AccountNumber: acc.Number, Name: "Vacation", Balance: 500, Status: domain.PocketStatusActive}
+	mockPocketsRepo := new(usecases_mock.MockPocketsRepository)
+	mockHoldersRepo := new(usecases_mock.MockAccountHoldersRepository)
+	holder := &domain.AccountHolder{AccountNumber: acc.Number, Document: "52998224725", Name: "Jane Dee", Role: domain.AccountHolderRoleSecondary}
+	mockHoldersRepo.On("GetAccountHolders", acc.Number).Return([]*domain.AccountHolder{holder}, nil)
+	mockPocketsRepo.On("GetPocketsByAccount", acc.Number).Return([]*domain.Pocket{pocket}, nil)
+
+	useCase := NewGetAccountUseCase(mockAccountRepo, mockPocketsRepo, mockHoldersRepo)
 
 	// act
-	result, err := useCase.Handle(acc.Number)
+	result, pockets, err := useCase.Handle(acc.Number)
 
 	// assert
 	assert.NotNil(t, result)
 	assert.Nil(t, err)
+	assert.Equal(t, []*domain.Pocket{pocket}, pockets)
+	assert.Equal(t, []*domain.AccountHolder{holder}, result.Holders)
 
 	assert.Equal(t, result.Id, acc.Id)
 	assert.Equal(t, result.Number, acc.Number)
@@ -45,10 +54,13 @@ func TestGetAccountUseCase_Handle_NotFound(t *testing.T) {
 	mockAccountRepo := new(usecases_mock.MockAccountRepository)
 	mockAccountRepo.On("GetAccountByNumber", acc.Number).Return((*domain.Account)(nil), nil)
 
-	useCase := NewGetAccountUseCase(mockAccountRepo)
+	mockPocketsRepo := new(usecases_mock.MockPocketsRepository)
+	mockHoldersRepo := new(usecases_mock.MockAccountHoldersRepository)
+
+	useCase := NewGetAccountUseCase(mockAccountRepo, mockPocketsRepo, mockHoldersRepo)
 
 	// act
-	result, err := useCase.Handle(acc.Number)
+	result, _, err := useCase.Handle(acc.Number)
 
 	// assert
 	assert.Nil(t, result)
@@ -68,13 +80,45 @@ func TestGetAccountUseCase_Handle_Error(t *testing.T) {
 	mockAccountRepo := new(usecases_mock.MockAccountRepository)
 	mockAccountRepo.On("GetAccountByNumber", acc.Number).Return((*domain.Account)(nil), expectedError)
 
-	useCase := NewGetAccountUseCase(mockAccountRepo)
+	mockPocketsRepo := new(usecases_mock.MockPocketsRepository)
+	mockHoldersRepo := new(usecases_mock.MockAccountHoldersRepository)
+
+	useCase := NewGetAccountUseCase(mockAccountRepo, mockPocketsRepo, mockHoldersRepo)
 
 	// act
-	result, err := useCase.Handle(acc.Number)
+	result, _, err := useCase.Handle(acc.Number)
 
 	// assert
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
 	assert.Equal(t, expectedError, err)
 }
+
+func TestGetAccountUseCase_Handle_ErrorGettingPockets(t *testing.T) {
+	// arrange
+	acc := domain.NewAccount(
+		"1",
+		"01234567890",
+		"John Dee",
+	)
+
+	expectedError := errors.New("error getting pockets")
+
+	mockAccountRepo := new(usecases_mock.MockAccountRepository)
+	mockAccountRepo.On("GetAccountByNumber", acc.Number).Return(acc, nil)
+
+	mockPocketsRepo := new(usecases_mock.MockPocketsRepository)
+	mockHoldersRepo := new(usecases_mock.MockAccountHoldersRepository)
+	mockHoldersRepo.On("GetAccountHolders", acc.Number).Return([]*domain.AccountHolder{}, nil)
+	mockPocketsRepo.On("GetPocketsByAccount", acc.Number).Return([]*domain.Pocket(nil), expectedError)
+
+	useCase := NewGetAccountUseCase(mockAccountRepo, mockPocketsRepo, mockHoldersRepo)
+
+	// act
+	result, pockets, err := useCase.Handle(acc.Number)
+
+	// assert
+	assert.Nil(t, result)
+	assert.Nil(t, pockets)
+	assert.Equal(t, expectedError, err)
+}
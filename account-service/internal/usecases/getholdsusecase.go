@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetHoldsUseCaseInterface interface {
+	Handle(number string) ([]*domain.Hold, error)
+}
+
+type GetHoldsUseCase struct {
+	holdsRepository repositories.HoldsRepositoryInterface
+}
+
+func NewGetHoldsUseCase(holdsRepository repositories.HoldsRepositoryInterface) *GetHoldsUseCase {
+	return &GetHoldsUseCase{
+		holdsRepository: holdsRepository,
+	}
+}
+
+func (us *GetHoldsUseCase) Handle(number string) ([]*domain.Hold, error) {
+	holds, err := us.holdsRepository.GetHoldsByAccount(number)
+	if err != nil {
+		slog.Error("error getting holds", "error", err, "number", number)
+		return nil, err
+	}
+
+	return holds, nil
+}
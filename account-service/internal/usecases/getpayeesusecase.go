@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetPayeesUseCaseInterface interface {
+	Handle(number string) ([]*domain.Payee, error)
+}
+
+type GetPayeesUseCase struct {
+	payeesRepository repositories.PayeesRepositoryInterface
+}
+
+func NewGetPayeesUseCase(payeesRepository repositories.PayeesRepositoryInterface) *GetPayeesUseCase {
+	return &GetPayeesUseCase{
+		payeesRepository: payeesRepository,
+	}
+}
+
+func (us *GetPayeesUseCase) Handle(number string) ([]*domain.Payee, error) {
+	payees, err := us.payeesRepository.GetPayeesByAccount(number)
+	if err != nil {
+		slog.Error("error getting payees by account", "error", err, "number", number)
+		return nil, err
+	}
+
+	return payees, nil
+}
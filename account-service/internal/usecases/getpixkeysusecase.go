@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetPixKeysUseCaseInterface interface {
+	Handle(number string) ([]*domain.PixKey, error)
+}
+
+type GetPixKeysUseCase struct {
+	pixKeysRepository repositories.PixKeysRepositoryInterface
+}
+
+func NewGetPixKeysUseCase(pixKeysRepository repositories.PixKeysRepositoryInterface) *GetPixKeysUseCase {
+	return &GetPixKeysUseCase{
+		pixKeysRepository: pixKeysRepository,
+	}
+}
+
+func (us *GetPixKeysUseCase) Handle(number string) ([]*domain.PixKey, error) {
+	pixKeys, err := us.pixKeysRepository.GetPixKeysByAccount(number)
+	if err != nil {
+		slog.Error("error getting pix keys by account", "error", err, "number", number)
+		return nil, err
+	}
+
+	return pixKeys, nil
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetPocketsUseCaseInterface interface {
+	Handle(number string) ([]*domain.Pocket, error)
+}
+
+type GetPocketsUseCase struct {
+	pocketsRepository repositories.PocketsRepositoryInterface
+}
+
+func NewGetPocketsUseCase(pocketsRepository repositories.PocketsRepositoryInterface) *GetPocketsUseCase {
+	return &GetPocketsUseCase{
+		pocketsRepository: pocketsRepository,
+	}
+}
+
+func (us *GetPocketsUseCase) Handle(number string) ([]*domain.Pocket, error) {
+	pockets, err := us.pocketsRepository.GetPocketsByAccount(number)
+	if err != nil {
+		slog.Error("error getting pockets by account", "error", err, "number", number)
+		return nil, err
+	}
+
+	return pockets, nil
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetScheduledTransfersUseCaseInterface interface {
+	Handle(number string) ([]*domain.ScheduledTransfer, error)
+}
+
+type GetScheduledTransfersUseCase struct {
+	scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface
+}
+
+func NewGetScheduledTransfersUseCase(scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface) *GetScheduledTransfersUseCase {
+	return &GetScheduledTransfersUseCase{
+		scheduledTransfersRepository: scheduledTransfersRepository,
+	}
+}
+
+func (us *GetScheduledTransfersUseCase) Handle(number string) ([]*domain.ScheduledTransfer, error) {
+	scheduledTransfers, err := us.scheduledTransfersRepository.GetScheduledTransfersByAccount(number)
+	if err != nil {
+		slog.Error("error getting scheduled transfers", "error", err, "number", number)
+		return nil, err
+	}
+
+	return scheduledTransfers, nil
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetScheduledTransferUseCaseInterface interface {
+	Handle(number string, id string) (*domain.ScheduledTransfer, error)
+}
+
+type GetScheduledTransferUseCase struct {
+	scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface
+}
+
+func NewGetScheduledTransferUseCase(scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface) *GetScheduledTransferUseCase {
+	return &GetScheduledTransferUseCase{
+		scheduledTransfersRepository: scheduledTransfersRepository,
+	}
+}
+
+func (us *GetScheduledTransferUseCase) Handle(number string, id string) (*domain.ScheduledTransfer, error) {
+	scheduledTransfer, err := us.scheduledTransfersRepository.GetScheduledTransfer(number, id)
+	if err != nil {
+		slog.Error("error getting scheduled transfer", "error", err, "number", number, "id", id)
+		return nil, err
+	}
+
+	return scheduledTransfer, nil
+}
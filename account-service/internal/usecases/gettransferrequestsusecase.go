@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type GetTransferRequestsUseCaseInterface interface {
+	Handle(number string) ([]*domain.TransferRequest, error)
+}
+
+type GetTransferRequestsUseCase struct {
+	transferRequestsRepository repositories.TransferRequestsRepositoryInterface
+}
+
+func NewGetTransferRequestsUseCase(transferRequestsRepository repositories.TransferRequestsRepositoryInterface) *GetTransferRequestsUseCase {
+	return &GetTransferRequestsUseCase{
+		transferRequestsRepository: transferRequestsRepository,
+	}
+}
+
+func (us *GetTransferRequestsUseCase) Handle(number string) ([]*domain.TransferRequest, error) {
+	transferRequests, err := us.transferRequestsRepository.GetTransferRequestsByAccount(number)
+	if err != nil {
+		slog.Error("error getting transfer requests", "error", err, "number", number)
+		return nil, err
+	}
+
+	return transferRequests, nil
+}
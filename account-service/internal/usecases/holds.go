@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+// releaseHold returns the amount of hold to the available balance of acc, which must be
+// locked, recording status as the reason it was released.
+func releaseHold(uow repositories.UnitOfWorkInterface, acc *domain.Account, hold *domain.Hold, status domain.HoldStatus) error {
+	err := acc.ReleaseHold(hold, status)
+	if err != nil {
+		slog.Info("hold release not allowed", "error", err, "number", acc.Number, "holdId", hold.Id)
+		return err
+	}
+
+	err = uow.AccountRepository().UpdateAccountBalance(acc)
+	if err != nil {
+		slog.Error("error updating account balance", "error", err)
+		return err
+	}
+
+	err = uow.HoldsRepository().UpdateHold(hold)
+	if err != nil {
+		slog.Error("error updating hold", "error", err, "holdId", hold.Id)
+		return err
+	}
+
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewHoldReleased(acc.Number, hold.Id, hold.Amount, string(hold.Status)))
+	if err != nil {
+		slog.Error("error adding hold released event to outbox", "error", err)
+		return err
+	}
+
+	return nil
+}
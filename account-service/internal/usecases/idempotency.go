@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+// getRecordedOutcome returns the outcome stored for a previous request with the same key,
+// or nil when the key was not used yet. A key reused for a different request is rejected.
+// It must be called after the scope account is locked so concurrent retries are serialized.
+func getRecordedOutcome(idempotencyKeysRepository repositories.IdempotencyKeysRepositoryInterface, idempotencyKey *domain.IdempotencyKey) (*domain.IdempotencyKey, error) {
+	recorded, err := idempotencyKeysRepository.GetKey(idempotencyKey.Scope, idempotencyKey.Subject, idempotencyKey.Key)
+	if err != nil {
+		slog.Error("Error getting idempotencyKey", "error", err)
+		return nil, err
+	}
+
+	if recorded == nil {
+		return nil, nil
+	}
+
+	if !recorded.Matches(idempotencyKey) {
+		slog.Info("idempotency key reused with a different request", "idempotencyKey", idempotencyKey.Key, "scope", idempotencyKey.Scope)
+		return nil, domain.ErrIdempotencyKeyReused
+	}
+
+	slog.Info("idempotency key already processed, replaying response", "idempotencyKey", idempotencyKey.Key, "scope", idempotencyKey.Scope)
+
+	return recorded, nil
+}
@@ -0,0 +1,25 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAccountHoldersRepository struct {
+	mock.Mock
+}
+
+func (m *MockAccountHoldersRepository) CreateAccountHolder(holder *domain.AccountHolder) (string, error) {
+	args := m.Called(holder)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAccountHoldersRepository) GetAccountHolders(accountNumber string) ([]*domain.AccountHolder, error) {
+	args := m.Called(accountNumber)
+	return args.Get(0).([]*domain.AccountHolder), args.Error(1)
+}
+
+func (m *MockAccountHoldersRepository) UpdatePrimaryHolderName(accountNumber string, name string) error {
+	args := m.Called(accountNumber, name)
+	return args.Error(0)
+}
@@ -0,0 +1,20 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAccountProfileChangesRepository struct {
+	mock.Mock
+}
+
+func (m *MockAccountProfileChangesRepository) CreateAccountProfileChange(change *domain.AccountProfileChange) (string, error) {
+	args := m.Called(change)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAccountProfileChangesRepository) GetAccountProfileChanges(accountNumber string) ([]*domain.AccountProfileChange, error) {
+	args := m.Called(accountNumber)
+	return args.Get(0).([]*domain.AccountProfileChange), args.Error(1)
+}
@@ -1,7 +1,10 @@
 package usecases_mock
 
 import (
+	"time"
+
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -14,14 +17,14 @@ func (m *MockAccountRepository) GetAccountByNumber(number string) (*domain.Accou
 	return args.Get(0).(*domain.Account), args.Error(1)
 }
 
-func (m *MockAccountRepository) GetAccountByDocument(document string) (*domain.Account, error) {
+func (m *MockAccountRepository) GetAccountsByDocument(document string) ([]*domain.Account, error) {
 	args := m.Called(document)
-	return args.Get(0).(*domain.Account), args.Error(1)
+	return args.Get(0).([]*domain.Account), args.Error(1)
 }
 
-func (m *MockAccountRepository) GetNextAccountNumber() (string, error) {
+func (m *MockAccountRepository) GetNextAccountSequence() (int64, error) {
 	args := m.Called()
-	return args.String(0), args.Error(1)
+	return args.Get(0).(int64), args.Error(1)
 }
 
 func (m *MockAccountRepository) CreateAccount(account *domain.Account) (string, error) {
@@ -33,3 +36,57 @@ func (m *MockAccountRepository) UpdateAccountBalance(account *domain.Account) er
 	args := m.Called(account)
 	return args.Error(0)
 }
+
+func (m *MockAccountRepository) UpdateAccountStatus(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) UpdateAccountOverdraftLimit(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) GetAccountsByNumbersForUpdate(numbers ...string) (map[string]*domain.Account, error) {
+	args := m.Called(numbers)
+	return args.Get(0).(map[string]*domain.Account), args.Error(1)
+}
+
+func (m *MockAccountRepository) UpdateAccountTransferLimits(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) UpdateAccountInterest(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) GetAccountsToAccrueInterest(day time.Time, limit int) ([]*domain.Account, error) {
+	args := m.Called(day, limit)
+	return args.Get(0).([]*domain.Account), args.Error(1)
+}
+
+func (m *MockAccountRepository) UpdateAccountMaintenanceFee(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) UpdateAccountProfile(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) GetAccountsDueMaintenanceFee(monthStart time.Time, limit int) ([]*domain.Account, error) {
+	args := m.Called(monthStart, limit)
+	return args.Get(0).([]*domain.Account), args.Error(1)
+}
+
+func (m *MockAccountRepository) WithTransaction(fn func(uow repositories.UnitOfWorkInterface) error) error {
+	args := m.Called(fn)
+	if args.Error(1) != nil {
+		return args.Error(1)
+	}
+
+	return fn(args.Get(0).(repositories.UnitOfWorkInterface))
+}
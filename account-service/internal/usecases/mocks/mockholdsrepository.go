@@ -0,0 +1,37 @@
+package usecases_mock
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockHoldsRepository struct {
+	mock.Mock
+}
+
+func (m *MockHoldsRepository) CreateHold(hold *domain.Hold) (string, error) {
+	args := m.Called(hold)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockHoldsRepository) GetHold(accountNumber string, id string) (*domain.Hold, error) {
+	args := m.Called(accountNumber, id)
+	return args.Get(0).(*domain.Hold), args.Error(1)
+}
+
+func (m *MockHoldsRepository) GetHoldsByAccount(accountNumber string) ([]*domain.Hold, error) {
+	args := m.Called(accountNumber)
+	return args.Get(0).([]*domain.Hold), args.Error(1)
+}
+
+func (m *MockHoldsRepository) GetExpiredHolds(now time.Time, limit int) ([]*domain.Hold, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]*domain.Hold), args.Error(1)
+}
+
+func (m *MockHoldsRepository) UpdateHold(hold *domain.Hold) error {
+	args := m.Called(hold)
+	return args.Error(0)
+}
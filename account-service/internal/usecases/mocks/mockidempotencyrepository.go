@@ -1,6 +1,9 @@
 package usecases_mock
 
 import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -8,12 +11,17 @@ type MockIdempotencyRepository struct {
 	mock.Mock
 }
 
-func (m *MockIdempotencyRepository) HasKey(key string) (bool, error) {
-	args := m.Called(key)
-	return args.Bool(0), args.Error(1)
+func (m *MockIdempotencyRepository) GetKey(scope string, subject string, key string) (*domain.IdempotencyKey, error) {
+	args := m.Called(scope, subject, key)
+	return args.Get(0).(*domain.IdempotencyKey), args.Error(1)
 }
 
-func (m *MockIdempotencyRepository) CreateKey(key string) error {
-	args := m.Called(key)
+func (m *MockIdempotencyRepository) CreateKey(idempotencyKey *domain.IdempotencyKey) error {
+	args := m.Called(idempotencyKey)
 	return args.Error(0)
 }
+
+func (m *MockIdempotencyRepository) DeleteKeysCreatedBefore(createdBefore time.Time) (int64, error) {
+	args := m.Called(createdBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
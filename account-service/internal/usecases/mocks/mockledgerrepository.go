@@ -0,0 +1,67 @@
+package usecases_mock
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLedgerRepository struct {
+	mock.Mock
+}
+
+func (m *MockLedgerRepository) CreateTransaction(transaction *domain.LedgerTransaction) error {
+	args := m.Called(transaction)
+	return args.Error(0)
+}
+
+func (m *MockLedgerRepository) GetTransactionForUpdate(id string) (*domain.LedgerTransaction, error) {
+	args := m.Called(id)
+	return args.Get(0).(*domain.LedgerTransaction), args.Error(1)
+}
+
+func (m *MockLedgerRepository) GetReversedValue(transactionId string) (int64, error) {
+	args := m.Called(transactionId)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockLedgerRepository) GetOutgoingTransfersTotal(accountNumber string, since time.Time) (int64, error) {
+	args := m.Called(accountNumber, since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockLedgerRepository) GetEntriesTotal() (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockLedgerRepository) GetUnbalancedTransactions() ([]string, error) {
+	args := m.Called()
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockLedgerRepository) GetBalanceMismatches() ([]domain.LedgerBalanceMismatch, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.LedgerBalanceMismatch), args.Error(1)
+}
+
+func (m *MockLedgerRepository) GetAccountTransactions(filter domain.AccountTransactionsFilter) ([]*domain.AccountTransaction, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]*domain.AccountTransaction), args.Error(1)
+}
+
+func (m *MockLedgerRepository) CountOutgoingTransfers(accountNumber string, since time.Time) (int, error) {
+	args := m.Called(accountNumber, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockLedgerRepository) CountDeposits(accountNumber string, since time.Time) (int, error) {
+	args := m.Called(accountNumber, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockLedgerRepository) HasTransferredTo(fromNumber string, toNumber string) (bool, error) {
+	args := m.Called(fromNumber, toNumber)
+	return args.Bool(0), args.Error(1)
+}
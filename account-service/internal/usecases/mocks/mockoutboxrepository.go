@@ -0,0 +1,40 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) CreateMessage(message *domain.OutboxMessage) error {
+	args := m.Called(message)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) TryLockRelay() (bool, error) {
+	args := m.Called()
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOutboxRepository) GetPendingMessagesForUpdate(limit int) ([]*domain.OutboxMessage, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]*domain.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxRepository) UpdateMessage(message *domain.OutboxMessage) error {
+	args := m.Called(message)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) WithTransaction(fn func(uow repositories.UnitOfWorkInterface) error) error {
+	args := m.Called(fn)
+	if args.Error(1) != nil {
+		return args.Error(1)
+	}
+
+	return fn(args.Get(0).(repositories.UnitOfWorkInterface))
+}
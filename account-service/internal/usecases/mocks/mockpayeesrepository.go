@@ -0,0 +1,35 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPayeesRepository struct {
+	mock.Mock
+}
+
+func (m *MockPayeesRepository) CreatePayee(payee *domain.Payee) (string, error) {
+	args := m.Called(payee)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPayeesRepository) GetPayee(accountNumber string, id string) (*domain.Payee, error) {
+	args := m.Called(accountNumber, id)
+	return args.Get(0).(*domain.Payee), args.Error(1)
+}
+
+func (m *MockPayeesRepository) GetPayeesByAccount(accountNumber string) ([]*domain.Payee, error) {
+	args := m.Called(accountNumber)
+	return args.Get(0).([]*domain.Payee), args.Error(1)
+}
+
+func (m *MockPayeesRepository) UpdatePayee(payee *domain.Payee) error {
+	args := m.Called(payee)
+	return args.Error(0)
+}
+
+func (m *MockPayeesRepository) DeletePayee(accountNumber string, id string) (bool, error) {
+	args := m.Called(accountNumber, id)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,30 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPixKeysRepository struct {
+	mock.Mock
+}
+
+func (m *MockPixKeysRepository) CreatePixKey(pixKey *domain.PixKey) (string, error) {
+	args := m.Called(pixKey)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPixKeysRepository) GetPixKey(key string) (*domain.PixKey, error) {
+	args := m.Called(key)
+	return args.Get(0).(*domain.PixKey), args.Error(1)
+}
+
+func (m *MockPixKeysRepository) GetPixKeysByAccount(accountNumber string) ([]*domain.PixKey, error) {
+	args := m.Called(accountNumber)
+	return args.Get(0).([]*domain.PixKey), args.Error(1)
+}
+
+func (m *MockPixKeysRepository) DeletePixKey(accountNumber string, key string) (bool, error) {
+	args := m.Called(accountNumber, key)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,30 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPocketsRepository struct {
+	mock.Mock
+}
+
+func (m *MockPocketsRepository) CreatePocket(pocket *domain.Pocket) (string, error) {
+	args := m.Called(pocket)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPocketsRepository) GetPocket(accountNumber string, id string) (*domain.Pocket, error) {
+	args := m.Called(accountNumber, id)
+	return args.Get(0).(*domain.Pocket), args.Error(1)
+}
+
+func (m *MockPocketsRepository) GetPocketsByAccount(accountNumber string) ([]*domain.Pocket, error) {
+	args := m.Called(accountNumber)
+	return args.Get(0).([]*domain.Pocket), args.Error(1)
+}
+
+func (m *MockPocketsRepository) UpdatePocket(pocket *domain.Pocket) error {
+	args := m.Called(pocket)
+	return args.Error(0)
+}
@@ -0,0 +1,15 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRiskAssessmentsRepository struct {
+	mock.Mock
+}
+
+func (m *MockRiskAssessmentsRepository) CreateRiskAssessment(assessment *domain.RiskAssessment) (string, error) {
+	args := m.Called(assessment)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,37 @@
+package usecases_mock
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockScheduledTransfersRepository struct {
+	mock.Mock
+}
+
+func (m *MockScheduledTransfersRepository) CreateScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer) (string, error) {
+	args := m.Called(scheduledTransfer)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockScheduledTransfersRepository) GetScheduledTransfer(fromNumber string, id string) (*domain.ScheduledTransfer, error) {
+	args := m.Called(fromNumber, id)
+	return args.Get(0).(*domain.ScheduledTransfer), args.Error(1)
+}
+
+func (m *MockScheduledTransfersRepository) GetScheduledTransfersByAccount(fromNumber string) ([]*domain.ScheduledTransfer, error) {
+	args := m.Called(fromNumber)
+	return args.Get(0).([]*domain.ScheduledTransfer), args.Error(1)
+}
+
+func (m *MockScheduledTransfersRepository) GetDueScheduledTransfers(now time.Time, limit int) ([]*domain.ScheduledTransfer, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]*domain.ScheduledTransfer), args.Error(1)
+}
+
+func (m *MockScheduledTransfersRepository) UpdateScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer) error {
+	args := m.Called(scheduledTransfer)
+	return args.Error(0)
+}
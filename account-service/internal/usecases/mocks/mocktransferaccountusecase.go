@@ -0,0 +1,30 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransferAccountUseCase struct {
+	mock.Mock
+}
+
+func (m *MockTransferAccountUseCase) Handle(fromNumber string, toNumber string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	args := m.Called(fromNumber, toNumber, value, idempotencyKey, requestedBy)
+	return args.Get(0).(*domain.IdempotencyKey), args.Error(1)
+}
+
+func (m *MockTransferAccountUseCase) HandleToPixKey(fromNumber string, pixKey string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	args := m.Called(fromNumber, pixKey, value, idempotencyKey, requestedBy)
+	return args.Get(0).(*domain.IdempotencyKey), args.Error(1)
+}
+
+func (m *MockTransferAccountUseCase) HandleToPayee(fromNumber string, payeeId string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	args := m.Called(fromNumber, payeeId, value, idempotencyKey, requestedBy)
+	return args.Get(0).(*domain.IdempotencyKey), args.Error(1)
+}
+
+func (m *MockTransferAccountUseCase) ExecuteScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer, requestedBy string) (*domain.IdempotencyKey, error) {
+	args := m.Called(scheduledTransfer, requestedBy)
+	return args.Get(0).(*domain.IdempotencyKey), args.Error(1)
+}
@@ -0,0 +1,37 @@
+package usecases_mock
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransferRequestsRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransferRequestsRepository) CreateTransferRequest(transferRequest *domain.TransferRequest) (string, error) {
+	args := m.Called(transferRequest)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockTransferRequestsRepository) GetTransferRequestForUpdate(id string) (*domain.TransferRequest, error) {
+	args := m.Called(id)
+	return args.Get(0).(*domain.TransferRequest), args.Error(1)
+}
+
+func (m *MockTransferRequestsRepository) GetTransferRequestsByAccount(accountNumber string) ([]*domain.TransferRequest, error) {
+	args := m.Called(accountNumber)
+	return args.Get(0).([]*domain.TransferRequest), args.Error(1)
+}
+
+func (m *MockTransferRequestsRepository) GetExpiredTransferRequests(now time.Time, limit int) ([]*domain.TransferRequest, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]*domain.TransferRequest), args.Error(1)
+}
+
+func (m *MockTransferRequestsRepository) UpdateTransferRequest(transferRequest *domain.TransferRequest) error {
+	args := m.Called(transferRequest)
+	return args.Error(0)
+}
@@ -0,0 +1,111 @@
+package usecases_mock
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type MockUnitOfWork struct {
+	accountRepository               repositories.AccountRepositoryInterface
+	idempotencyKeysRepository       repositories.IdempotencyKeysRepositoryInterface
+	outboxRepository                repositories.OutboxRepositoryInterface
+	ledgerRepository                repositories.LedgerRepositoryInterface
+	holdsRepository                 repositories.HoldsRepositoryInterface
+	transferRequestsRepository      repositories.TransferRequestsRepositoryInterface
+	riskAssessmentsRepository       repositories.RiskAssessmentsRepositoryInterface
+	accountProfileChangesRepository repositories.AccountProfileChangesRepositoryInterface
+	pocketsRepository               repositories.PocketsRepositoryInterface
+	accountHoldersRepository        repositories.AccountHoldersRepositoryInterface
+}
+
+func NewMockUnitOfWork(
+	accountRepository repositories.AccountRepositoryInterface,
+	idempotencyKeysRepository repositories.IdempotencyKeysRepositoryInterface,
+	outboxRepository repositories.OutboxRepositoryInterface,
+	ledgerRepository repositories.LedgerRepositoryInterface) *MockUnitOfWork {
+	return &MockUnitOfWork{
+		accountRepository:         accountRepository,
+		idempotencyKeysRepository: idempotencyKeysRepository,
+		outboxRepository:          outboxRepository,
+		ledgerRepository:          ledgerRepository,
+	}
+}
+
+func (m *MockUnitOfWork) AccountRepository() repositories.AccountRepositoryInterface {
+	return m.accountRepository
+}
+
+func (m *MockUnitOfWork) IdempotencyKeysRepository() repositories.IdempotencyKeysRepositoryInterface {
+	return m.idempotencyKeysRepository
+}
+
+func (m *MockUnitOfWork) OutboxRepository() repositories.OutboxRepositoryInterface {
+	return m.outboxRepository
+}
+
+func (m *MockUnitOfWork) LedgerRepository() repositories.LedgerRepositoryInterface {
+	return m.ledgerRepository
+}
+
+func (m *MockUnitOfWork) HoldsRepository() repositories.HoldsRepositoryInterface {
+	return m.holdsRepository
+}
+
+// WithHoldsRepository sets the holds repository, only needed by the use cases handling holds.
+func (m *MockUnitOfWork) WithHoldsRepository(holdsRepository repositories.HoldsRepositoryInterface) *MockUnitOfWork {
+	m.holdsRepository = holdsRepository
+	return m
+}
+
+func (m *MockUnitOfWork) TransferRequestsRepository() repositories.TransferRequestsRepositoryInterface {
+	return m.transferRequestsRepository
+}
+
+// WithTransferRequestsRepository sets the transfer requests repository, only needed by the use
+// cases handling transfers that need approval.
+func (m *MockUnitOfWork) WithTransferRequestsRepository(transferRequestsRepository repositories.TransferRequestsRepositoryInterface) *MockUnitOfWork {
+	m.transferRequestsRepository = transferRequestsRepository
+	return m
+}
+
+func (m *MockUnitOfWork) RiskAssessmentsRepository() repositories.RiskAssessmentsRepositoryInterface {
+	return m.riskAssessmentsRepository
+}
+
+// WithRiskAssessmentsRepository sets the risk assessments repository, only needed when the use
+// case screens the operation with risk rules.
+func (m *MockUnitOfWork) WithRiskAssessmentsRepository(riskAssessmentsRepository repositories.RiskAssessmentsRepositoryInterface) *MockUnitOfWork {
+	m.riskAssessmentsRepository = riskAssessmentsRepository
+	return m
+}
+
+func (m *MockUnitOfWork) AccountProfileChangesRepository() repositories.AccountProfileChangesRepositoryInterface {
+	return m.accountProfileChangesRepository
+}
+
+// WithAccountProfileChangesRepository sets the account profile changes repository, only needed
+// by the use case updating the account profile.
+func (m *MockUnitOfWork) WithAccountProfileChangesRepository(accountProfileChangesRepository repositories.AccountProfileChangesRepositoryInterface) *MockUnitOfWork {
+	m.accountProfileChangesRepository = accountProfileChangesRepository
+	return m
+}
+
+func (m *MockUnitOfWork) PocketsRepository() repositories.PocketsRepositoryInterface {
+	return m.pocketsRepository
+}
+
+// WithPocketsRepository sets the pockets repository, only needed by the use cases handling pockets.
+func (m *MockUnitOfWork) WithPocketsRepository(pocketsRepository repositories.PocketsRepositoryInterface) *MockUnitOfWork {
+	m.pocketsRepository = pocketsRepository
+	return m
+}
+
+func (m *MockUnitOfWork) AccountHoldersRepository() repositories.AccountHoldersRepositoryInterface {
+	return m.accountHoldersRepository
+}
+
+// WithAccountHoldersRepository sets the account holders repository, only needed by the use cases
+// creating accounts and updating the account profile.
+func (m *MockUnitOfWork) WithAccountHoldersRepository(accountHoldersRepository repositories.AccountHoldersRepositoryInterface) *MockUnitOfWork {
+	m.accountHoldersRepository = accountHoldersRepository
+	return m
+}
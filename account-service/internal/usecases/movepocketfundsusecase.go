@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+const movePocketFundsOperation = "move_pocket_funds"
+
+type MovePocketFundsUseCaseInterface interface {
+	Handle(number string, pocketId string, direction domain.PocketMoveDirection, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error)
+}
+
+type MovePocketFundsUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+type movePocketFundsRequest struct {
+	PocketId  string                     `json:"pocketId"`
+	Direction domain.PocketMoveDirection `json:"direction"`
+	Value     int64                      `json:"value"`
+	Currency  domain.Currency            `json:"currency,omitempty"`
+}
+
+type movePocketFundsResponse struct {
+	PocketId         string `json:"pocketId"`
+	PocketBalance    int64  `json:"pocketBalance"`
+	AvailableBalance int64  `json:"availableBalance"`
+}
+
+func NewMovePocketFundsUseCase(accountRepository repositories.AccountRepositoryInterface) *MovePocketFundsUseCase {
+	return &MovePocketFundsUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handle moves value between the main balance of the account and one of its pockets. The
+// money does not leave the account, so nothing is posted to the ledger. A retry with the same
+// key and request returns the outcome of the first execution.
+func (us *MovePocketFundsUseCase) Handle(number string, pocketId string, direction domain.PocketMoveDirection, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	key, err := domain.NewIdempotencyKey(number, requestedBy, idempotencyKey, movePocketFundsOperation,
+		movePocketFundsRequest{PocketId: pocketId, Direction: direction, Value: value.Amount, Currency: value.Currency})
+	if err != nil {
+		slog.Info("invalid idempotency key", "error", err)
+		return nil, err
+	}
+
+	var outcome *domain.IdempotencyKey
+	err = us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		outcome, err = getRecordedOutcome(uow.IdempotencyKeysRepository(), key)
+		if err != nil || outcome != nil {
+			return err
+		}
+
+		pocket, err := uow.PocketsRepository().GetPocket(number, pocketId)
+		if err != nil {
+			slog.Error("error getting pocket", "error", err, "number", number, "pocketId", pocketId)
+			return err
+		}
+
+		if pocket == nil {
+			slog.Info("pocket not found", "number", number, "pocketId", pocketId)
+			return errors.New("pocket not found")
+		}
+
+		value = value.OrCurrency(acc.Currency)
+
+		err = acc.MovePocketFunds(pocket, direction, value)
+		if err != nil {
+			slog.Info("pocket move not allowed", "error", err, "number", number, "pocketId", pocketId)
+			return err
+		}
+
+		err = uow.AccountRepository().UpdateAccountBalance(acc)
+		if err != nil {
+			slog.Error("error updating account balance", "error", err)
+			return err
+		}
+
+		err = uow.PocketsRepository().UpdatePocket(pocket)
+		if err != nil {
+			slog.Error("error updating pocket", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewPocketFundsMoved(acc.Number, pocket.Id, pocket.Name, direction, value, acc.Money(pocket.Balance)))
+		if err != nil {
+			slog.Error("error adding pocket funds moved event to outbox", "error", err)
+			return err
+		}
+
+		err = key.SetResponse(http.StatusOK, movePocketFundsResponse{
+			PocketId:         pocket.Id,
+			PocketBalance:    pocket.Balance,
+			AvailableBalance: acc.AvailableBalance(),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uow.IdempotencyKeysRepository().CreateKey(key)
+		if err != nil {
+			slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", idempotencyKey)
+			return err
+		}
+
+		outcome = key
+
+		slog.Info("pocket funds moved", "number", acc.Number, "pocketId", pocket.Id, "direction", direction, "value", value, "idempotencyKey", idempotencyKey)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outcome, nil
+}
@@ -0,0 +1,144 @@
+package usecases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMovePocketFundsUseCase_Handle_MoveIn(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewMovePocketFundsUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	pocket := &domain.Pocket{Id: "4", AccountNumber: acc.Number, Name: "Vacation", Balance: 100, Status: domain.PocketStatusActive}
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.MatchedBy(func(key *domain.IdempotencyKey) bool {
+		return key.Operation == "move_pocket_funds" && key.StatusCode == http.StatusOK &&
+			key.Response == `{"pocketId":"4","pocketBalance":500,"availableBalance":600}`
+	})).Return(nil)
+	mockPocketsRepository.On("GetPocket", acc.Number, "4").Return(pocket, nil)
+	mockPocketsRepository.On("UpdatePocket", pocket).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "PocketFundsMoved" &&
+			message.Data == `{"number":"19","pocketId":"4","pocketName":"Vacation","direction":"in","value":{"amount":400,"currency":"BRL"},"pocketBalance":{"amount":500,"currency":"BRL"}}`
+	})).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, "4", domain.PocketMoveIn, domain.Money{Amount: 400}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, outcome.StatusCode)
+	assert.Equal(t, int64(1000), acc.Balance)
+	assert.Equal(t, int64(400), acc.PocketBalance)
+	assert.Equal(t, int64(500), pocket.Balance)
+
+	mockRepo.AssertExpectations(t)
+	mockPocketsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+	mockIdempotencyRepository.AssertExpectations(t)
+}
+
+func TestMovePocketFundsUseCase_Handle_InsufficientPocketFunds(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewMovePocketFundsUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.PocketBalance = 100
+	pocket := &domain.Pocket{Id: "4", AccountNumber: acc.Number, Name: "Vacation", Balance: 100, Status: domain.PocketStatusActive}
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, nil, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockPocketsRepository.On("GetPocket", acc.Number, "4").Return(pocket, nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, "4", domain.PocketMoveOut, domain.Money{Amount: 200}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrInsufficientPocketFunds)
+	assert.Nil(t, outcome)
+	assert.Equal(t, int64(100), acc.PocketBalance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockPocketsRepository.AssertNotCalled(t, "UpdatePocket", mock.Anything)
+}
+
+func TestMovePocketFundsUseCase_Handle_PocketNotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewMovePocketFundsUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, nil, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockPocketsRepository.On("GetPocket", acc.Number, "4").Return((*domain.Pocket)(nil), nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, "4", domain.PocketMoveIn, domain.Money{Amount: 200}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.EqualError(t, err, "pocket not found")
+	assert.Nil(t, outcome)
+}
+
+func TestMovePocketFundsUseCase_Handle_ReplaysRecordedOutcome(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockPocketsRepository := new(usecases_mock.MockPocketsRepository)
+
+	useCase := NewMovePocketFundsUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+
+	idempotencyKey, _ := uuid.NewUUID()
+	recorded, _ := domain.NewIdempotencyKey(acc.Number, "bob", idempotencyKey.String(), movePocketFundsOperation,
+		movePocketFundsRequest{PocketId: "4", Direction: domain.PocketMoveIn, Value: 200})
+	recorded.StatusCode = http.StatusOK
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, nil, nil).WithPocketsRepository(mockPocketsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return(recorded, nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, "4", domain.PocketMoveIn, domain.Money{Amount: 200}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, outcome)
+
+	mockPocketsRepository.AssertNotCalled(t, "GetPocket", mock.Anything, mock.Anything)
+}
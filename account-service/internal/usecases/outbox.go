@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+const accountEventsTopic = "account"
+
+// addEventToOutbox stores the event to be published by the outbox relay, it must be called
+// within the same unit of work that changes the state the event describes.
+func addEventToOutbox(outboxRepository repositories.OutboxRepositoryInterface, event any) error {
+	eventPublish, err := events.NewEventPublish(event)
+	if err != nil {
+		return err
+	}
+
+	return outboxRepository.CreateMessage(domain.NewOutboxMessage(accountEventsTopic, eventPublish.Type, eventPublish.Data))
+}
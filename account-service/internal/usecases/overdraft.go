@@ -0,0 +1,17 @@
+package usecases
+
+import (
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+// addEnteredOverdraftEventToOutbox stores an AccountEnteredOverdraft event when a debit took
+// the account balance below zero, wasInOverdraft is the state before the debit.
+func addEnteredOverdraftEventToOutbox(outboxRepository repositories.OutboxRepositoryInterface, acc *domain.Account, wasInOverdraft bool) error {
+	if wasInOverdraft || !acc.InOverdraft() {
+		return nil
+	}
+
+	return addEventToOutbox(outboxRepository, events.NewAccountEnteredOverdraft(acc.Number, acc.Balance, acc.OverdraftLimit))
+}
@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+const placeHoldOperation = "place_hold"
+
+type PlaceHoldUseCaseInterface interface {
+	Handle(number string, amount int64, description string, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error)
+}
+
+type PlaceHoldUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+	ttl               time.Duration
+}
+
+type placeHoldRequest struct {
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+}
+
+type placeHoldResponse struct {
+	HoldId    string    `json:"holdId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func NewPlaceHoldUseCase(accountRepository repositories.AccountRepositoryInterface, ttl time.Duration) *PlaceHoldUseCase {
+	return &PlaceHoldUseCase{
+		accountRepository: accountRepository,
+		ttl:               ttl,
+	}
+}
+
+// Handle reserves amount of the available balance of the account until the hold is captured,
+// released or expires. A retry with the same key and request returns the outcome of the first execution.
+func (us *PlaceHoldUseCase) Handle(number string, amount int64, description string, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	key, err := domain.NewIdempotencyKey(number, requestedBy, idempotencyKey, placeHoldOperation, placeHoldRequest{Amount: amount, Description: description})
+	if err != nil {
+		slog.Info("invalid idempotency key", "error", err)
+		return nil, err
+	}
+
+	var outcome *domain.IdempotencyKey
+	err = us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		outcome, err = getRecordedOutcome(uow.IdempotencyKeysRepository(), key)
+		if err != nil || outcome != nil {
+			return err
+		}
+
+		hold, err := acc.PlaceHold(amount, description, us.ttl)
+		if err != nil {
+			slog.Info("hold not allowed", "error", err, "number", number)
+			return err
+		}
+
+		err = uow.AccountRepository().UpdateAccountBalance(acc)
+		if err != nil {
+			slog.Error("error updating account balance", "error", err)
+			return err
+		}
+
+		_, err = uow.HoldsRepository().CreateHold(hold)
+		if err != nil {
+			slog.Error("error creating hold", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewHoldPlaced(acc.Number, hold.Id, hold.Amount, hold.ExpiresAt))
+		if err != nil {
+			slog.Error("error adding hold placed event to outbox", "error", err)
+			return err
+		}
+
+		err = key.SetResponse(http.StatusCreated, placeHoldResponse{HoldId: hold.Id, ExpiresAt: hold.ExpiresAt})
+		if err != nil {
+			return err
+		}
+
+		err = uow.IdempotencyKeysRepository().CreateKey(key)
+		if err != nil {
+			slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", idempotencyKey)
+			return err
+		}
+
+		outcome = key
+
+		slog.Info("Hold placed", "accountNumber", acc.Number, "holdId", hold.Id, "amount", amount, "idempotencyKey", idempotencyKey)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outcome, nil
+}
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPlaceHoldUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+
+	useCase := NewPlaceHoldUseCase(mockRepo, time.Hour)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, nil).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockHoldsRepository.On("CreateHold", mock.MatchedBy(func(hold *domain.Hold) bool {
+		return hold.AccountNumber == acc.Number && hold.Amount == 400 && hold.Description == "card authorization" && hold.Status == domain.HoldStatusActive
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.Hold).Id = "7"
+	}).Return("7", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "HoldPlaced" && strings.HasPrefix(message.Data, `{"number":"19","holdId":"7","amount":400,`)
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.MatchedBy(func(key *domain.IdempotencyKey) bool {
+		return key.Operation == "place_hold" && key.StatusCode == http.StatusCreated && strings.Contains(key.Response, `"holdId":"7"`)
+	})).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, 400, "card authorization", idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, outcome.StatusCode)
+	assert.Equal(t, int64(1000), acc.Balance)
+	assert.Equal(t, int64(400), acc.HeldBalance)
+
+	mockRepo.AssertExpectations(t)
+	mockHoldsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+	mockIdempotencyRepository.AssertExpectations(t)
+}
+
+func TestPlaceHoldUseCase_Handle_InsufficientFunds(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+
+	useCase := NewPlaceHoldUseCase(mockRepo, time.Hour)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	acc.HeldBalance = 800
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, nil, nil).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, 400, "", idempotencyKey.String(), "bob")
+
+	// assert
+	assert.Nil(t, outcome)
+	assert.Equal(t, domain.ErrInsufficientFunds, err)
+	assert.Equal(t, int64(800), acc.HeldBalance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockHoldsRepository.AssertNotCalled(t, "CreateHold", mock.Anything)
+}
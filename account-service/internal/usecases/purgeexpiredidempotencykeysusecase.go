@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type PurgeExpiredIdempotencyKeysUseCaseInterface interface {
+	Handle() (int64, error)
+}
+
+type PurgeExpiredIdempotencyKeysUseCase struct {
+	idempotencyKeysRepository repositories.IdempotencyKeysRepositoryInterface
+	ttl                       time.Duration
+}
+
+func NewPurgeExpiredIdempotencyKeysUseCase(
+	idempotencyKeysRepository repositories.IdempotencyKeysRepositoryInterface,
+	ttl time.Duration) *PurgeExpiredIdempotencyKeysUseCase {
+	return &PurgeExpiredIdempotencyKeysUseCase{
+		idempotencyKeysRepository: idempotencyKeysRepository,
+		ttl:                       ttl,
+	}
+}
+
+// Handle deletes the idempotency keys older than the ttl. After that a request with the
+// same key is processed as a new one.
+func (us *PurgeExpiredIdempotencyKeysUseCase) Handle() (int64, error) {
+	deleted, err := us.idempotencyKeysRepository.DeleteKeysCreatedBefore(time.Now().Add(-us.ttl))
+	if err != nil {
+		slog.Error("error deleting expired idempotency keys", "error", err)
+		return 0, err
+	}
+
+	if deleted > 0 {
+		slog.Info("expired idempotency keys deleted", "count", deleted)
+	}
+
+	return deleted, nil
+}
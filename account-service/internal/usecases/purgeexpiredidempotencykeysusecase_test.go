@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurgeExpiredIdempotencyKeysUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+
+	useCase := NewPurgeExpiredIdempotencyKeysUseCase(mockIdempotencyRepository, 24*time.Hour)
+
+	mockIdempotencyRepository.On("DeleteKeysCreatedBefore", mock.MatchedBy(func(createdBefore time.Time) bool {
+		return time.Since(createdBefore) >= 24*time.Hour && time.Since(createdBefore) < 25*time.Hour
+	})).Return(int64(2), nil)
+
+	// act
+	deleted, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	mockIdempotencyRepository.AssertExpectations(t)
+}
+
+func TestPurgeExpiredIdempotencyKeysUseCase_Handle_Error(t *testing.T) {
+	// arrange
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+
+	useCase := NewPurgeExpiredIdempotencyKeysUseCase(mockIdempotencyRepository, 24*time.Hour)
+
+	mockIdempotencyRepository.On("DeleteKeysCreatedBefore", mock.Anything).Return(int64(0), errors.New("delete error"))
+
+	// act
+	deleted, err := useCase.Handle()
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), deleted)
+}
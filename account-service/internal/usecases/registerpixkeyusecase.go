@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type RegisterPixKeyUseCaseInterface interface {
+	Handle(number string, keyType domain.PixKeyType, key string) (*domain.PixKey, error)
+}
+
+type RegisterPixKeyUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+	pixKeysRepository repositories.PixKeysRepositoryInterface
+}
+
+func NewRegisterPixKeyUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	pixKeysRepository repositories.PixKeysRepositoryInterface) *RegisterPixKeyUseCase {
+	return &RegisterPixKeyUseCase{
+		accountRepository: accountRepository,
+		pixKeysRepository: pixKeysRepository,
+	}
+}
+
+// Handle registers the key for the account. A key belongs to a single account and each
+// account has a limit of keys, higher for companies.
+func (us *RegisterPixKeyUseCase) Handle(number string, keyType domain.PixKeyType, key string) (*domain.PixKey, error) {
+	acc, err := us.accountRepository.GetAccountByNumber(number)
+	if err != nil {
+		slog.Error("error getting account by number", "error", err)
+		return nil, err
+	}
+
+	if acc == nil {
+		slog.Info("account not found", "number", number)
+		return nil, errors.New("account not found")
+	}
+
+	err = acc.EnsureActive()
+	if err != nil {
+		slog.Info("account not active", "number", acc.Number, "status", acc.Status)
+		return nil, err
+	}
+
+	pixKey, err := domain.NewPixKey(acc, keyType, key)
+	if err != nil {
+		slog.Info("invalid pix key", "error", err, "number", number, "type", keyType)
+		return nil, err
+	}
+
+	existing, err := us.pixKeysRepository.GetPixKey(pixKey.Key)
+	if err != nil {
+		slog.Error("error getting pix key", "error", err)
+		return nil, err
+	}
+
+	if existing != nil {
+		slog.Info("pix key already registered", "number", number, "ownerNumber", existing.AccountNumber)
+		return nil, domain.ErrPixKeyInUse
+	}
+
+	pixKeys, err := us.pixKeysRepository.GetPixKeysByAccount(number)
+	if err != nil {
+		slog.Error("error getting pix keys by account", "error", err)
+		return nil, err
+	}
+
+	if len(pixKeys) >= acc.MaximumPixKeys() {
+		slog.Info("pix keys limit reached", "number", number, "limit", acc.MaximumPixKeys())
+		return nil, domain.ErrPixKeyLimitReached
+	}
+
+	_, err = us.pixKeysRepository.CreatePixKey(pixKey)
+	if err != nil {
+		slog.Error("error creating pix key", "error", err)
+		return nil, err
+	}
+
+	slog.Info("pix key registered", "number", number, "type", keyType, "id", pixKey.Id)
+
+	return pixKey, nil
+}
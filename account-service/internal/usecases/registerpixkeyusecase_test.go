@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegisterPixKeyUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	useCase := NewRegisterPixKeyUseCase(mockAccountRepository, mockPixKeysRepository)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(acc, nil)
+	mockPixKeysRepository.On("GetPixKey", "01234567890").Return((*domain.PixKey)(nil), nil)
+	mockPixKeysRepository.On("GetPixKeysByAccount", "19").Return([]*domain.PixKey{}, nil)
+	mockPixKeysRepository.On("CreatePixKey", mock.MatchedBy(func(pixKey *domain.PixKey) bool {
+		return pixKey.AccountNumber == "19" && pixKey.Type == domain.PixKeyDocument && pixKey.Key == "01234567890"
+	})).Return("1", nil)
+
+	// act
+	pixKey, err := useCase.Handle("19", domain.PixKeyDocument, "012.345.678-90")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "01234567890", pixKey.Key)
+	mockPixKeysRepository.AssertExpectations(t)
+}
+
+func TestRegisterPixKeyUseCase_Handle_AccountNotFound(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	useCase := NewRegisterPixKeyUseCase(mockAccountRepository, mockPixKeysRepository)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return((*domain.Account)(nil), nil)
+
+	// act
+	pixKey, err := useCase.Handle("19", domain.PixKeyRandom, "")
+
+	// assert
+	assert.Nil(t, pixKey)
+	assert.Equal(t, "account not found", err.Error())
+}
+
+func TestRegisterPixKeyUseCase_Handle_DocumentOfAnotherPerson(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	useCase := NewRegisterPixKeyUseCase(mockAccountRepository, mockPixKeysRepository)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+
+	// act
+	pixKey, err := useCase.Handle("19", domain.PixKeyDocument, "52998224725")
+
+	// assert
+	assert.Nil(t, pixKey)
+	assert.Equal(t, domain.ErrPixKeyNotOwned, err)
+	mockPixKeysRepository.AssertNotCalled(t, "CreatePixKey", mock.Anything)
+}
+
+func TestRegisterPixKeyUseCase_Handle_KeyInUse(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	useCase := NewRegisterPixKeyUseCase(mockAccountRepository, mockPixKeysRepository)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+	mockPixKeysRepository.On("GetPixKey", "john@mail.com").Return(&domain.PixKey{AccountNumber: "27", Key: "john@mail.com"}, nil)
+
+	// act
+	pixKey, err := useCase.Handle("19", domain.PixKeyEmail, "John@Mail.com")
+
+	// assert
+	assert.Nil(t, pixKey)
+	assert.Equal(t, domain.ErrPixKeyInUse, err)
+	mockPixKeysRepository.AssertNotCalled(t, "CreatePixKey", mock.Anything)
+}
+
+func TestRegisterPixKeyUseCase_Handle_LimitReached(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	useCase := NewRegisterPixKeyUseCase(mockAccountRepository, mockPixKeysRepository)
+
+	pixKeys := make([]*domain.PixKey, domain.MaximumPixKeysPerson)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+	mockPixKeysRepository.On("GetPixKey", mock.Anything).Return((*domain.PixKey)(nil), nil)
+	mockPixKeysRepository.On("GetPixKeysByAccount", "19").Return(pixKeys, nil)
+
+	// act
+	pixKey, err := useCase.Handle("19", domain.PixKeyRandom, "")
+
+	// assert
+	assert.Nil(t, pixKey)
+	assert.Equal(t, domain.ErrPixKeyLimitReached, err)
+	mockPixKeysRepository.AssertNotCalled(t, "CreatePixKey", mock.Anything)
+}
+
+func TestRegisterPixKeyUseCase_Handle_CreateError(t *testing.T) {
+	// arrange
+	mockAccountRepository := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+	useCase := NewRegisterPixKeyUseCase(mockAccountRepository, mockPixKeysRepository)
+
+	mockAccountRepository.On("GetAccountByNumber", "19").Return(domain.NewAccount("19", "01234567890", "John Doe"), nil)
+	mockPixKeysRepository.On("GetPixKey", "+5511912345678").Return((*domain.PixKey)(nil), nil)
+	mockPixKeysRepository.On("GetPixKeysByAccount", "19").Return([]*domain.PixKey{}, nil)
+	mockPixKeysRepository.On("CreatePixKey", mock.Anything).Return("", errors.New("db error"))
+
+	// act
+	pixKey, err := useCase.Handle("19", domain.PixKeyPhone, "+55 11 91234-5678")
+
+	// assert
+	assert.Nil(t, pixKey)
+	assert.Equal(t, "db error", err.Error())
+}
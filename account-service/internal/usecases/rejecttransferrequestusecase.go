@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type RejectTransferRequestUseCaseInterface interface {
+	Handle(id string, rejectedBy string, reason string) error
+}
+
+type RejectTransferRequestUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewRejectTransferRequestUseCase(accountRepository repositories.AccountRepositoryInterface) *RejectTransferRequestUseCase {
+	return &RejectTransferRequestUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+func (us *RejectTransferRequestUseCase) Handle(id string, rejectedBy string, reason string) error {
+	return us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		transferRequest, err := uow.TransferRequestsRepository().GetTransferRequestForUpdate(id)
+		if err != nil {
+			slog.Error("error getting transfer request", "error", err, "id", id)
+			return err
+		}
+
+		if transferRequest == nil {
+			slog.Info("transfer request not found", "id", id)
+			return errors.New("transfer request not found")
+		}
+
+		err = transferRequest.Reject(rejectedBy, reason, time.Now())
+		if err != nil {
+			slog.Info("transfer request not rejected", "error", err, "id", id, "status", transferRequest.Status)
+			return err
+		}
+
+		err = uow.TransferRequestsRepository().UpdateTransferRequest(transferRequest)
+		if err != nil {
+			slog.Error("error updating transfer request", "error", err, "id", id)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewTransferRejected(transferRequest.Id, transferRequest.FromNumber,
+			transferRequest.ToNumber, transferRequest.Value, rejectedBy, reason))
+		if err != nil {
+			slog.Error("error adding transfer rejected event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("Transfer request rejected", "id", transferRequest.Id, "fromNumber", transferRequest.FromNumber, "rejectedBy", rejectedBy)
+
+		return nil
+	})
+}
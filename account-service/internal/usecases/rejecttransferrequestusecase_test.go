@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRejectTransferRequestUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	useCase := NewRejectTransferRequestUseCase(mockRepo)
+
+	transferRequest := domain.NewTransferRequest("123", "456", "", 100, "user-1", time.Hour)
+	transferRequest.Id = "3"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).
+		WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockTransferRequestsRepository.On("GetTransferRequestForUpdate", "3").Return(transferRequest, nil)
+	mockTransferRequestsRepository.On("UpdateTransferRequest", transferRequest).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferRejected" &&
+			message.Data == `{"transferRequestId":"3","fromNumber":"123","toNumber":"456","value":100,"rejectedBy":"user-2","reason":"unknown payee"}`
+	})).Return(nil)
+
+	// act
+	err := useCase.Handle("3", "user-2", "unknown payee")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.TransferRequestRejected, transferRequest.Status)
+	assert.Equal(t, "unknown payee", transferRequest.RejectionReason)
+
+	mockTransferRequestsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+}
+
+func TestRejectTransferRequestUseCase_Handle_AlreadyApproved(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	useCase := NewRejectTransferRequestUseCase(mockRepo)
+
+	transferRequest := domain.NewTransferRequest("123", "456", "", 100, "user-1", time.Hour)
+	transferRequest.Id = "3"
+	transferRequest.Status = domain.TransferRequestApproved
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil).
+		WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockTransferRequestsRepository.On("GetTransferRequestForUpdate", "3").Return(transferRequest, nil)
+
+	// act
+	err := useCase.Handle("3", "user-2", "unknown payee")
+
+	// assert
+	assert.Equal(t, domain.ErrTransferRequestNotPending, err)
+	mockTransferRequestsRepository.AssertNotCalled(t, "UpdateTransferRequest", mock.Anything)
+}
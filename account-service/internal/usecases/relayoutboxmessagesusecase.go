@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/infrastructure/broker"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type RelayOutboxMessagesUseCaseInterface interface {
+	Handle() (int, error)
+}
+
+type RelayOutboxMessagesUseCase struct {
+	outboxRepository repositories.OutboxRepositoryInterface
+	broker           broker.BrokerInterface
+	batchSize        int
+	maxAttempts      int
+	retryDelay       time.Duration
+}
+
+func NewRelayOutboxMessagesUseCase(
+	outboxRepository repositories.OutboxRepositoryInterface,
+	broker broker.BrokerInterface,
+	batchSize int,
+	maxAttempts int,
+	retryDelay time.Duration) *RelayOutboxMessagesUseCase {
+	return &RelayOutboxMessagesUseCase{
+		outboxRepository: outboxRepository,
+		broker:           broker,
+		batchSize:        batchSize,
+		maxAttempts:      maxAttempts,
+		retryDelay:       retryDelay,
+	}
+}
+
+// Handle publishes a batch of pending outbox messages in creation order and returns how many
+// were sent. Only the relay holding the relay lock publishes, the others return without sending,
+// and publishing stops at the first failure, so events are delivered in order; the failed message
+// keeps pending and is retried with backoff. After maxAttempts it is dead-lettered and the
+// messages behind it are published, the only case they overtake an earlier event.
+func (us *RelayOutboxMessagesUseCase) Handle() (int, error) {
+	sent := 0
+
+	err := us.outboxRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		locked, err := uow.OutboxRepository().TryLockRelay()
+		if err != nil {
+			slog.Error("error locking outbox relay", "error", err)
+			return err
+		}
+
+		if !locked {
+			return nil
+		}
+
+		messages, err := uow.OutboxRepository().GetPendingMessagesForUpdate(us.batchSize)
+		if err != nil {
+			slog.Error("error getting pending outbox messages", "error", err)
+			return err
+		}
+
+		now := time.Now()
+		for _, message := range messages {
+			if !message.DueForAttempt(now) {
+				return nil
+			}
+
+			err = us.publish(message)
+			if err != nil {
+				slog.Error("error publishing outbox message", "error", err, "id", message.Id, "type", message.Type, "attempts", message.Attempts+1)
+				message.SetAsFailed(err, now, us.maxAttempts, us.retryDelay)
+
+				err = uow.OutboxRepository().UpdateMessage(message)
+				if err != nil || !message.DeadLettered() {
+					return err
+				}
+
+				slog.Error("outbox message dead-lettered", "id", message.Id, "eventId", message.EventId, "type", message.Type, "attempts", message.Attempts)
+
+				continue
+			}
+
+			message.SetAsSent()
+
+			err = uow.OutboxRepository().UpdateMessage(message)
+			if err != nil {
+				slog.Error("error updating outbox message as sent", "error", err, "id", message.Id)
+				return err
+			}
+
+			sent++
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	if sent > 0 {
+		slog.Info("outbox messages relayed", "sent", sent)
+	}
+
+	return sent, nil
+}
+
+func (us *RelayOutboxMessagesUseCase) publish(message *domain.OutboxMessage) error {
+	eventPublish := &events.EventPublish{
+		Id:   message.EventId,
+		Type: message.Type,
+		Data: message.Data,
+	}
+
+	return us.broker.Produce(eventPublish, &broker.ProduceConfigs{Topic: message.Topic})
+}
@@ -0,0 +1,206 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/infrastructure/broker"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getTestOutboxMessages() []*domain.OutboxMessage {
+	first := domain.NewOutboxMessage("account", "FundsDeposited", `{"number":"1","value":100}`)
+	first.Id = "1"
+
+	second := domain.NewOutboxMessage("account", "FundsDeposited", `{"number":"2","value":200}`)
+	second.Id = "2"
+
+	return []*domain.OutboxMessage{first, second}
+}
+
+func TestRelayOutboxMessagesUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockBroker := new(usecases_mock.MockBroker)
+
+	useCase := NewRelayOutboxMessagesUseCase(mockOutboxRepository, mockBroker, 10, 3, time.Second)
+
+	messages := getTestOutboxMessages()
+
+	mockOutboxRepository.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(nil, nil, mockOutboxRepository, nil), nil)
+	mockOutboxRepository.On("TryLockRelay").Return(true, nil)
+	mockOutboxRepository.On("GetPendingMessagesForUpdate", 10).Return(messages, nil)
+	mockOutboxRepository.On("UpdateMessage", mock.Anything).Return(nil)
+	mockBroker.On("Produce", &events.EventPublish{Id: messages[0].EventId, Type: "FundsDeposited", Data: messages[0].Data}, &broker.ProduceConfigs{Topic: "account"}).Return(nil)
+	mockBroker.On("Produce", &events.EventPublish{Id: messages[1].EventId, Type: "FundsDeposited", Data: messages[1].Data}, &broker.ProduceConfigs{Topic: "account"}).Return(nil)
+
+	// act
+	sent, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.NotNil(t, messages[0].SentAt)
+	assert.NotNil(t, messages[1].SentAt)
+
+	mockOutboxRepository.AssertNumberOfCalls(t, "UpdateMessage", 2)
+	mockBroker.AssertExpectations(t)
+}
+
+func TestRelayOutboxMessagesUseCase_Handle_ProduceErrorStopsBatch(t *testing.T) {
+	// arrange
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockBroker := new(usecases_mock.MockBroker)
+
+	useCase := NewRelayOutboxMessagesUseCase(mockOutboxRepository, mockBroker, 10, 3, time.Second)
+
+	messages := getTestOutboxMessages()
+
+	mockOutboxRepository.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(nil, nil, mockOutboxRepository, nil), nil)
+	mockOutboxRepository.On("TryLockRelay").Return(true, nil)
+	mockOutboxRepository.On("GetPendingMessagesForUpdate", 10).Return(messages, nil)
+	mockOutboxRepository.On("UpdateMessage", messages[0]).Return(nil)
+	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(errors.New("broker unavailable")).Once()
+
+	// act
+	sent, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Nil(t, messages[0].SentAt)
+	assert.Equal(t, 1, messages[0].Attempts)
+	assert.Equal(t, "broker unavailable", messages[0].LastError)
+	assert.False(t, messages[0].DeadLettered())
+	assert.True(t, messages[0].NextAttemptAt.After(time.Now()))
+
+	mockBroker.AssertNumberOfCalls(t, "Produce", 1)
+	mockOutboxRepository.AssertNotCalled(t, "UpdateMessage", messages[1])
+}
+
+func TestRelayOutboxMessagesUseCase_Handle_ErrorGettingPendingMessages(t *testing.T) {
+	// arrange
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockBroker := new(usecases_mock.MockBroker)
+
+	useCase := NewRelayOutboxMessagesUseCase(mockOutboxRepository, mockBroker, 10, 3, time.Second)
+
+	mockOutboxRepository.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(nil, nil, mockOutboxRepository, nil), nil)
+	mockOutboxRepository.On("TryLockRelay").Return(true, nil)
+	mockOutboxRepository.On("GetPendingMessagesForUpdate", 10).Return([]*domain.OutboxMessage(nil), errors.New("db error"))
+
+	// act
+	sent, err := useCase.Handle()
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, 0, sent)
+
+	mockBroker.AssertNotCalled(t, "Produce", mock.Anything, mock.Anything)
+}
+
+func TestRelayOutboxMessagesUseCase_Handle_ErrorMarkingAsSent(t *testing.T) {
+	// arrange
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockBroker := new(usecases_mock.MockBroker)
+
+	useCase := NewRelayOutboxMessagesUseCase(mockOutboxRepository, mockBroker, 10, 3, time.Second)
+
+	messages := getTestOutboxMessages()
+
+	mockOutboxRepository.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(nil, nil, mockOutboxRepository, nil), nil)
+	mockOutboxRepository.On("TryLockRelay").Return(true, nil)
+	mockOutboxRepository.On("GetPendingMessagesForUpdate", 10).Return(messages, nil)
+	mockOutboxRepository.On("UpdateMessage", messages[0]).Return(errors.New("db error"))
+	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+
+	// act
+	sent, err := useCase.Handle()
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, 0, sent)
+
+	mockBroker.AssertNumberOfCalls(t, "Produce", 1)
+}
+
+func TestRelayOutboxMessagesUseCase_Handle_DeadLettersAfterMaxAttempts(t *testing.T) {
+	// arrange
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockBroker := new(usecases_mock.MockBroker)
+
+	useCase := NewRelayOutboxMessagesUseCase(mockOutboxRepository, mockBroker, 10, 3, time.Second)
+
+	messages := getTestOutboxMessages()
+	messages[0].Attempts = 2
+
+	mockOutboxRepository.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(nil, nil, mockOutboxRepository, nil), nil)
+	mockOutboxRepository.On("TryLockRelay").Return(true, nil)
+	mockOutboxRepository.On("GetPendingMessagesForUpdate", 10).Return(messages, nil)
+	mockOutboxRepository.On("UpdateMessage", mock.Anything).Return(nil)
+	mockBroker.On("Produce", &events.EventPublish{Id: messages[0].EventId, Type: "FundsDeposited", Data: messages[0].Data}, mock.Anything).Return(errors.New("broker unavailable"))
+	mockBroker.On("Produce", &events.EventPublish{Id: messages[1].EventId, Type: "FundsDeposited", Data: messages[1].Data}, mock.Anything).Return(nil)
+
+	// act
+	sent, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.True(t, messages[0].DeadLettered())
+	assert.Nil(t, messages[0].SentAt)
+	assert.NotNil(t, messages[1].SentAt)
+
+	mockOutboxRepository.AssertNumberOfCalls(t, "UpdateMessage", 2)
+}
+
+func TestRelayOutboxMessagesUseCase_Handle_WaitsForRetryDelay(t *testing.T) {
+	// arrange
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockBroker := new(usecases_mock.MockBroker)
+
+	useCase := NewRelayOutboxMessagesUseCase(mockOutboxRepository, mockBroker, 10, 3, time.Second)
+
+	messages := getTestOutboxMessages()
+	messages[0].Attempts = 1
+	messages[0].NextAttemptAt = time.Now().Add(time.Minute)
+
+	mockOutboxRepository.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(nil, nil, mockOutboxRepository, nil), nil)
+	mockOutboxRepository.On("TryLockRelay").Return(true, nil)
+	mockOutboxRepository.On("GetPendingMessagesForUpdate", 10).Return(messages, nil)
+
+	// act
+	sent, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+
+	mockBroker.AssertNotCalled(t, "Produce", mock.Anything, mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "UpdateMessage", mock.Anything)
+}
+
+func TestRelayOutboxMessagesUseCase_Handle_RelayLockedByAnother(t *testing.T) {
+	// arrange
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockBroker := new(usecases_mock.MockBroker)
+
+	useCase := NewRelayOutboxMessagesUseCase(mockOutboxRepository, mockBroker, 10, 3, time.Second)
+
+	mockOutboxRepository.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(nil, nil, mockOutboxRepository, nil), nil)
+	mockOutboxRepository.On("TryLockRelay").Return(false, nil)
+
+	// act
+	sent, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	mockOutboxRepository.AssertNotCalled(t, "GetPendingMessagesForUpdate", mock.Anything)
+	mockBroker.AssertNotCalled(t, "Produce", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type ReleaseHoldUseCaseInterface interface {
+	Handle(number string, holdId string) error
+}
+
+type ReleaseHoldUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewReleaseHoldUseCase(accountRepository repositories.AccountRepositoryInterface) *ReleaseHoldUseCase {
+	return &ReleaseHoldUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handle returns the amount of the hold to the available balance of the account.
+func (us *ReleaseHoldUseCase) Handle(number string, holdId string) error {
+	return us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		hold, err := uow.HoldsRepository().GetHold(number, holdId)
+		if err != nil {
+			slog.Error("error getting hold", "error", err, "holdId", holdId)
+			return err
+		}
+
+		if hold == nil {
+			slog.Info("hold not found", "number", number, "holdId", holdId)
+			return errors.New("hold not found")
+		}
+
+		err = releaseHold(uow, acc, hold, domain.HoldStatusReleased)
+		if err != nil {
+			return err
+		}
+
+		slog.Info("Hold released", "accountNumber", acc.Number, "holdId", hold.Id, "amount", hold.Amount)
+
+		return nil
+	})
+}
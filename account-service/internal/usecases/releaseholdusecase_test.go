@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReleaseHoldUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+
+	useCase := NewReleaseHoldUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.Balance = 1000
+	hold, _ := acc.PlaceHold(400, "", time.Hour)
+	hold.Id = "7"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return(hold, nil)
+	mockRepo.On("UpdateAccountBalance", acc).Return(nil)
+	mockHoldsRepository.On("UpdateHold", hold).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "HoldReleased" && message.Data == `{"number":"19","holdId":"7","amount":400,"status":"released"}`
+	})).Return(nil)
+
+	// act
+	err := useCase.Handle(acc.Number, "7")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.HoldStatusReleased, hold.Status)
+	assert.Equal(t, int64(0), acc.HeldBalance)
+
+	mockRepo.AssertExpectations(t)
+	mockHoldsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestReleaseHoldUseCase_Handle_HoldNotActive(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockHoldsRepository := new(usecases_mock.MockHoldsRepository)
+
+	useCase := NewReleaseHoldUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	hold := &domain.Hold{Id: "7", AccountNumber: acc.Number, Amount: 400, Status: domain.HoldStatusCaptured}
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil).WithHoldsRepository(mockHoldsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockHoldsRepository.On("GetHold", acc.Number, "7").Return(hold, nil)
+
+	// act
+	err := useCase.Handle(acc.Number, "7")
+
+	// assert
+	assert.Equal(t, domain.ErrHoldNotActive, err)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+}
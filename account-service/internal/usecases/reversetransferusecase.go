@@ -0,0 +1,174 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+const reverseTransferOperation = "reverse_transfer"
+
+type ReverseTransferUseCaseInterface interface {
+	Handle(transferId string, value int64, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error)
+}
+
+type ReverseTransferUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+type reverseTransferRequest struct {
+	TransferId string `json:"transferId"`
+	Value      int64  `json:"value"`
+}
+
+type reverseTransferResponse struct {
+	ReversalId string `json:"reversalId"`
+	Value      int64  `json:"value"`
+}
+
+func NewReverseTransferUseCase(accountRepository repositories.AccountRepositoryInterface) *ReverseTransferUseCase {
+	return &ReverseTransferUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handle moves value back from the receiver of the transfer to its sender, a zero value
+// reverses what was not reversed yet. The idempotency key is scoped to the receiver account,
+// which is the one debited.
+func (us *ReverseTransferUseCase) Handle(transferId string, value int64, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	var outcome *domain.IdempotencyKey
+	err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		transfer, err := uow.LedgerRepository().GetTransactionForUpdate(transferId)
+		if err != nil {
+			slog.Error("error getting transfer", "error", err, "transferId", transferId)
+			return err
+		}
+
+		if transfer == nil {
+			slog.Info("transfer not found", "transferId", transferId)
+			return errors.New("transfer not found")
+		}
+
+		senderNumber, receiverNumber, _, err := transfer.TransferParties()
+		if err != nil {
+			slog.Info("transaction is not a transfer", "transferId", transferId, "type", transfer.Type)
+			return err
+		}
+
+		key, err := domain.NewIdempotencyKey(receiverNumber, requestedBy, idempotencyKey, reverseTransferOperation, reverseTransferRequest{TransferId: transferId, Value: value})
+		if err != nil {
+			slog.Info("invalid idempotency key", "error", err)
+			return err
+		}
+
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(receiverNumber, senderNumber)
+		if err != nil {
+			slog.Error("Error getting accounts by number", "error", err)
+			return err
+		}
+
+		outcome, err = getRecordedOutcome(uow.IdempotencyKeysRepository(), key)
+		if err != nil || outcome != nil {
+			return err
+		}
+
+		receiverAcc := accounts[receiverNumber]
+		senderAcc := accounts[senderNumber]
+		if receiverAcc == nil || senderAcc == nil {
+			slog.Error("transfer account not found", "transferId", transferId)
+			return errors.New("account not found")
+		}
+
+		reversedValue, err := uow.LedgerRepository().GetReversedValue(transferId)
+		if err != nil {
+			slog.Error("error getting transfer reversed value", "error", err, "transferId", transferId)
+			return err
+		}
+
+		reversal, err := domain.NewTransferReversalLedgerTransaction(transfer, value, reversedValue)
+		if err != nil {
+			slog.Info("transfer reversal not allowed", "error", err, "transferId", transferId)
+			return err
+		}
+
+		reversalValue := reversal.ReversalValue()
+
+		err = receiverAcc.EnsureActive()
+		if err != nil {
+			slog.Info("account not active", "number", receiverAcc.Number, "status", receiverAcc.Status)
+			return err
+		}
+
+		err = senderAcc.EnsureActive()
+		if err != nil {
+			slog.Info("account not active", "number", senderAcc.Number, "status", senderAcc.Status)
+			return err
+		}
+
+		wasInOverdraft := receiverAcc.InOverdraft()
+
+		err = receiverAcc.Transfer(reversalValue, senderAcc)
+		if err != nil {
+			slog.Info("transfer reversal not allowed", "error", err, "transferId", transferId)
+			return err
+		}
+
+		err = uow.AccountRepository().UpdateAccountBalance(receiverAcc)
+		if err != nil {
+			slog.Error("Error updating receiver account balance", "error", err)
+			return err
+		}
+
+		err = uow.AccountRepository().UpdateAccountBalance(senderAcc)
+		if err != nil {
+			slog.Error("Error updating sender account balance", "error", err)
+			return err
+		}
+
+		err = uow.LedgerRepository().CreateTransaction(reversal)
+		if err != nil {
+			slog.Error("error creating ledger transaction", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewTransferReversed(transferId, reversal.Id, receiverAcc.Number, senderAcc.Number,
+			reversalValue, receiverAcc.Money(receiverAcc.Balance), senderAcc.Money(senderAcc.Balance)))
+		if err != nil {
+			slog.Error("error adding transfer reversed event to outbox", "error", err)
+			return err
+		}
+
+		err = addEnteredOverdraftEventToOutbox(uow.OutboxRepository(), receiverAcc, wasInOverdraft)
+		if err != nil {
+			slog.Error("error adding account entered overdraft event to outbox", "error", err)
+			return err
+		}
+
+		err = key.SetResponse(http.StatusCreated, reverseTransferResponse{ReversalId: reversal.Id, Value: reversalValue.Amount})
+		if err != nil {
+			return err
+		}
+
+		err = uow.IdempotencyKeysRepository().CreateKey(key)
+		if err != nil {
+			slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", idempotencyKey)
+			return err
+		}
+
+		outcome = key
+
+		slog.Info("Transfer reversed", "transferId", transferId, "reversalId", reversal.Id, "value", reversalValue)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outcome, nil
+}
@@ -0,0 +1,183 @@
+package usecases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newReverseTransferMocks() (*usecases_mock.MockAccountRepository, *usecases_mock.MockOutboxRepository,
+	*usecases_mock.MockIdempotencyRepository, *usecases_mock.MockLedgerRepository) {
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+
+	return mockRepo, mockOutboxRepository, mockIdempotencyRepository, mockLedgerRepository
+}
+
+func TestReverseTransferUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo, mockOutboxRepository, mockIdempotencyRepository, mockLedgerRepository := newReverseTransferMocks()
+	useCase := NewReverseTransferUseCase(mockRepo)
+
+	transfer := domain.NewTransferLedgerTransaction("123", "456", domain.NewMoney(100, domain.DefaultCurrency))
+	transfer.Id = "10"
+
+	senderAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	receiverAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+	receiverAcc.Balance = 100
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockLedgerRepository.On("GetTransactionForUpdate", "10").Return(transfer, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"456", "123"}).Return(map[string]*domain.Account{"123": senderAcc, "456": receiverAcc}, nil)
+	mockIdempotencyRepository.On("GetKey", "456", "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockLedgerRepository.On("GetReversedValue", "10").Return(int64(30), nil)
+	mockRepo.On("UpdateAccountBalance", receiverAcc).Return(nil)
+	mockRepo.On("UpdateAccountBalance", senderAcc).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == "transfer_reversal" && transaction.ReversedTransactionId == "10" &&
+			transaction.Entries[0].AccountNumber == "456" && transaction.Entries[0].Amount == -70
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "11"
+	}).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferReversed" &&
+			message.Data == `{"transferId":"10","reversalId":"11","fromNumber":"456","toNumber":"123","value":{"amount":70,"currency":"BRL"},"fromBalance":{"amount":30,"currency":"BRL"},"toBalance":{"amount":70,"currency":"BRL"}}`
+	})).Return(nil)
+	mockIdempotencyRepository.On("CreateKey", mock.MatchedBy(func(key *domain.IdempotencyKey) bool {
+		return key.Scope == "456" && key.Operation == "reverse_transfer" && key.StatusCode == http.StatusCreated
+	})).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle("10", 0, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, outcome.StatusCode)
+	assert.Equal(t, `{"reversalId":"11","value":70}`, outcome.Response)
+	assert.Equal(t, int64(30), receiverAcc.Balance)
+	assert.Equal(t, int64(70), senderAcc.Balance)
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+	mockIdempotencyRepository.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+}
+
+func TestReverseTransferUseCase_Handle_TransferNotFound(t *testing.T) {
+	// arrange
+	mockRepo, _, _, mockLedgerRepository := newReverseTransferMocks()
+	useCase := NewReverseTransferUseCase(mockRepo)
+
+	mockLedgerRepository.On("GetTransactionForUpdate", "10").Return((*domain.LedgerTransaction)(nil), nil)
+
+	// act
+	outcome, err := useCase.Handle("10", 0, "key", "bob")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "transfer not found", err.Error())
+	assert.Nil(t, outcome)
+}
+
+func TestReverseTransferUseCase_Handle_NotATransfer(t *testing.T) {
+	// arrange
+	mockRepo, _, _, mockLedgerRepository := newReverseTransferMocks()
+	useCase := NewReverseTransferUseCase(mockRepo)
+
+	reversal, _ := domain.NewTransferReversalLedgerTransaction(domain.NewTransferLedgerTransaction("123", "456", domain.NewMoney(100, domain.DefaultCurrency)), 0, 0)
+	reversal.Id = "11"
+
+	mockLedgerRepository.On("GetTransactionForUpdate", "11").Return(reversal, nil)
+
+	// act
+	_, err := useCase.Handle("11", 0, "key", "bob")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "transaction is not a transfer", err.Error())
+	mockRepo.AssertNotCalled(t, "GetAccountsByNumbersForUpdate", mock.Anything)
+}
+
+func TestReverseTransferUseCase_Handle_AlreadyReversed(t *testing.T) {
+	// arrange
+	mockRepo, mockOutboxRepository, mockIdempotencyRepository, mockLedgerRepository := newReverseTransferMocks()
+	useCase := NewReverseTransferUseCase(mockRepo)
+
+	transfer := domain.NewTransferLedgerTransaction("123", "456", domain.NewMoney(100, domain.DefaultCurrency))
+	transfer.Id = "10"
+
+	senderAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	receiverAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockLedgerRepository.On("GetTransactionForUpdate", "10").Return(transfer, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"456", "123"}).Return(map[string]*domain.Account{"123": senderAcc, "456": receiverAcc}, nil)
+	mockIdempotencyRepository.On("GetKey", "456", "bob", "key").Return((*domain.IdempotencyKey)(nil), nil)
+	mockLedgerRepository.On("GetReversedValue", "10").Return(int64(100), nil)
+
+	// act
+	_, err := useCase.Handle("10", 0, "key", "bob")
+
+	// assert
+	assert.Equal(t, domain.ErrTransferAlreadyReversed, err)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestReverseTransferUseCase_Handle_IdempotencyKeyReplayed(t *testing.T) {
+	// arrange
+	mockRepo, _, mockIdempotencyRepository, mockLedgerRepository := newReverseTransferMocks()
+	useCase := NewReverseTransferUseCase(mockRepo)
+
+	transfer := domain.NewTransferLedgerTransaction("123", "456", domain.NewMoney(100, domain.DefaultCurrency))
+	transfer.Id = "10"
+
+	recorded, _ := domain.NewIdempotencyKey("456", "bob", "key", "reverse_transfer", reverseTransferRequest{TransferId: "10"})
+	recorded.SetResponse(http.StatusCreated, reverseTransferResponse{ReversalId: "11", Value: 100})
+
+	mockLedgerRepository.On("GetTransactionForUpdate", "10").Return(transfer, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"456", "123"}).Return(map[string]*domain.Account{}, nil)
+	mockIdempotencyRepository.On("GetKey", "456", "bob", "key").Return(recorded, nil)
+
+	// act
+	outcome, err := useCase.Handle("10", 0, "key", "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, outcome)
+	mockLedgerRepository.AssertNotCalled(t, "GetReversedValue", mock.Anything)
+}
+
+func TestReverseTransferUseCase_Handle_ReceiverInsufficientFunds(t *testing.T) {
+	// arrange
+	mockRepo, mockOutboxRepository, mockIdempotencyRepository, mockLedgerRepository := newReverseTransferMocks()
+	useCase := NewReverseTransferUseCase(mockRepo)
+
+	transfer := domain.NewTransferLedgerTransaction("123", "456", domain.NewMoney(100, domain.DefaultCurrency))
+	transfer.Id = "10"
+
+	senderAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	receiverAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+	receiverAcc.Balance = 20
+
+	mockLedgerRepository.On("GetTransactionForUpdate", "10").Return(transfer, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"456", "123"}).Return(map[string]*domain.Account{"123": senderAcc, "456": receiverAcc}, nil)
+	mockIdempotencyRepository.On("GetKey", "456", "bob", "key").Return((*domain.IdempotencyKey)(nil), nil)
+	mockLedgerRepository.On("GetReversedValue", "10").Return(int64(0), nil)
+
+	// act
+	_, err := useCase.Handle("10", 50, "key", "bob")
+
+	// assert
+	assert.Equal(t, domain.ErrInsufficientFunds, err)
+	assert.Equal(t, int64(20), receiverAcc.Balance)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
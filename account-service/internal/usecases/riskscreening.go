@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+// screenRisk evaluates the operation against the risk rules, records the decision and publishes
+// SuspiciousActivityDetected when it is not allowed. The account row must be locked so velocity
+// rules count concurrent operations. Without rules every operation is allowed and nothing is recorded.
+func screenRisk(uow repositories.UnitOfWorkInterface, rules domain.RiskRules, operation domain.RiskOperation, now time.Time) (*domain.RiskAssessment, error) {
+	assessment := domain.NewRiskAssessment(operation)
+	if len(rules) == 0 {
+		return assessment, nil
+	}
+
+	for _, rule := range rules {
+		if !rule.AppliesTo(operation) {
+			continue
+		}
+
+		facts, err := riskFacts(uow.LedgerRepository(), rule, operation, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if rule.Triggered(operation, facts) {
+			assessment.Record(rule)
+		}
+	}
+
+	_, err := uow.RiskAssessmentsRepository().CreateRiskAssessment(assessment)
+	if err != nil {
+		slog.Error("error creating risk assessment", "error", err)
+		return nil, err
+	}
+
+	if !assessment.Suspicious() {
+		return assessment, nil
+	}
+
+	slog.Info("suspicious activity detected", "operation", operation.Type, "number", operation.AccountNumber,
+		"decision", assessment.Decision, "rule", assessment.Rule)
+
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewSuspiciousActivityDetected(assessment.Id, string(assessment.Operation),
+		assessment.AccountNumber, assessment.CounterpartyNumber, assessment.Value, string(assessment.Decision), assessment.Rule))
+	if err != nil {
+		slog.Error("error adding suspicious activity detected event to outbox", "error", err)
+		return nil, err
+	}
+
+	return assessment, nil
+}
+
+func riskFacts(ledgerRepository repositories.LedgerRepositoryInterface, rule domain.RiskRule, operation domain.RiskOperation, now time.Time) (domain.RiskFacts, error) {
+	var facts domain.RiskFacts
+	var err error
+
+	switch rule.Type {
+	case domain.RiskRuleVelocity:
+		since := now.Add(-rule.Window)
+		if operation.Type == domain.RiskOperationDeposit {
+			facts.RecentCount, err = ledgerRepository.CountDeposits(operation.AccountNumber, since)
+		} else {
+			facts.RecentCount, err = ledgerRepository.CountOutgoingTransfers(operation.AccountNumber, since)
+		}
+	case domain.RiskRuleNewCounterparty:
+		facts.KnownCounterparty, err = ledgerRepository.HasTransferredTo(operation.AccountNumber, operation.CounterpartyNumber)
+	}
+
+	if err != nil {
+		slog.Error("error reading risk facts", "error", err, "rule", rule.Name)
+	}
+
+	return facts, err
+}
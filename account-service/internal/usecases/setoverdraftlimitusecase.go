@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type SetOverdraftLimitUseCaseInterface interface {
+	Handle(number string, limit int64) error
+}
+
+type SetOverdraftLimitUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewSetOverdraftLimitUseCase(accountRepository repositories.AccountRepositoryInterface) *SetOverdraftLimitUseCase {
+	return &SetOverdraftLimitUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+func (us *SetOverdraftLimitUseCase) Handle(number string, limit int64) error {
+	return us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		err = acc.SetOverdraftLimit(limit)
+		if err != nil {
+			slog.Info("invalid overdraft limit", "error", err, "number", number, "limit", limit)
+			return err
+		}
+
+		err = uow.AccountRepository().UpdateAccountOverdraftLimit(acc)
+		if err != nil {
+			slog.Error("error updating account overdraft limit", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewOverdraftLimitChanged(acc.Number, acc.OverdraftLimit))
+		if err != nil {
+			slog.Error("error adding overdraft limit changed event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("account overdraft limit changed", "number", number, "limit", limit)
+
+		return nil
+	})
+}
@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetOverdraftLimitUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewSetOverdraftLimitUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountOverdraftLimit", acc).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "OverdraftLimitChanged" && message.Data == `{"number":"1","overdraftLimit":500}`
+	})).Return(nil)
+
+	// act
+	err := useCase.Handle(acc.Number, 500)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), acc.OverdraftLimit)
+
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestSetOverdraftLimitUseCase_Handle_InvalidLimit(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewSetOverdraftLimitUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+	acc.Balance = -200
+	acc.OverdraftLimit = 300
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	// act
+	err := useCase.Handle(acc.Number, 100)
+
+	// assert
+	assert.Equal(t, domain.ErrInvalidOverdraftLimit, err)
+	assert.Equal(t, int64(300), acc.OverdraftLimit)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountOverdraftLimit", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestSetOverdraftLimitUseCase_Handle_AccountNotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewSetOverdraftLimitUseCase(mockRepo)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"1"}).Return(map[string]*domain.Account{}, nil)
+
+	// act
+	err := useCase.Handle("1", 500)
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "account not found", err.Error())
+}
+
+func TestSetOverdraftLimitUseCase_Handle_ErrorUpdatingLimit(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewSetOverdraftLimitUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountOverdraftLimit", acc).Return(errors.New("update error"))
+
+	// act
+	err := useCase.Handle(acc.Number, 500)
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "update error", err.Error())
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
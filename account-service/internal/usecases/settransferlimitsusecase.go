@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type SetTransferLimitsUseCaseInterface interface {
+	Handle(number string, limits domain.TransferLimits) error
+}
+
+type SetTransferLimitsUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewSetTransferLimitsUseCase(accountRepository repositories.AccountRepositoryInterface) *SetTransferLimitsUseCase {
+	return &SetTransferLimitsUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+func (us *SetTransferLimitsUseCase) Handle(number string, limits domain.TransferLimits) error {
+	return us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		previous := acc.TransferLimits
+
+		err = acc.SetTransferLimits(limits)
+		if err != nil {
+			slog.Info("invalid transfer limits", "error", err, "number", number)
+			return err
+		}
+
+		err = uow.AccountRepository().UpdateAccountTransferLimits(acc)
+		if err != nil {
+			slog.Error("error updating account transfer limits", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewTransferLimitsChanged(acc.Number,
+			limits.PerTransaction, limits.Daily, limits.Nightly,
+			previous.PerTransaction, previous.Daily, previous.Nightly))
+		if err != nil {
+			slog.Error("error adding transfer limits changed event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("account transfer limits changed", "number", number,
+			"perTransaction", limits.PerTransaction, "daily", limits.Daily, "nightly", limits.Nightly)
+
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetTransferLimitsUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewSetTransferLimitsUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+	acc.TransferLimits = domain.TransferLimits{Daily: 1000}
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountTransferLimits", acc).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferLimitsChanged" &&
+			message.Data == `{"number":"19","perTransaction":100,"daily":500,"nightly":50,"previousPerTransaction":0,"previousDaily":1000,"previousNightly":0}`
+	})).Return(nil)
+
+	// act
+	err := useCase.Handle(acc.Number, domain.TransferLimits{PerTransaction: 100, Daily: 500, Nightly: 50})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), acc.TransferLimits.Daily)
+
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestSetTransferLimitsUseCase_Handle_InvalidLimits(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewSetTransferLimitsUseCase(mockRepo)
+
+	acc := domain.NewAccount("19", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	// act
+	err := useCase.Handle(acc.Number, domain.TransferLimits{PerTransaction: 600, Daily: 500})
+
+	// assert
+	assert.Equal(t, domain.ErrInvalidTransferLimits, err)
+	mockRepo.AssertNotCalled(t, "UpdateAccountTransferLimits", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestSetTransferLimitsUseCase_Handle_AccountNotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewSetTransferLimitsUseCase(mockRepo)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"19"}).Return(map[string]*domain.Account{}, nil)
+
+	// act
+	err := useCase.Handle("19", domain.TransferLimits{Daily: 500})
+
+	// assert
+	assert.Equal(t, "account not found", err.Error())
+}
@@ -3,127 +3,481 @@ package usecases
 import (
 	"errors"
 	"log/slog"
+	"net/http"
+	"time"
 
-	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/infrastructure/broker"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
 )
 
+const transferOperation = "transfer"
+
 type TransferAccountUseCaseInterface interface {
-	Handle(fromNumber string, toNumber string, value int64, idempotencyKey string) error
+	Handle(fromNumber string, toNumber string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error)
+	HandleToPixKey(fromNumber string, pixKey string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error)
+	HandleToPayee(fromNumber string, payeeId string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error)
+	ExecuteScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer, requestedBy string) (*domain.IdempotencyKey, error)
 }
 
 type TransferAccountUseCase struct {
-	accountRepository         repositories.AccountRepositoryInterface
-	broker                    broker.BrokerInterface
-	idempotencyKeysRepository repositories.IdempotencyKeysRepositoryInterface
+	accountRepository     repositories.AccountRepositoryInterface
+	pixKeysRepository     repositories.PixKeysRepositoryInterface
+	payeesRepository      repositories.PayeesRepositoryInterface
+	defaultTransferLimits domain.DefaultTransferLimits
+	approvalPolicy        domain.TransferApprovalPolicy
+	payeePolicy           domain.PayeePolicy
+	riskRules             domain.RiskRules
+	fxRates               *domain.FxRates
+	feeSchedule           *domain.FeeSchedule
+}
+
+type transferRequest struct {
+	ToNumber string          `json:"toNumber,omitempty"`
+	PixKey   string          `json:"pixKey,omitempty"`
+	Value    int64           `json:"value"`
+	Currency domain.Currency `json:"currency,omitempty"`
+}
+
+type transferPendingApprovalResponse struct {
+	TransferRequestId string                       `json:"transferRequestId"`
+	Status            domain.TransferRequestStatus `json:"status"`
+	ExpiresAt         time.Time                    `json:"expiresAt"`
 }
 
 func NewTransferAccountUseCase(
 	accountRepository repositories.AccountRepositoryInterface,
-	broker broker.BrokerInterface,
-	idempotencyKeysRepository repositories.IdempotencyKeysRepositoryInterface) *TransferAccountUseCase {
+	pixKeysRepository repositories.PixKeysRepositoryInterface,
+	payeesRepository repositories.PayeesRepositoryInterface,
+	defaultTransferLimits domain.DefaultTransferLimits,
+	approvalPolicy domain.TransferApprovalPolicy,
+	payeePolicy domain.PayeePolicy,
+	riskRules domain.RiskRules,
+	fxRates *domain.FxRates,
+	feeSchedule *domain.FeeSchedule) *TransferAccountUseCase {
 	return &TransferAccountUseCase{
-		accountRepository:         accountRepository,
-		broker:                    broker,
-		idempotencyKeysRepository: idempotencyKeysRepository,
+		accountRepository:     accountRepository,
+		pixKeysRepository:     pixKeysRepository,
+		payeesRepository:      payeesRepository,
+		defaultTransferLimits: defaultTransferLimits,
+		approvalPolicy:        approvalPolicy,
+		payeePolicy:           payeePolicy,
+		riskRules:             riskRules,
+		fxRates:               fxRates,
+		feeSchedule:           feeSchedule,
 	}
 }
 
-func (us *TransferAccountUseCase) Handle(fromNumber string, toNumber string, value int64, idempotencyKey string) error {
-	hasKey, err := us.idempotencyKeysRepository.HasKey(idempotencyKey)
+// WithUnitOfWork returns a use case whose transfers join the transaction of uow instead of
+// committing on their own, so several transfers can be committed or rolled back together.
+func (us *TransferAccountUseCase) WithUnitOfWork(uow repositories.UnitOfWorkInterface) *TransferAccountUseCase {
+	return NewTransferAccountUseCase(uow.AccountRepository(), us.pixKeysRepository, us.payeesRepository, us.defaultTransferLimits, us.approvalPolicy, us.payeePolicy, us.riskRules, us.fxRates, us.feeSchedule)
+}
+
+// ExecuteTransferRequest executes an approved transfer request inside the transaction of uow,
+// without asking for approval nor screening it again, the payee policy was checked when the
+// transfer was requested.
+func (us *TransferAccountUseCase) ExecuteTransferRequest(uow repositories.UnitOfWorkInterface, pending *domain.TransferRequest) (*domain.IdempotencyKey, error) {
+	executor := NewTransferAccountUseCase(uow.AccountRepository(), us.pixKeysRepository, us.payeesRepository, us.defaultTransferLimits, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, us.fxRates, us.feeSchedule)
+
+	request := transferRequest{ToNumber: pending.ToNumber, Value: pending.Value}
+	if pending.PixKey != "" {
+		request = transferRequest{PixKey: pending.PixKey, Value: pending.Value}
+	}
+
+	key, err := domain.NewSystemIdempotencyKey(pending.FromNumber, pending.ExecutionIdempotencyKey(), transferOperation, request)
 	if err != nil {
-		slog.Error("Error getting idempotencyKey", "error", err)
-		return err
+		return nil, err
+	}
+
+	return executor.transfer(pending.FromNumber, pending.ToNumber, request, key, pending.RequestedBy)
+}
+
+// TransferCapturedHold transfers the value captured from hold to toNumber inside the transaction
+// of uow, between accounts the capture already locked, through the same limits, payee policy,
+// risk screening and fees as any other transfer, and returns the id of its ledger transaction.
+// The captured funds can not wait for an approval, so captures above the approval threshold or
+// flagged for review fail with ErrCaptureRequiresApproval. Captures blocked by the risk screening
+// fail with ErrOperationBlocked after only recording the assessment, which the capture commits.
+func (us *TransferAccountUseCase) TransferCapturedHold(uow repositories.UnitOfWorkInterface, accounts map[string]*domain.Account, hold *domain.Hold, toNumber string) (string, error) {
+	if hold.AccountNumber == toNumber {
+		slog.Info("transfer not allowed", "error", domain.ErrTransferToSameAccount, "fromNumber", hold.AccountNumber)
+		return "", domain.ErrTransferToSameAccount
+	}
+
+	request := transferRequest{ToNumber: toNumber, Value: hold.CapturedAmount}
+
+	key, err := domain.NewSystemIdempotencyKey(hold.AccountNumber, hold.CaptureIdempotencyKey(), transferOperation, request)
+	if err != nil {
+		return "", err
+	}
+
+	outcome, transactionId, blocked, err := us.transferLockedAccounts(uow, accounts, hold.AccountNumber, toNumber, request, key, "")
+	if err != nil {
+		return "", err
+	}
+
+	if blocked {
+		return "", domain.ErrOperationBlocked
+	}
+
+	if outcome.StatusCode == http.StatusAccepted {
+		slog.Info("hold capture not allowed", "error", domain.ErrCaptureRequiresApproval, "number", hold.AccountNumber, "holdId", hold.Id)
+		return "", domain.ErrCaptureRequiresApproval
+	}
+
+	return transactionId, nil
+}
+
+// ExecuteScheduledTransfer transfers the pending occurrence of a scheduled transfer. Its key is
+// reserved to the occurrence, so a key sent by a client can never make the occurrence a replay.
+func (us *TransferAccountUseCase) ExecuteScheduledTransfer(scheduledTransfer *domain.ScheduledTransfer, requestedBy string) (*domain.IdempotencyKey, error) {
+	request := transferRequest{ToNumber: scheduledTransfer.ToNumber, Value: scheduledTransfer.Value}
+
+	key, err := domain.NewSystemIdempotencyKey(scheduledTransfer.FromNumber, scheduledTransfer.OccurrenceIdempotencyKey(), transferOperation, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return us.transfer(scheduledTransfer.FromNumber, scheduledTransfer.ToNumber, request, key, requestedBy)
+}
+
+// Handle transfers value between the accounts and returns the outcome recorded for the idempotency key.
+// A retry with the same key and request returns the outcome of the first execution. Transfers above
+// the approval threshold or flagged for review by the risk screening only create a transfer request,
+// answered with 202 Accepted, and transfers blocked by the risk screening fail with ErrOperationBlocked.
+// The value is in the currency of the sender and converted with the fx rates when the receiver holds
+// another currency.
+func (us *TransferAccountUseCase) Handle(fromNumber string, toNumber string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	return us.transferWithClientKey(fromNumber, toNumber, transferRequest{ToNumber: toNumber, Value: value.Amount, Currency: value.Currency}, idempotencyKey, requestedBy)
+}
+
+// HandleToPixKey transfers value to the account that registered pixKey. The idempotency key
+// is bound to the pix key, not to the account it resolves to.
+func (us *TransferAccountUseCase) HandleToPixKey(fromNumber string, pixKey string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	pixKey = domain.NormalizePixKey(pixKey)
+
+	resolved, err := us.pixKeysRepository.GetPixKey(pixKey)
+	if err != nil {
+		slog.Error("error getting pix key", "error", err)
+		return nil, err
+	}
+
+	if resolved == nil {
+		slog.Info("pix key not found", "fromNumber", fromNumber)
+		return nil, errors.New("pix key not found")
+	}
+
+	return us.transferWithClientKey(fromNumber, resolved.AccountNumber, transferRequest{PixKey: resolved.Key, Value: value.Amount, Currency: value.Currency}, idempotencyKey, requestedBy)
+}
+
+// HandleToPayee transfers value to a payee saved by the sender, to its pix key or account
+// number like a transfer addressed to them directly.
+func (us *TransferAccountUseCase) HandleToPayee(fromNumber string, payeeId string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	payee, err := us.payeesRepository.GetPayee(fromNumber, payeeId)
+	if err != nil {
+		slog.Error("error getting payee", "error", err)
+		return nil, err
+	}
+
+	if payee == nil {
+		slog.Info("payee not found", "fromNumber", fromNumber, "payeeId", payeeId)
+		return nil, errors.New("payee not found")
 	}
 
-	if hasKey {
-		slog.Error("idempotency key already processed", "idempotencyKey", idempotencyKey)
-		return errors.New("idempotency key already processed")
+	if payee.PixKey != "" {
+		return us.HandleToPixKey(fromNumber, payee.PixKey, value, idempotencyKey, requestedBy)
 	}
 
-	fromAcc, err := us.accountRepository.GetAccountByNumber(fromNumber)
+	return us.Handle(fromNumber, payee.ToNumber, value, idempotencyKey, requestedBy)
+}
+
+func (us *TransferAccountUseCase) transferWithClientKey(fromNumber string, toNumber string, request transferRequest, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	key, err := domain.NewIdempotencyKey(fromNumber, requestedBy, idempotencyKey, transferOperation, request)
 	if err != nil {
-		slog.Error("Error getting from account by document", "error", err)
+		slog.Info("invalid idempotency key", "error", err)
+		return nil, err
+	}
+
+	return us.transfer(fromNumber, toNumber, request, key, requestedBy)
+}
+
+func (us *TransferAccountUseCase) transfer(fromNumber string, toNumber string, request transferRequest, key *domain.IdempotencyKey, requestedBy string) (*domain.IdempotencyKey, error) {
+	outcome, _, err := us.transferWithTransactionId(fromNumber, toNumber, request, key, requestedBy)
+
+	return outcome, err
+}
+
+// transferWithTransactionId transfers like transfer and also returns the id of the ledger
+// transaction, empty when the transfer was replayed or is pending approval.
+func (us *TransferAccountUseCase) transferWithTransactionId(fromNumber string, toNumber string, request transferRequest, key *domain.IdempotencyKey, requestedBy string) (*domain.IdempotencyKey, string, error) {
+	if fromNumber == toNumber {
+		slog.Info("transfer not allowed", "error", domain.ErrTransferToSameAccount, "fromNumber", fromNumber)
+		return nil, "", domain.ErrTransferToSameAccount
+	}
+
+	var outcome *domain.IdempotencyKey
+	var transactionId string
+	blocked := false
+	err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(fromNumber, toNumber)
+		if err != nil {
+			slog.Error("Error getting accounts by number", "error", err)
+			return err
+		}
+
+		outcome, transactionId, blocked, err = us.transferLockedAccounts(uow, accounts, fromNumber, toNumber, request, key, requestedBy)
+
 		return err
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if blocked {
+		return nil, "", domain.ErrOperationBlocked
 	}
 
+	return outcome, transactionId, nil
+}
+
+// transferLockedAccounts transfers between accounts already locked in the transaction of uow. A
+// transfer blocked by the risk screening returns blocked, having only recorded the assessment, so
+// the caller can commit the decision and fail with ErrOperationBlocked.
+func (us *TransferAccountUseCase) transferLockedAccounts(
+	uow repositories.UnitOfWorkInterface,
+	accounts map[string]*domain.Account,
+	fromNumber string,
+	toNumber string,
+	request transferRequest,
+	key *domain.IdempotencyKey,
+	requestedBy string) (*domain.IdempotencyKey, string, bool, error) {
+	value := domain.NewMoney(request.Value, request.Currency)
+
+	fromAcc := accounts[fromNumber]
 	if fromAcc == nil {
 		slog.Info("from account not found", "fromNumber", fromNumber)
-		return errors.New("from account not found")
+		return nil, "", false, errors.New("from account not found")
 	}
 
-	toAcc, err := us.accountRepository.GetAccountByNumber(toNumber)
-	if err != nil {
-		slog.Error("Error getting to account by document", "error", err)
-		return err
+	outcome, err := getRecordedOutcome(uow.IdempotencyKeysRepository(), key)
+	if err != nil || outcome != nil {
+		return outcome, "", false, err
 	}
 
+	toAcc := accounts[toNumber]
 	if toAcc == nil {
 		slog.Info("to account not found", "toNumber", toNumber)
-		return errors.New("to account not found")
+		return nil, "", false, errors.New("to account not found")
 	}
 
-	fromAcc.Transfer(value, toAcc)
+	err = fromAcc.EnsureActive()
+	if err != nil {
+		slog.Info("account not active", "number", fromAcc.Number, "status", fromAcc.Status)
+		return nil, "", false, err
+	}
 
-	err = us.accountRepository.UpdateAccountBalance(toAcc)
+	err = toAcc.EnsureActive()
 	if err != nil {
-		slog.Error("Error updating to account balance", "error", err)
-		return err
+		slog.Info("account not active", "number", toAcc.Number, "status", toAcc.Status)
+		return nil, "", false, err
+	}
+
+	value = value.OrCurrency(fromAcc.Currency)
+	if value.Currency != fromAcc.Currency {
+		slog.Info("transfer not allowed", "error", domain.ErrCurrencyMismatch, "fromNumber", fromNumber, "currency", value.Currency)
+		return nil, "", false, domain.ErrCurrencyMismatch
+	}
+
+	received, err := us.fxRates.Convert(value, toAcc.Currency)
+	if err != nil {
+		slog.Info("transfer not allowed", "error", err, "fromNumber", fromNumber, "toNumber", toNumber)
+		return nil, "", false, err
+	}
+
+	err = checkTransferLimits(uow.LedgerRepository(), fromAcc, us.defaultTransferLimits, value.Amount, time.Now())
+	if err != nil {
+		slog.Info("transfer not allowed", "error", err, "fromNumber", fromNumber, "toNumber", toNumber)
+		return nil, "", false, err
+	}
+
+	err = us.checkPayeePolicy(fromNumber, toNumber, request, time.Now())
+	if err != nil {
+		slog.Info("transfer not allowed", "error", err, "fromNumber", fromNumber, "toNumber", toNumber)
+		return nil, "", false, err
+	}
+
+	assessment, err := screenRisk(uow, us.riskRules, domain.RiskOperation{
+		Type:               domain.RiskOperationTransfer,
+		AccountNumber:      fromAcc.Number,
+		CounterpartyNumber: toAcc.Number,
+		Value:              value.Amount,
+	}, time.Now())
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	if assessment.Decision == domain.RiskDecisionBlock {
+		slog.Info("transfer blocked by risk screening", "fromNumber", fromNumber, "toNumber", toNumber, "rule", assessment.Rule)
+		return nil, "", true, nil
+	}
+
+	if assessment.Decision == domain.RiskDecisionReview || us.approvalPolicy.RequiresApproval(value.Amount) {
+		err = us.requestApproval(uow, key, fromAcc, toAcc, request, requestedBy)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		return key, "", false, nil
+	}
+
+	wasInOverdraft := fromAcc.InOverdraft()
+
+	fee, err := us.feeSchedule.Fee(domain.FeeOperationTransfer, value)
+	if err != nil {
+		slog.Info("transfer fee not computed", "error", err, "fromNumber", fromNumber)
+		return nil, "", false, err
+	}
+
+	if fee.Amount > 0 && value.Amount+fee.Amount > fromAcc.AvailableBalance() {
+		slog.Info("transfer not allowed", "error", domain.ErrInsufficientFunds, "fromNumber", fromNumber, "fee", fee)
+		return nil, "", false, domain.ErrInsufficientFunds
+	}
+
+	err = fromAcc.TransferConverted(value, toAcc, received)
+	if err != nil {
+		slog.Info("transfer not allowed", "error", err, "fromNumber", fromNumber, "toNumber", toNumber)
+		return nil, "", false, err
+	}
+
+	if fee.Amount > 0 {
+		err = fromAcc.ChargeFee(fee)
+		if err != nil {
+			return nil, "", false, err
+		}
 	}
 
-	err = us.accountRepository.UpdateAccountBalance(fromAcc)
+	err = uow.AccountRepository().UpdateAccountBalance(fromAcc)
 	if err != nil {
 		slog.Error("Error updating from account balance", "error", err)
-		return err
+		return nil, "", false, err
 	}
 
-	err = us.produceEventTransferRealized(fromAcc.Number, toAcc.Number, value, fromAcc.Balance)
+	err = uow.AccountRepository().UpdateAccountBalance(toAcc)
 	if err != nil {
-		slog.Error("error producing event transfer realized", "error", err)
-		return err
+		slog.Error("Error updating to account balance", "error", err)
+		return nil, "", false, err
 	}
 
-	err = us.produceEventTransferReceived(toAcc.Number, fromAcc.Number, value, fromAcc.Balance)
+	ledgerTransaction := domain.NewConvertedTransferLedgerTransaction(fromAcc.Number, toAcc.Number, value, received)
+	err = uow.LedgerRepository().CreateTransaction(ledgerTransaction)
 	if err != nil {
-		slog.Error("error producing event transfer received", "error", err)
-		return err
+		slog.Error("error creating ledger transaction", "error", err)
+		return nil, "", false, err
 	}
 
-	err = us.idempotencyKeysRepository.CreateKey(idempotencyKey)
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewTransferRealized(fromAcc.Number, toAcc.Number, value, fromAcc.Money(fromAcc.Balance), ledgerTransaction.Id, request.PixKey))
 	if err != nil {
-		slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", idempotencyKey)
-		return err
+		slog.Error("error adding transfer realized event to outbox", "error", err)
+		return nil, "", false, err
 	}
 
-	slog.Info("Transfer realized", "fromAccNumber", fromAcc.Number, "toAccNumber", toAcc.Number, "idempotencyKey", idempotencyKey)
-	return nil
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewTransferReceived(toAcc.Number, fromAcc.Number, received, toAcc.Money(toAcc.Balance), ledgerTransaction.Id))
+	if err != nil {
+		slog.Error("error adding transfer received event to outbox", "error", err)
+		return nil, "", false, err
+	}
+
+	if fee.Amount > 0 {
+		err = recordFee(uow, fromAcc, domain.FeeOperationTransfer, fee, ledgerTransaction.Id)
+		if err != nil {
+			return nil, "", false, err
+		}
+	}
+
+	err = addEnteredOverdraftEventToOutbox(uow.OutboxRepository(), fromAcc, wasInOverdraft)
+	if err != nil {
+		slog.Error("error adding account entered overdraft event to outbox", "error", err)
+		return nil, "", false, err
+	}
+
+	err = key.SetResponse(http.StatusNoContent, nil)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	err = uow.IdempotencyKeysRepository().CreateKey(key)
+	if err != nil {
+		slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", key.Key)
+		return nil, "", false, err
+	}
+
+	slog.Info("Transfer realized", "fromAccNumber", fromAcc.Number, "toAccNumber", toAcc.Number, "idempotencyKey", key.Key)
+
+	return key, ledgerTransaction.Id, false, nil
 }
 
-func (us *TransferAccountUseCase) produceEventTransferRealized(fromNumber string, toNumber string, value int64, fromBalance int64) error {
-	transferRealizedEvent, err := events.NewEventPublish(events.NewTransferRealized(fromNumber, toNumber, value, fromBalance))
+// requestApproval records a transfer request instead of moving the money, the transfer is
+// executed once another user approves it.
+func (us *TransferAccountUseCase) requestApproval(
+	uow repositories.UnitOfWorkInterface,
+	key *domain.IdempotencyKey,
+	fromAcc *domain.Account,
+	toAcc *domain.Account,
+	request transferRequest,
+	requestedBy string) error {
+	pending := domain.NewTransferRequest(fromAcc.Number, toAcc.Number, request.PixKey, request.Value, requestedBy, us.approvalPolicy.TTL)
+
+	_, err := uow.TransferRequestsRepository().CreateTransferRequest(pending)
 	if err != nil {
+		slog.Error("error creating transfer request", "error", err)
 		return err
 	}
 
-	err = us.broker.Produce(transferRealizedEvent, &broker.ProduceConfigs{Topic: "account"})
+	err = addEventToOutbox(uow.OutboxRepository(), events.NewTransferPendingApproval(pending.Id, pending.FromNumber, pending.ToNumber,
+		pending.Value, pending.PixKey, pending.ExpiresAt))
 	if err != nil {
+		slog.Error("error adding transfer pending approval event to outbox", "error", err)
 		return err
 	}
 
-	return nil
-}
+	err = key.SetResponse(http.StatusAccepted, transferPendingApprovalResponse{
+		TransferRequestId: pending.Id,
+		Status:            pending.Status,
+		ExpiresAt:         pending.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
 
-func (us *TransferAccountUseCase) produceEventTransferReceived(toNumber string, fromNumber string, value int64, toBalance int64) error {
-	transferReceivedEvent, err := events.NewEventPublish(events.NewTransferReceived(toNumber, fromNumber, value, toBalance))
+	err = uow.IdempotencyKeysRepository().CreateKey(key)
 	if err != nil {
+		slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", key.Key)
 		return err
 	}
 
-	err = us.broker.Produce(transferReceivedEvent, &broker.ProduceConfigs{Topic: "account"})
+	slog.Info("Transfer pending approval", "fromAccNumber", fromAcc.Number, "toAccNumber", toAcc.Number, "transferRequestId", pending.Id)
+
+	return nil
+}
+
+// checkPayeePolicy rejects transfers above the payee policy limit unless the destination is a
+// payee of the sender registered before the cooling off period.
+func (us *TransferAccountUseCase) checkPayeePolicy(fromNumber string, toNumber string, request transferRequest, now time.Time) error {
+	if !us.payeePolicy.RequiresPayee(request.Value) {
+		return nil
+	}
+
+	payees, err := us.payeesRepository.GetPayeesByAccount(fromNumber)
 	if err != nil {
+		slog.Error("error getting payees by account", "error", err)
 		return err
 	}
 
+	if !domain.HasTrustedPayee(payees, us.payeePolicy, toNumber, request.PixKey, now) {
+		return domain.ErrPayeeRequired
+	}
+
 	return nil
 }
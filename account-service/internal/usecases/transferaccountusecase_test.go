@@ -2,7 +2,9 @@ package usecases
 
 import (
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
@@ -11,289 +13,1131 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-func TestTransferAccountUseCase_Handle_ErrorGettingFromAccount(t *testing.T) {
+func TestTransferAccountUseCase_Handle_ErrorGettingAccounts(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
 
-	mockRepo.On("GetAccountByNumber", "123").Return((*domain.Account)(nil), errors.New("generic error"))
-
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account(nil), errors.New("generic error"))
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
-
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
 	assert.Error(t, err)
 	assert.Equal(t, "generic error", err.Error())
+
 	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_SameAccount(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	// act
+	outcome, err := useCase.Handle("123", "123", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.Equal(t, domain.ErrTransferToSameAccount, err)
+	assert.Nil(t, outcome)
+
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
+
+func TestTransferAccountUseCase_HandleToPixKey_OwnAccount(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, mockPixKeysRepository, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	mockPixKeysRepository.On("GetPixKey", "john@mail.com").Return(&domain.PixKey{Key: "john@mail.com", AccountNumber: "123"}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	// act
+	_, err := useCase.HandleToPixKey("123", "john@mail.com", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.Equal(t, domain.ErrTransferToSameAccount, err)
+
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_ReservedIdempotencyKey(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	// act
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, "system:scheduled-5-1", "user-1")
+
+	// assert
+	assert.Equal(t, domain.ErrReservedIdempotencyKey, err)
+
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
+
+func TestTransferAccountUseCase_ExecuteScheduledTransfer_UsesSystemKey(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	scheduledTransfer := &domain.ScheduledTransfer{Id: "5", FromNumber: "123", ToNumber: "456", Value: 100}
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+	mockIdempotencyRepository.On("GetKey", "123", "", "system:scheduled-5-1").Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.MatchedBy(func(key *domain.IdempotencyKey) bool {
+		return key.Key == "system:scheduled-5-1" && key.Scope == "123" && key.Operation == "transfer"
+	})).Return(nil)
+
+	// act
+	outcome, err := useCase.ExecuteScheduledTransfer(scheduledTransfer, "scheduler")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(50), fromAcc.Balance)
+
+	mockIdempotencyRepository.AssertExpectations(t)
 }
 
 func TestTransferAccountUseCase_Handle_FromAccountNotFound(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
 
-	mockRepo.On("GetAccountByNumber", "123").Return((*domain.Account)(nil), nil)
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"456": toAcc}, nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
-
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
 	assert.Error(t, err)
 	assert.Equal(t, "from account not found", err.Error())
+
 	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
 }
 
-func TestTransferAccountUseCase_Handle_ErrorGettingToAccount(t *testing.T) {
+func TestTransferAccountUseCase_Handle_ToAccountNotFound(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
 
 	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
-	mockRepo.On("GetAccountByNumber", "123").Return(fromAcc, nil)
-	mockRepo.On("GetAccountByNumber", "456").Return((*domain.Account)(nil), errors.New("generic error"))
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc}, nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
 
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
 	assert.Error(t, err)
-	assert.Equal(t, "generic error", err.Error())
+	assert.Equal(t, "to account not found", err.Error())
+
 	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
 }
 
-func TestTransferAccountUseCase_Handle_ToAccountNotFound(t *testing.T) {
+func TestTransferAccountUseCase_Handle_InsufficientFunds(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
 
 	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
-	mockRepo.On("GetAccountByNumber", "123").Return(fromAcc, nil)
-	mockRepo.On("GetAccountByNumber", "456").Return((*domain.Account)(nil), nil)
+	fromAcc.Balance = 50
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
 
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
-	assert.Error(t, err)
-	assert.Equal(t, "to account not found", err.Error())
+	assert.Equal(t, domain.ErrInsufficientFunds, err)
+	assert.Equal(t, int64(50), fromAcc.Balance)
+	assert.Equal(t, int64(0), toAcc.Balance)
+
 	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
 }
 
 func TestTransferAccountUseCase_Handle_ErrorUpdatingToAccountBalance(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
 
 	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 100
 	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
-	mockRepo.On("GetAccountByNumber", "123").Return(fromAcc, nil)
-	mockRepo.On("GetAccountByNumber", "456").Return(toAcc, nil)
 
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", fromAcc).Return(nil)
 	mockRepo.On("UpdateAccountBalance", toAcc).Return(errors.New("update error"))
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
-
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
 
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
 	assert.Error(t, err)
 	assert.Equal(t, "update error", err.Error())
+
 	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
 }
 
 func TestTransferAccountUseCase_Handle_ErrorUpdatingFromAccountBalance(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
 
 	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 100
 	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
-	mockRepo.On("GetAccountByNumber", "123").Return(fromAcc, nil)
-	mockRepo.On("GetAccountByNumber", "456").Return(toAcc, nil)
 
-	mockRepo.On("UpdateAccountBalance", toAcc).Return(nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
 	mockRepo.On("UpdateAccountBalance", fromAcc).Return(errors.New("update error"))
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
-
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
 
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
 	assert.Error(t, err)
 	assert.Equal(t, "update error", err.Error())
+
 	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", toAcc)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_ErrorStartingTransaction(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(nil, errors.New("begin error"))
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	// act
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "begin error", err.Error())
+
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
 }
 
 func TestTransferAccountUseCase_Handle_Success(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
 
 	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
 	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
-	mockRepo.On("GetAccountByNumber", "123").Return(fromAcc, nil)
-	mockRepo.On("GetAccountByNumber", "456").Return(toAcc, nil)
 
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
 	mockRepo.On("UpdateAccountBalance", toAcc).Return(nil)
 	mockRepo.On("UpdateAccountBalance", fromAcc).Return(nil)
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == "transfer" && transaction.Validate() == nil &&
+			transaction.Entries[0].AccountNumber == "123" && transaction.Entries[0].Amount == -100 &&
+			transaction.Entries[1].AccountNumber == "456" && transaction.Entries[1].Amount == 100
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "77"
+	}).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferRealized" && message.Data == `{"fromNumber":"123","toNumber":"456","value":{"amount":100,"currency":"BRL"},"balance":{"amount":50,"currency":"BRL"},"transferId":"77"}`
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferReceived" && message.Data == `{"fromNumber":"456","toNumber":"123","value":{"amount":100,"currency":"BRL"},"balance":{"amount":100,"currency":"BRL"},"transferId":"77"}`
+	})).Return(nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.MatchedBy(func(key *domain.IdempotencyKey) bool {
+		return key.Key == idempotencyKey.String() && key.Scope == "123" && key.Operation == "transfer"
+	})).Return(nil)
 
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
 	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(50), fromAcc.Balance)
+	assert.Equal(t, int64(100), toAcc.Balance)
+
 	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+	mockIdempotencyRepository.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
 }
 
-func TestTransferAccountUseCase_Handle_HasKeyAlreadyUsed(t *testing.T) {
+func TestTransferAccountUseCase_Handle_ChargesFee(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, []domain.FeeRule{{Operation: domain.FeeOperationTransfer, Percentage: "0.01", Min: 5}}, nil, nil)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, feeSchedule)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.TransferLedgerTransaction
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "77"
+	}).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == domain.FeeLedgerTransaction && transaction.Validate() == nil && transaction.OriginTransactionId == "77" &&
+			transaction.Entries[0].AccountNumber == "123" && transaction.Entries[0].Amount == -5
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "78"
+	}).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferRealized" || message.Type == "TransferReceived"
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FeeCharged" &&
+			message.Data == `{"number":"123","operation":"transfer","value":{"amount":5,"currency":"BRL"},"balance":{"amount":45,"currency":"BRL"},"transactionId":"78","originTransactionId":"77"}`
+	})).Return(nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(true, nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
 
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(45), fromAcc.Balance)
+	assert.Equal(t, int64(100), toAcc.Balance)
+
+	mockLedgerRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestTransferAccountUseCase_Handle_InsufficientFundsForFee(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	feeSchedule, _ := domain.NewFeeSchedule(domain.DefaultCurrency, []domain.FeeRule{{Operation: domain.FeeOperationTransfer, Flat: 10}}, nil, nil)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, feeSchedule)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 105
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+	assert.Equal(t, int64(105), fromAcc.Balance)
+	assert.Equal(t, int64(0), toAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockLedgerRepository.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_ErrorSavingIdempotencyKeyUsed(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 100
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", toAcc).Return(nil)
+	mockRepo.On("UpdateAccountBalance", fromAcc).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	errorSavingUsedIdempotencyKey := errors.New("error saving used idempotency key")
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(errorSavingUsedIdempotencyKey)
+
+	// act
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
 	assert.Error(t, err)
-	assert.Equal(t, err, errors.New("idempotency key already processed"))
+	assert.Equal(t, err, errorSavingUsedIdempotencyKey)
 
 	mockRepo.AssertExpectations(t)
-	mockBroker.AssertExpectations(t)
 	mockIdempotencyRepository.AssertExpectations(t)
 }
 
-func TestTransferAccountUseCase_Handle_HasKeyGenericError(t *testing.T) {
+func TestTransferAccountUseCase_Handle_IdempotencyKeyReplayed(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 50
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	recorded, _ := domain.NewIdempotencyKey("123", "user-1", idempotencyKey.String(), "transfer", transferRequest{ToNumber: "456", Value: 100})
+	_ = recorded.SetResponse(http.StatusNoContent, nil)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return(recorded, nil)
+
+	// act
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, outcome)
+	assert.Equal(t, int64(50), fromAcc.Balance)
+	assert.Equal(t, int64(0), toAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_IdempotencyKeyReusedWithDifferentRequest(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	recorded, _ := domain.NewIdempotencyKey("123", "user-1", idempotencyKey.String(), "transfer", transferRequest{ToNumber: "789", Value: 100})
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return(recorded, nil)
+
+	// act
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrIdempotencyKeyReused)
+	assert.Nil(t, outcome)
+	assert.Equal(t, int64(150), fromAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_GetKeyError(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
 	genericError := errors.New("generic error")
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, genericError)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), genericError)
 
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
 	assert.Error(t, err)
 	assert.Equal(t, err, genericError)
 
 	mockRepo.AssertExpectations(t)
-	mockBroker.AssertExpectations(t)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
 	mockIdempotencyRepository.AssertExpectations(t)
 }
 
-func TestTransferAccountUseCase_Handle_SuccessErrorSavingIdempotencyKeyUsed(t *testing.T) {
+func TestTransferAccountUseCase_Handle_ToAccountNotActive(t *testing.T) {
 	// arrange
 	mockRepo := new(usecases_mock.MockAccountRepository)
-	mockBroker := new(usecases_mock.MockBroker)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
 	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
 
-	useCase := NewTransferAccountUseCase(mockRepo, mockBroker, mockIdempotencyRepository)
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
 
 	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
 	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
-	mockRepo.On("GetAccountByNumber", "123").Return(fromAcc, nil)
-	mockRepo.On("GetAccountByNumber", "456").Return(toAcc, nil)
+	toAcc.Status = domain.AccountStatusClosed
 
-	mockRepo.On("UpdateAccountBalance", toAcc).Return(nil)
-	mockRepo.On("UpdateAccountBalance", fromAcc).Return(nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
 
-	mockBroker.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	// act
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.Equal(t, domain.ErrAccountNotActive, err)
+	assert.Equal(t, int64(150), fromAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestTransferAccountUseCase_HandleToPixKey_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, mockPixKeysRepository, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockPixKeysRepository.On("GetPixKey", "jane@mail.com").Return(&domain.PixKey{AccountNumber: "456", Type: domain.PixKeyEmail, Key: "jane@mail.com"}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.LedgerTransaction).Id = "77"
+	}).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferRealized" &&
+			message.Data == `{"fromNumber":"123","toNumber":"456","value":{"amount":100,"currency":"BRL"},"balance":{"amount":50,"currency":"BRL"},"transferId":"77","pixKey":"jane@mail.com"}`
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferReceived"
+	})).Return(nil)
 
 	idempotencyKey, _ := uuid.NewUUID()
 
-	mockIdempotencyRepository.On("HasKey", idempotencyKey.String()).Return(false, nil)
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
 
-	errorSavingUsedIdempotencyKey := errors.New("error saving used idempotency key")
-	mockIdempotencyRepository.On("CreateKey", idempotencyKey.String()).Return(errorSavingUsedIdempotencyKey)
+	// act
+	outcome, err := useCase.HandleToPixKey("123", " Jane@Mail.com ", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(100), toAcc.Balance)
+
+	mockPixKeysRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestTransferAccountUseCase_HandleToPixKey_NotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockPixKeysRepository := new(usecases_mock.MockPixKeysRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, mockPixKeysRepository, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	mockPixKeysRepository.On("GetPixKey", "+5511912345678").Return((*domain.PixKey)(nil), nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
 
 	// act
-	err := useCase.Handle("123", "456", 100, idempotencyKey.String())
+	outcome, err := useCase.HandleToPixKey("123", "+55 (11) 91234-5678", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
 
 	// assert
-	assert.Error(t, err)
-	assert.Equal(t, err, errorSavingUsedIdempotencyKey)
+	assert.Nil(t, outcome)
+	assert.Equal(t, "pix key not found", err.Error())
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
 
-	mockRepo.AssertExpectations(t)
-	mockBroker.AssertExpectations(t)
-	mockIdempotencyRepository.AssertExpectations(t)
+func TestTransferAccountUseCase_Handle_DailyLimitExceeded(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, domain.DefaultTransferLimits{
+		domain.DocumentTypePerson: {PerTransaction: 500, Daily: 1000},
+	}, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 1000
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockLedgerRepository.On("GetOutgoingTransfersTotal", "123", mock.Anything).Return(int64(800), nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 300}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.Nil(t, outcome)
+	assert.Equal(t, &domain.TransferLimitExceededError{Limit: domain.DailyTransferLimit, Available: 200}, err)
+	assert.Equal(t, int64(1000), fromAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_AccountLimitOverridesDefault(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, domain.DefaultTransferLimits{
+		domain.DocumentTypePerson: {PerTransaction: 500},
+	}, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 1000
+	fromAcc.TransferLimits = domain.TransferLimits{PerTransaction: 100}
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, nil, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockLedgerRepository.On("GetOutgoingTransfersTotal", "123", mock.Anything).Return(int64(0), nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 300}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.Equal(t, &domain.TransferLimitExceededError{Limit: domain.PerTransactionTransferLimit, Available: 100}, err)
+}
+
+func TestTransferAccountUseCase_Handle_AboveApprovalThreshold(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{Threshold: 50, TTL: time.Hour}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, nil).
+		WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockTransferRequestsRepository.On("CreateTransferRequest", mock.MatchedBy(func(transferRequest *domain.TransferRequest) bool {
+		return transferRequest.FromNumber == "123" && transferRequest.ToNumber == "456" && transferRequest.Value == 100 &&
+			transferRequest.RequestedBy == "user-1" && transferRequest.Status == domain.TransferRequestPendingApproval
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.TransferRequest).Id = "3"
+	}).Return("3", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferPendingApproval"
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, outcome.StatusCode)
+	assert.Contains(t, outcome.Response, `"transferRequestId":"3","status":"pending_approval"`)
+	assert.Equal(t, int64(150), fromAcc.Balance)
+	assert.Equal(t, int64(0), toAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockTransferRequestsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestTransferAccountUseCase_Handle_BlockedByRiskScreening(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockRiskAssessmentsRepository := new(usecases_mock.MockRiskAssessmentsRepository)
+
+	riskRules := domain.RiskRules{
+		{Name: "transfers-velocity", Type: domain.RiskRuleVelocity, Decision: domain.RiskDecisionBlock, MaxCount: 3, Window: 10 * time.Minute},
+	}
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, riskRules, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository).
+		WithRiskAssessmentsRepository(mockRiskAssessmentsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockLedgerRepository.On("GetOutgoingTransfersTotal", "123", mock.Anything).Return(int64(0), nil).Maybe()
+	mockLedgerRepository.On("CountOutgoingTransfers", "123", mock.Anything).Return(3, nil)
+	mockRiskAssessmentsRepository.On("CreateRiskAssessment", mock.MatchedBy(func(assessment *domain.RiskAssessment) bool {
+		return assessment.Decision == domain.RiskDecisionBlock && assessment.Rule == "transfers-velocity" &&
+			assessment.AccountNumber == "123" && assessment.CounterpartyNumber == "456"
+	})).Return("7", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "SuspiciousActivityDetected"
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.Equal(t, domain.ErrOperationBlocked, err)
+	assert.Nil(t, outcome)
+	assert.Equal(t, int64(150), fromAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+	mockRiskAssessmentsRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestTransferAccountUseCase_Handle_ReviewByRiskScreeningRequestsApproval(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockRiskAssessmentsRepository := new(usecases_mock.MockRiskAssessmentsRepository)
+	mockTransferRequestsRepository := new(usecases_mock.MockTransferRequestsRepository)
+
+	riskRules := domain.RiskRules{
+		{Name: "new-counterparty", Type: domain.RiskRuleNewCounterparty, Decision: domain.RiskDecisionReview},
+	}
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{Threshold: 1000, TTL: time.Hour}, domain.PayeePolicy{}, riskRules, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository).
+		WithRiskAssessmentsRepository(mockRiskAssessmentsRepository).WithTransferRequestsRepository(mockTransferRequestsRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockLedgerRepository.On("HasTransferredTo", "123", "456").Return(false, nil)
+	mockRiskAssessmentsRepository.On("CreateRiskAssessment", mock.MatchedBy(func(assessment *domain.RiskAssessment) bool {
+		return assessment.Decision == domain.RiskDecisionReview && assessment.Rule == "new-counterparty"
+	})).Return("7", nil)
+	mockTransferRequestsRepository.On("CreateTransferRequest", mock.MatchedBy(func(transferRequest *domain.TransferRequest) bool {
+		return transferRequest.FromNumber == "123" && transferRequest.ToNumber == "456" && transferRequest.Value == 100 &&
+			transferRequest.RequestedBy == "user-1"
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.TransferRequest).Id = "3"
+	}).Return("3", nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "SuspiciousActivityDetected" || message.Type == "TransferPendingApproval"
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, outcome.StatusCode)
+	assert.Contains(t, outcome.Response, `"transferRequestId":"3","status":"pending_approval"`)
+	assert.Equal(t, int64(150), fromAcc.Balance)
+	assert.Equal(t, int64(0), toAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNumberOfCalls(t, "CreateMessage", 2)
+	mockTransferRequestsRepository.AssertExpectations(t)
+	mockRiskAssessmentsRepository.AssertExpectations(t)
+}
+
+func TestTransferAccountUseCase_Handle_ConvertsBetweenCurrencies(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	fxRates, _ := domain.NewFxRates([]domain.FxRate{{From: "USD", To: "BRL", Rate: "5.25"}})
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, fxRates, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Currency = "USD"
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Validate() == nil && len(transaction.Entries) == 4 &&
+			transaction.Entries[3].AccountNumber == "456" && transaction.Entries[3].Amount == 525 && transaction.Entries[3].Currency == "BRL"
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferRealized" &&
+			message.Data == `{"fromNumber":"123","toNumber":"456","value":{"amount":100,"currency":"USD"},"balance":{"amount":50,"currency":"USD"},"transferId":""}`
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "TransferReceived" &&
+			message.Data == `{"fromNumber":"456","toNumber":"123","value":{"amount":525,"currency":"BRL"},"balance":{"amount":525,"currency":"BRL"},"transferId":""}`
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle("123", "456", domain.NewMoney(100, "USD"), idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(50), fromAcc.Balance)
+	assert.Equal(t, int64(525), toAcc.Balance)
+
+	mockLedgerRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestTransferAccountUseCase_Handle_NoFxRate(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+	toAcc.Currency = "USD"
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrFxRateNotFound)
+	assert.Equal(t, int64(150), fromAcc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_ValueInAnotherCurrency(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	_, err := useCase.Handle("123", "456", domain.NewMoney(100, "USD"), idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.Equal(t, domain.ErrCurrencyMismatch, err)
+	assert.Equal(t, int64(150), fromAcc.Balance)
+}
+
+func TestTransferAccountUseCase_HandleToPayee_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, mockPayeesRepository, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockPayeesRepository.On("GetPayee", "123", "5").Return(&domain.Payee{Id: "5", AccountNumber: "123", Nickname: "Jane", ToNumber: "456"}, nil)
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
+	// act
+	outcome, err := useCase.HandleToPayee("123", "5", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(100), toAcc.Balance)
+
+	mockPayeesRepository.AssertExpectations(t)
+}
+
+func TestTransferAccountUseCase_HandleToPayee_NotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+
+	useCase := NewTransferAccountUseCase(mockRepo, nil, mockPayeesRepository, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil)
+
+	mockPayeesRepository.On("GetPayee", "123", "5").Return((*domain.Payee)(nil), nil)
+
+	// act
+	_, err := useCase.HandleToPayee("123", "5", domain.Money{Amount: 100}, "key", "user-1")
+
+	// assert
+	assert.Equal(t, "payee not found", err.Error())
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
+
+func TestTransferAccountUseCase_Handle_AbovePayeeLimitRequiresTrustedPayee(t *testing.T) {
+	testCases := []struct {
+		testName string
+		payees   []*domain.Payee
+	}{
+		{"without payee", []*domain.Payee{}},
+		{"payee in cooling off", []*domain.Payee{{ToNumber: "456", CreatedAt: time.Now().Add(-time.Hour)}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			// arrange
+			mockRepo := new(usecases_mock.MockAccountRepository)
+			mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+			mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+
+			policy := domain.PayeePolicy{RequiredAbove: 50, CoolingOff: 24 * time.Hour}
+			useCase := NewTransferAccountUseCase(mockRepo, nil, mockPayeesRepository, nil, domain.TransferApprovalPolicy{}, policy, nil, nil, nil)
+
+			fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+			fromAcc.Balance = 150
+			toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+			mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, nil, nil), nil)
+			mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+			mockPayeesRepository.On("GetPayeesByAccount", "123").Return(tc.payees, nil)
+
+			idempotencyKey, _ := uuid.NewUUID()
+
+			mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+			// act
+			_, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+			// assert
+			assert.ErrorIs(t, err, domain.ErrPayeeRequired)
+			assert.Equal(t, int64(150), fromAcc.Balance)
+			mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+		})
+	}
+}
+
+func TestTransferAccountUseCase_Handle_AbovePayeeLimitToTrustedPayee(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+
+	policy := domain.PayeePolicy{RequiredAbove: 50, CoolingOff: 24 * time.Hour}
+	useCase := NewTransferAccountUseCase(mockRepo, nil, mockPayeesRepository, nil, domain.TransferApprovalPolicy{}, policy, nil, nil, nil)
+
+	fromAcc := domain.NewAccount("123", "01234567890", "John Doe")
+	fromAcc.Balance = 150
+	toAcc := domain.NewAccount("456", "09876543210", "Jane Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"123", "456"}).Return(map[string]*domain.Account{"123": fromAcc, "456": toAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockPayeesRepository.On("GetPayeesByAccount", "123").Return([]*domain.Payee{{ToNumber: "456", CreatedAt: time.Now().Add(-25 * time.Hour)}}, nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", "123", "user-1", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle("123", "456", domain.Money{Amount: 100}, idempotencyKey.String(), "user-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(50), fromAcc.Balance)
 }
@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type TransferBatchUseCaseInterface interface {
+	Handle(batch *domain.TransferBatch) error
+}
+
+type TransferBatchUseCase struct {
+	accountRepository      repositories.AccountRepositoryInterface
+	transferAccountUseCase *TransferAccountUseCase
+	maxLines               int
+	accountNumberPolicy    domain.AccountNumberPolicy
+}
+
+func NewTransferBatchUseCase(
+	accountRepository repositories.AccountRepositoryInterface,
+	transferAccountUseCase *TransferAccountUseCase,
+	maxLines int,
+	accountNumberPolicy domain.AccountNumberPolicy) *TransferBatchUseCase {
+	return &TransferBatchUseCase{
+		accountRepository:      accountRepository,
+		transferAccountUseCase: transferAccountUseCase,
+		maxLines:               maxLines,
+		accountNumberPolicy:    accountNumberPolicy,
+	}
+}
+
+// Handle validates the whole batch and executes its lines through the transfer use case,
+// recording the result of each line in the batch. Every line has its own idempotency key, so
+// sending the batch again replays the lines already executed and retries the others.
+// An error is returned when the batch is invalid and nothing was executed.
+func (us *TransferBatchUseCase) Handle(batch *domain.TransferBatch) error {
+	err := batch.Validate(us.maxLines, us.accountNumberPolicy)
+	if err != nil {
+		slog.Info("invalid transfer batch", "error", err, "fromNumber", batch.FromNumber)
+		return err
+	}
+
+	if batch.Mode == domain.TransferBatchBestEffort {
+		for _, line := range batch.Lines {
+			us.executeLine(us.transferAccountUseCase, batch, line)
+		}
+	} else {
+		err = us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+			transferAccountUseCase := us.transferAccountUseCase.WithUnitOfWork(uow)
+
+			for _, line := range batch.Lines {
+				err := us.executeLine(transferAccountUseCase, batch, line)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		// a line failure is already in the report, other errors mean the commit failed
+		if err != nil && batch.Count(domain.TransferBatchLineFailed) == 0 {
+			slog.Error("error committing transfer batch", "error", err, "fromNumber", batch.FromNumber)
+			return err
+		}
+	}
+
+	slog.Info("Transfer batch executed", "fromNumber", batch.FromNumber, "mode", batch.Mode, "lines", len(batch.Lines),
+		"succeeded", batch.Count(domain.TransferBatchLineSucceeded),
+		"pendingApproval", batch.Count(domain.TransferBatchLinePendingApproval), "failed", batch.Count(domain.TransferBatchLineFailed))
+
+	return nil
+}
+
+func (us *TransferBatchUseCase) executeLine(transferAccountUseCase *TransferAccountUseCase, batch *domain.TransferBatch, line *domain.TransferBatchLine) error {
+	var outcome *domain.IdempotencyKey
+	var err error
+	if line.PixKey != "" {
+		outcome, err = transferAccountUseCase.HandleToPixKey(batch.FromNumber, line.PixKey, domain.Money{Amount: line.Value}, line.IdempotencyKey, batch.RequestedBy)
+	} else {
+		outcome, err = transferAccountUseCase.Handle(batch.FromNumber, line.ToNumber, domain.Money{Amount: line.Value}, line.IdempotencyKey, batch.RequestedBy)
+	}
+
+	if err != nil {
+		slog.Info("transfer batch line failed", "error", err, "fromNumber", batch.FromNumber, "line", line.Line)
+		batch.RecordFailure(line, err)
+		return err
+	}
+
+	line.Status = domain.TransferBatchLineSucceeded
+	if outcome.StatusCode == http.StatusAccepted {
+		line.Status = domain.TransferBatchLinePendingApproval
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTransferBatchMocks(fromAcc *domain.Account, toAcc *domain.Account) (*usecases_mock.MockAccountRepository, *usecases_mock.MockOutboxRepository) {
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{fromAcc.Number, toAcc.Number}).Return(map[string]*domain.Account{fromAcc.Number: fromAcc, toAcc.Number: toAcc}, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{fromAcc.Number, "35"}).Return(map[string]*domain.Account{fromAcc.Number: fromAcc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockIdempotencyRepository.On("GetKey", fromAcc.Number, "user-1", mock.Anything).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	return mockRepo, mockOutboxRepository
+}
+
+func newTransferBatch(mode domain.TransferBatchMode) *domain.TransferBatch {
+	return domain.NewTransferBatch("19", mode, "user-1", []*domain.TransferBatchLine{
+		{ToNumber: "27", Value: 100, IdempotencyKey: "3f9b1a52-5c1e-4d8e-9a57-0c2b8f1d6e41"},
+		{ToNumber: "35", Value: 100, IdempotencyKey: "7c2d4e6f-8a1b-4c3d-9e5f-1a2b3c4d5e6f"},
+		{ToNumber: "27", Value: 200, IdempotencyKey: "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"},
+	})
+}
+
+func TestTransferBatchUseCase_Handle_BestEffort(t *testing.T) {
+	// arrange
+	fromAcc := domain.NewAccount("19", "01234567890", "John Doe")
+	fromAcc.Balance = 1000
+	toAcc := domain.NewAccount("27", "52998224725", "Jane Doe")
+
+	mockRepo, _ := newTransferBatchMocks(fromAcc, toAcc)
+
+	useCase := NewTransferBatchUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil), 10, domain.AccountNumberPolicy{})
+
+	batch := newTransferBatch(domain.TransferBatchBestEffort)
+
+	// act
+	err := useCase.Handle(batch)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.TransferBatchLineSucceeded, batch.Lines[0].Status)
+	assert.Equal(t, domain.TransferBatchLineFailed, batch.Lines[1].Status)
+	assert.Equal(t, "to account not found", batch.Lines[1].Error)
+	assert.Equal(t, domain.TransferBatchLineSucceeded, batch.Lines[2].Status)
+	assert.Equal(t, int64(700), fromAcc.Balance)
+	assert.Equal(t, int64(300), toAcc.Balance)
+
+	mockRepo.AssertNumberOfCalls(t, "WithTransaction", 3)
+}
+
+func TestTransferBatchUseCase_Handle_AllOrNothing(t *testing.T) {
+	// arrange
+	fromAcc := domain.NewAccount("19", "01234567890", "John Doe")
+	fromAcc.Balance = 1000
+	toAcc := domain.NewAccount("27", "52998224725", "Jane Doe")
+
+	mockRepo, _ := newTransferBatchMocks(fromAcc, toAcc)
+
+	useCase := NewTransferBatchUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil), 10, domain.AccountNumberPolicy{})
+
+	batch := newTransferBatch(domain.TransferBatchAllOrNothing)
+
+	// act
+	err := useCase.Handle(batch)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.TransferBatchLineRolledBack, batch.Lines[0].Status)
+	assert.Equal(t, domain.TransferBatchLineFailed, batch.Lines[1].Status)
+	assert.Equal(t, domain.TransferBatchLineNotExecuted, batch.Lines[2].Status)
+
+	// the batch transaction and the transfers joining it
+	mockRepo.AssertNumberOfCalls(t, "WithTransaction", 3)
+}
+
+func TestTransferBatchUseCase_Handle_InvalidBatch(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewTransferBatchUseCase(mockRepo, NewTransferAccountUseCase(mockRepo, nil, nil, nil, domain.TransferApprovalPolicy{}, domain.PayeePolicy{}, nil, nil, nil), 10, domain.AccountNumberPolicy{})
+
+	batch := newTransferBatch(domain.TransferBatchAllOrNothing)
+	batch.Lines[2].Value = -1
+
+	// act
+	err := useCase.Handle(batch)
+
+	// assert
+	assert.Equal(t, domain.ErrInvalidTransferBatch, err)
+	assert.Equal(t, domain.TransferBatchLineInvalid, batch.Lines[2].Status)
+	assert.Equal(t, domain.TransferBatchLinePending, batch.Lines[0].Status)
+
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
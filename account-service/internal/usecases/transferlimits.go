@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+// checkTransferLimits verifies a transfer of value out of acc against its limits, using the
+// transfers already recorded in the ledger today and in the current night window. The account
+// row must be locked so concurrent transfers cannot both fit in the same remaining limit.
+func checkTransferLimits(ledgerRepository repositories.LedgerRepositoryInterface, acc *domain.Account,
+	defaults domain.DefaultTransferLimits, value int64, now time.Time) error {
+	limits := acc.EffectiveTransferLimits(defaults)
+	if limits.Unlimited() {
+		return nil
+	}
+
+	spentToday, err := ledgerRepository.GetOutgoingTransfersTotal(acc.Number, domain.StartOfDay(now))
+	if err != nil {
+		return err
+	}
+
+	var spentTonight int64
+	nightStart, atNight := domain.NightWindowStart(now)
+	if atNight && limits.Nightly > 0 {
+		spentTonight, err = ledgerRepository.GetOutgoingTransfersTotal(acc.Number, nightStart)
+		if err != nil {
+			return err
+		}
+	}
+
+	return limits.Check(value, spentToday, spentTonight, atNight)
+}
@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+type UpdateAccountProfileUseCaseInterface interface {
+	Handle(number string, update domain.AccountProfileUpdate, changedBy string) (*domain.Account, error)
+}
+
+type UpdateAccountProfileUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewUpdateAccountProfileUseCase(accountRepository repositories.AccountRepositoryInterface) *UpdateAccountProfileUseCase {
+	return &UpdateAccountProfileUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handle updates the name and contact data of the account, recording each changed field in
+// its history. AccountUpdated is only published when some field actually changed.
+func (us *UpdateAccountProfileUseCase) Handle(number string, update domain.AccountProfileUpdate, changedBy string) (*domain.Account, error) {
+	var acc *domain.Account
+
+	err := us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc = accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		changes, err := acc.UpdateProfile(update, changedBy)
+		if err != nil {
+			slog.Info("invalid account profile", "error", err, "number", number)
+			return err
+		}
+
+		if len(changes) == 0 {
+			return nil
+		}
+
+		err = uow.AccountRepository().UpdateAccountProfile(acc)
+		if err != nil {
+			slog.Error("error updating account profile", "error", err)
+			return err
+		}
+
+		changedFields := make([]string, 0, len(changes))
+		for _, change := range changes {
+			_, err = uow.AccountProfileChangesRepository().CreateAccountProfileChange(change)
+			if err != nil {
+				slog.Error("error creating account profile change", "error", err)
+				return err
+			}
+
+			changedFields = append(changedFields, change.Field)
+		}
+
+		if slices.Contains(changedFields, domain.AccountProfileFieldName) {
+			err = uow.AccountHoldersRepository().UpdatePrimaryHolderName(acc.Number, acc.Name)
+			if err != nil {
+				slog.Error("error updating primary holder name", "error", err)
+				return err
+			}
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewAccountUpdated(acc.Number, acc.Name, acc.Email, acc.Phone, acc.Address, changedFields))
+		if err != nil {
+			slog.Error("error adding account updated event to outbox", "error", err)
+			return err
+		}
+
+		slog.Info("account profile updated", "number", number, "fields", changedFields)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func profileField(value string) *string {
+	return &value
+}
+
+func TestUpdateAccountProfileUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockChangesRepository := new(usecases_mock.MockAccountProfileChangesRepository)
+	mockHoldersRepository := new(usecases_mock.MockAccountHoldersRepository)
+
+	useCase := NewUpdateAccountProfileUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+	update := domain.AccountProfileUpdate{Name: profileField("John Bidden"), Email: profileField("john@example.com")}
+
+	uow := usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).
+		WithAccountProfileChangesRepository(mockChangesRepository).
+		WithAccountHoldersRepository(mockHoldersRepository)
+	mockRepo.On("WithTransaction", mock.Anything).Return(uow, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountProfile", acc).Return(nil)
+	mockChangesRepository.On("CreateAccountProfileChange", mock.MatchedBy(func(change *domain.AccountProfileChange) bool {
+		return change.AccountNumber == "1" && change.ChangedBy == "client"
+	})).Return("1", nil).Twice()
+	mockHoldersRepository.On("UpdatePrimaryHolderName", acc.Number, "John Bidden").Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "AccountUpdated" &&
+			message.Data == `{"number":"1","name":"John Bidden","email":"john@example.com","phone":"","address":"","changedFields":["name","email"]}`
+	})).Return(nil)
+
+	// act
+	updated, err := useCase.Handle(acc.Number, update, "client")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "John Bidden", updated.Name)
+	assert.Equal(t, "john@example.com", updated.Email)
+
+	mockRepo.AssertExpectations(t)
+	mockChangesRepository.AssertExpectations(t)
+	mockHoldersRepository.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+}
+
+func TestUpdateAccountProfileUseCase_Handle_NothingChanged(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockChangesRepository := new(usecases_mock.MockAccountProfileChangesRepository)
+
+	useCase := NewUpdateAccountProfileUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+
+	uow := usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountProfileChangesRepository(mockChangesRepository)
+	mockRepo.On("WithTransaction", mock.Anything).Return(uow, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	// act
+	updated, err := useCase.Handle(acc.Number, domain.AccountProfileUpdate{Name: profileField("John Doe")}, "client")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, acc, updated)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountProfile", mock.Anything)
+	mockChangesRepository.AssertNotCalled(t, "CreateAccountProfileChange", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
+
+func TestUpdateAccountProfileUseCase_Handle_InvalidProfile(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewUpdateAccountProfileUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	// act
+	updated, err := useCase.Handle(acc.Number, domain.AccountProfileUpdate{Email: profileField("not an email")}, "client")
+
+	// assert
+	assert.EqualError(t, err, "invalid email")
+	assert.Nil(t, updated)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountProfile", mock.Anything)
+}
+
+func TestUpdateAccountProfileUseCase_Handle_AccountNotFound(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewUpdateAccountProfileUseCase(mockRepo)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, nil, nil, nil), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{"1"}).Return(map[string]*domain.Account{}, nil)
+
+	// act
+	updated, err := useCase.Handle("1", domain.AccountProfileUpdate{Name: profileField("John Bidden")}, "client")
+
+	// assert
+	assert.EqualError(t, err, "account not found")
+	assert.Nil(t, updated)
+}
+
+func TestUpdateAccountProfileUseCase_Handle_ErrorCreatingChange(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockChangesRepository := new(usecases_mock.MockAccountProfileChangesRepository)
+
+	useCase := NewUpdateAccountProfileUseCase(mockRepo)
+
+	acc := domain.NewAccount("1", "01234567890", "John Doe")
+
+	uow := usecases_mock.NewMockUnitOfWork(mockRepo, nil, mockOutboxRepository, nil).WithAccountProfileChangesRepository(mockChangesRepository)
+	mockRepo.On("WithTransaction", mock.Anything).Return(uow, nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountProfile", acc).Return(nil)
+	mockChangesRepository.On("CreateAccountProfileChange", mock.Anything).Return("", errors.New("db error"))
+
+	// act
+	updated, err := useCase.Handle(acc.Number, domain.AccountProfileUpdate{Name: profileField("John Bidden")}, "client")
+
+	// assert
+	assert.EqualError(t, err, "db error")
+	assert.Nil(t, updated)
+
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+}
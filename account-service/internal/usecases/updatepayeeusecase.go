@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type UpdatePayeeUseCaseInterface interface {
+	Handle(number string, id string, nickname string) (*domain.Payee, error)
+}
+
+type UpdatePayeeUseCase struct {
+	payeesRepository repositories.PayeesRepositoryInterface
+}
+
+func NewUpdatePayeeUseCase(payeesRepository repositories.PayeesRepositoryInterface) *UpdatePayeeUseCase {
+	return &UpdatePayeeUseCase{
+		payeesRepository: payeesRepository,
+	}
+}
+
+// Handle renames the payee, a different destination is saved as a new payee.
+func (us *UpdatePayeeUseCase) Handle(number string, id string, nickname string) (*domain.Payee, error) {
+	payee, err := us.payeesRepository.GetPayee(number, id)
+	if err != nil {
+		slog.Error("error getting payee", "error", err, "number", number, "id", id)
+		return nil, err
+	}
+
+	if payee == nil {
+		slog.Info("payee not found", "number", number, "id", id)
+		return nil, errors.New("payee not found")
+	}
+
+	err = payee.Rename(nickname)
+	if err != nil {
+		slog.Info("invalid payee", "error", err, "id", id)
+		return nil, err
+	}
+
+	err = us.payeesRepository.UpdatePayee(payee)
+	if err != nil {
+		slog.Error("error updating payee", "error", err, "id", id)
+		return nil, err
+	}
+
+	slog.Info("payee updated", "number", number, "id", id)
+
+	return payee, nil
+}
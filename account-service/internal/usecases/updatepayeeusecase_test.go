@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdatePayeeUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewUpdatePayeeUseCase(mockPayeesRepository)
+
+	payee := &domain.Payee{Id: "5", AccountNumber: "19", Nickname: "Jane", ToNumber: "27"}
+
+	mockPayeesRepository.On("GetPayee", "19", "5").Return(payee, nil)
+	mockPayeesRepository.On("UpdatePayee", payee).Return(nil)
+
+	// act
+	updated, err := useCase.Handle("19", "5", "Landlord")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Landlord", updated.Nickname)
+	assert.Equal(t, "27", updated.ToNumber)
+	mockPayeesRepository.AssertExpectations(t)
+}
+
+func TestUpdatePayeeUseCase_Handle_NotFound(t *testing.T) {
+	// arrange
+	mockPayeesRepository := new(usecases_mock.MockPayeesRepository)
+	useCase := NewUpdatePayeeUseCase(mockPayeesRepository)
+
+	mockPayeesRepository.On("GetPayee", "19", "5").Return((*domain.Payee)(nil), nil)
+
+	// act
+	_, err := useCase.Handle("19", "5", "Landlord")
+
+	// assert
+	assert.Equal(t, "payee not found", err.Error())
+	mockPayeesRepository.AssertNotCalled(t, "UpdatePayee", mock.Anything)
+}
@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type UpdateScheduledTransferUseCaseInterface interface {
+	Handle(number string, id string, value int64, endDate *time.Time, maxOccurrences int) error
+}
+
+type UpdateScheduledTransferUseCase struct {
+	scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface
+}
+
+func NewUpdateScheduledTransferUseCase(scheduledTransfersRepository repositories.ScheduledTransfersRepositoryInterface) *UpdateScheduledTransferUseCase {
+	return &UpdateScheduledTransferUseCase{
+		scheduledTransfersRepository: scheduledTransfersRepository,
+	}
+}
+
+func (us *UpdateScheduledTransferUseCase) Handle(number string, id string, value int64, endDate *time.Time, maxOccurrences int) error {
+	scheduledTransfer, err := us.scheduledTransfersRepository.GetScheduledTransfer(number, id)
+	if err != nil {
+		slog.Error("error getting scheduled transfer", "error", err, "number", number, "id", id)
+		return err
+	}
+
+	if scheduledTransfer == nil {
+		slog.Info("scheduled transfer not found", "number", number, "id", id)
+		return errors.New("scheduled transfer not found")
+	}
+
+	err = scheduledTransfer.Update(value, endDate, maxOccurrences)
+	if err != nil {
+		slog.Info("scheduled transfer update not allowed", "error", err, "id", id)
+		return err
+	}
+
+	err = us.scheduledTransfersRepository.UpdateScheduledTransfer(scheduledTransfer)
+	if err != nil {
+		slog.Error("error updating scheduled transfer", "error", err, "id", id)
+		return err
+	}
+
+	slog.Info("scheduled transfer updated", "number", number, "id", id)
+
+	return nil
+}
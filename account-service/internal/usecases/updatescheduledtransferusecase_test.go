@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateScheduledTransferUseCase_Handle_Success(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	useCase := NewUpdateScheduledTransferUseCase(mockScheduledTransfersRepository)
+
+	scheduledTransfer := &domain.ScheduledTransfer{Id: "5", FromNumber: "1", ToNumber: "2", Value: 100,
+		Frequency: domain.ScheduledTransferWeekly, Status: domain.ScheduledTransferActive}
+
+	mockScheduledTransfersRepository.On("GetScheduledTransfer", "1", "5").Return(scheduledTransfer, nil)
+	mockScheduledTransfersRepository.On("UpdateScheduledTransfer", scheduledTransfer).Return(nil)
+
+	// act
+	err := useCase.Handle("1", "5", 300, nil, 4)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(300), scheduledTransfer.Value)
+	assert.Equal(t, 4, scheduledTransfer.MaxOccurrences)
+	mockScheduledTransfersRepository.AssertExpectations(t)
+}
+
+func TestUpdateScheduledTransferUseCase_Handle_InvalidValue(t *testing.T) {
+	// arrange
+	mockScheduledTransfersRepository := new(usecases_mock.MockScheduledTransfersRepository)
+	useCase := NewUpdateScheduledTransferUseCase(mockScheduledTransfersRepository)
+
+	scheduledTransfer := &domain.ScheduledTransfer{Id: "5", FromNumber: "1", ToNumber: "2", Value: 100,
+		Frequency: domain.ScheduledTransferWeekly, Status: domain.ScheduledTransferActive}
+
+	mockScheduledTransfersRepository.On("GetScheduledTransfer", "1", "5").Return(scheduledTransfer, nil)
+
+	// act
+	err := useCase.Handle("1", "5", -1, nil, 0)
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, int64(100), scheduledTransfer.Value)
+	mockScheduledTransfersRepository.AssertNotCalled(t, "UpdateScheduledTransfer", mock.Anything)
+}
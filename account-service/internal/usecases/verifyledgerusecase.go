@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+)
+
+type VerifyLedgerUseCaseInterface interface {
+	Handle() (*domain.LedgerVerification, error)
+}
+
+type VerifyLedgerUseCase struct {
+	ledgerRepository repositories.LedgerRepositoryInterface
+}
+
+func NewVerifyLedgerUseCase(ledgerRepository repositories.LedgerRepositoryInterface) *VerifyLedgerUseCase {
+	return &VerifyLedgerUseCase{
+		ledgerRepository: ledgerRepository,
+	}
+}
+
+// Handle checks that all ledger entries sum to zero, that every transaction is balanced and
+// that every account balance matches its entries. Each check is a single statement, so it
+// sees a consistent snapshot even while transactions are being written.
+func (us *VerifyLedgerUseCase) Handle() (*domain.LedgerVerification, error) {
+	entriesTotal, err := us.ledgerRepository.GetEntriesTotal()
+	if err != nil {
+		slog.Error("error getting ledger entries total", "error", err)
+		return nil, err
+	}
+
+	unbalancedTransactions, err := us.ledgerRepository.GetUnbalancedTransactions()
+	if err != nil {
+		slog.Error("error getting unbalanced ledger transactions", "error", err)
+		return nil, err
+	}
+
+	balanceMismatches, err := us.ledgerRepository.GetBalanceMismatches()
+	if err != nil {
+		slog.Error("error getting ledger balance mismatches", "error", err)
+		return nil, err
+	}
+
+	verification := &domain.LedgerVerification{
+		EntriesTotal:           entriesTotal,
+		UnbalancedTransactions: unbalancedTransactions,
+		BalanceMismatches:      balanceMismatches,
+		VerifiedAt:             time.Now(),
+	}
+
+	if !verification.IsBalanced() {
+		slog.Error("ledger verification failed",
+			"entriesTotal", entriesTotal,
+			"unbalancedTransactions", unbalancedTransactions,
+			"balanceMismatches", balanceMismatches)
+
+		return verification, nil
+	}
+
+	slog.Info("ledger verified")
+
+	return verification, nil
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyLedgerUseCase_Handle_Balanced(t *testing.T) {
+	// arrange
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewVerifyLedgerUseCase(mockLedgerRepository)
+
+	mockLedgerRepository.On("GetEntriesTotal").Return(int64(0), nil)
+	mockLedgerRepository.On("GetUnbalancedTransactions").Return([]string{}, nil)
+	mockLedgerRepository.On("GetBalanceMismatches").Return([]domain.LedgerBalanceMismatch{}, nil)
+
+	// act
+	verification, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, verification.IsBalanced())
+
+	mockLedgerRepository.AssertExpectations(t)
+}
+
+func TestVerifyLedgerUseCase_Handle_BalanceMismatch(t *testing.T) {
+	// arrange
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewVerifyLedgerUseCase(mockLedgerRepository)
+
+	mismatches := []domain.LedgerBalanceMismatch{{AccountNumber: "1", Balance: 150, LedgerBalance: 100}}
+
+	mockLedgerRepository.On("GetEntriesTotal").Return(int64(0), nil)
+	mockLedgerRepository.On("GetUnbalancedTransactions").Return([]string{}, nil)
+	mockLedgerRepository.On("GetBalanceMismatches").Return(mismatches, nil)
+
+	// act
+	verification, err := useCase.Handle()
+
+	// assert
+	assert.NoError(t, err)
+	assert.False(t, verification.IsBalanced())
+	assert.Equal(t, mismatches, verification.BalanceMismatches)
+}
+
+func TestVerifyLedgerUseCase_Handle_ErrorGettingEntriesTotal(t *testing.T) {
+	// arrange
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewVerifyLedgerUseCase(mockLedgerRepository)
+
+	mockLedgerRepository.On("GetEntriesTotal").Return(int64(0), errors.New("db error"))
+
+	// act
+	verification, err := useCase.Handle()
+
+	// assert
+	assert.Error(t, err)
+	assert.Nil(t, verification)
+	mockLedgerRepository.AssertNotCalled(t, "GetUnbalancedTransactions")
+}
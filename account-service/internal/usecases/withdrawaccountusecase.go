@@ -0,0 +1,125 @@
+package usecases
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/shared/events"
+)
+
+const withdrawOperation = "withdraw"
+
+type WithdrawAccountUseCaseInterface interface {
+	Handle(number string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error)
+}
+
+type WithdrawAccountUseCase struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+type withdrawRequest struct {
+	Value    int64           `json:"value"`
+	Currency domain.Currency `json:"currency,omitempty"`
+}
+
+func NewWithdrawAccountUseCase(accountRepository repositories.AccountRepositoryInterface) *WithdrawAccountUseCase {
+	return &WithdrawAccountUseCase{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handle withdraws value from the account and returns the outcome recorded for the idempotency key.
+// A retry with the same key and request returns the outcome of the first execution.
+func (us *WithdrawAccountUseCase) Handle(number string, value domain.Money, idempotencyKey string, requestedBy string) (*domain.IdempotencyKey, error) {
+	key, err := domain.NewIdempotencyKey(number, requestedBy, idempotencyKey, withdrawOperation, withdrawRequest{Value: value.Amount, Currency: value.Currency})
+	if err != nil {
+		slog.Info("invalid idempotency key", "error", err)
+		return nil, err
+	}
+
+	var outcome *domain.IdempotencyKey
+	err = us.accountRepository.WithTransaction(func(uow repositories.UnitOfWorkInterface) error {
+		accounts, err := uow.AccountRepository().GetAccountsByNumbersForUpdate(number)
+		if err != nil {
+			slog.Error("Error getting account by number", "error", err)
+			return err
+		}
+
+		acc := accounts[number]
+		if acc == nil {
+			slog.Info("account not found", "number", number)
+			return errors.New("account not found")
+		}
+
+		outcome, err = getRecordedOutcome(uow.IdempotencyKeysRepository(), key)
+		if err != nil || outcome != nil {
+			return err
+		}
+
+		err = acc.EnsureActive()
+		if err != nil {
+			slog.Info("account not active", "number", acc.Number, "status", acc.Status)
+			return err
+		}
+
+		value = value.OrCurrency(acc.Currency)
+
+		wasInOverdraft := acc.InOverdraft()
+
+		err = acc.Withdraw(value)
+		if err != nil {
+			slog.Info("invalid withdraw", "error", err, "number", number)
+			return err
+		}
+
+		err = uow.AccountRepository().UpdateAccountBalance(acc)
+		if err != nil {
+			slog.Error("error updating account balance", "error", err)
+			return err
+		}
+
+		err = uow.LedgerRepository().CreateTransaction(domain.NewWithdrawLedgerTransaction(acc.Number, value))
+		if err != nil {
+			slog.Error("error creating ledger transaction", "error", err)
+			return err
+		}
+
+		err = addEventToOutbox(uow.OutboxRepository(), events.NewFundsWithdrawn(acc.Number, value))
+		if err != nil {
+			slog.Error("error adding funds withdrawn event to outbox", "error", err)
+			return err
+		}
+
+		err = addEnteredOverdraftEventToOutbox(uow.OutboxRepository(), acc, wasInOverdraft)
+		if err != nil {
+			slog.Error("error adding account entered overdraft event to outbox", "error", err)
+			return err
+		}
+
+		err = key.SetResponse(http.StatusNoContent, nil)
+		if err != nil {
+			return err
+		}
+
+		err = uow.IdempotencyKeysRepository().CreateKey(key)
+		if err != nil {
+			slog.Error("error saving idempotency key used", "error", err, "idempotencyKey", idempotencyKey)
+			return err
+		}
+
+		outcome = key
+
+		slog.Info("Withdraw realized", "accountNumber", acc.Number, "value", value, "idempotencyKey", idempotencyKey)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outcome, nil
+}
@@ -0,0 +1,331 @@
+package usecases
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	usecases_mock "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWithdrawAccountUseCase_Handle_ErrorGettingAccount(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 200
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account(nil), errors.New("generic error"))
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.Error(t, err)
+	assert.Nil(t, outcome)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestWithdrawAccountUseCase_Handle_AccountNotFound(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 200
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	// act
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, "account not found", err.Error())
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestWithdrawAccountUseCase_Handle_Success(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 200
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.MatchedBy(func(transaction *domain.LedgerTransaction) bool {
+		return transaction.Type == "withdraw" && transaction.Validate() == nil &&
+			transaction.Entries[0].AccountNumber == acc.Number && transaction.Entries[0].Amount == -150 &&
+			transaction.Entries[1].AccountNumber == domain.ExternalLedgerAccount && transaction.Entries[1].Amount == 150
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FundsWithdrawn" && message.Data == `{"number":"4","value":{"amount":150,"currency":"BRL"}}`
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.MatchedBy(func(key *domain.IdempotencyKey) bool {
+		return key.Key == idempotencyKey.String() && key.Scope == acc.Number && key.Operation == "withdraw" && key.StatusCode == http.StatusNoContent
+	})).Return(nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, outcome.StatusCode)
+	assert.Equal(t, int64(50), acc.Balance)
+
+	mockRepo.AssertExpectations(t)
+	mockOutboxRepository.AssertExpectations(t)
+	mockIdempotencyRepository.AssertExpectations(t)
+	mockLedgerRepository.AssertExpectations(t)
+}
+
+func TestWithdrawAccountUseCase_Handle_IdempotencyKeyReplayed(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 200
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	recorded, _ := domain.NewIdempotencyKey(acc.Number, "bob", idempotencyKey.String(), "withdraw", withdrawRequest{Value: 150})
+	_ = recorded.SetResponse(http.StatusNoContent, nil)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return(recorded, nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, outcome)
+	assert.Equal(t, int64(200), acc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestWithdrawAccountUseCase_Handle_IdempotencyKeyReusedWithDifferentRequest(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 200
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	recorded, _ := domain.NewIdempotencyKey(acc.Number, "bob", idempotencyKey.String(), "withdraw", withdrawRequest{Value: 100})
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return(recorded, nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.ErrorIs(t, err, domain.ErrIdempotencyKeyReused)
+	assert.Nil(t, outcome)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestWithdrawAccountUseCase_Handle_EmptyIdempotencyKey(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	// act
+	outcome, err := useCase.Handle("4", domain.Money{Amount: 150}, "", "bob")
+
+	// assert
+	assert.Error(t, err)
+	assert.Nil(t, outcome)
+
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
+
+func TestWithdrawAccountUseCase_Handle_GetKeyError(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 200
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	genericError := errors.New("generic error")
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), genericError)
+
+	// act
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, err, genericError)
+
+	mockRepo.AssertExpectations(t)
+	mockIdempotencyRepository.AssertExpectations(t)
+}
+
+func TestWithdrawAccountUseCase_Handle_ErrorSavingIdempotencyKeyUsed(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 200
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.Anything).Return(nil)
+
+	errorSavingUsedIdempotencyKey := errors.New("error saving used idempotency key")
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(errorSavingUsedIdempotencyKey)
+
+	// act
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, err, errorSavingUsedIdempotencyKey)
+
+	mockRepo.AssertExpectations(t)
+	mockIdempotencyRepository.AssertExpectations(t)
+}
+
+func TestWithdrawAccountUseCase_Handle_InsufficientFunds(t *testing.T) {
+	// act
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 100
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+
+	// act
+	outcome, err := useCase.Handle(acc.Number, domain.Money{Amount: 150}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.Equal(t, domain.ErrInsufficientFunds, err)
+	assert.Nil(t, outcome)
+	assert.Equal(t, int64(100), acc.Balance)
+
+	mockRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+	mockOutboxRepository.AssertNotCalled(t, "CreateMessage", mock.Anything)
+	mockIdempotencyRepository.AssertNotCalled(t, "CreateKey", mock.Anything)
+}
+
+func TestWithdrawAccountUseCase_Handle_EnteringOverdraft(t *testing.T) {
+	// arrange
+	mockRepo := new(usecases_mock.MockAccountRepository)
+	mockOutboxRepository := new(usecases_mock.MockOutboxRepository)
+	mockIdempotencyRepository := new(usecases_mock.MockIdempotencyRepository)
+	mockLedgerRepository := new(usecases_mock.MockLedgerRepository)
+
+	useCase := NewWithdrawAccountUseCase(mockRepo)
+
+	acc := domain.NewAccount("4", "01234567890", "John Doo")
+	acc.Balance = 100
+	acc.OverdraftLimit = 500
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(usecases_mock.NewMockUnitOfWork(mockRepo, mockIdempotencyRepository, mockOutboxRepository, mockLedgerRepository), nil)
+	mockRepo.On("GetAccountsByNumbersForUpdate", []string{acc.Number}).Return(map[string]*domain.Account{acc.Number: acc}, nil)
+	mockRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	mockLedgerRepository.On("CreateTransaction", mock.Anything).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "FundsWithdrawn"
+	})).Return(nil)
+	mockOutboxRepository.On("CreateMessage", mock.MatchedBy(func(message *domain.OutboxMessage) bool {
+		return message.Type == "AccountEnteredOverdraft" && message.Data == `{"number":"4","balance":-200,"overdraftLimit":500}`
+	})).Return(nil)
+
+	idempotencyKey, _ := uuid.NewUUID()
+
+	mockIdempotencyRepository.On("GetKey", acc.Number, "bob", idempotencyKey.String()).Return((*domain.IdempotencyKey)(nil), nil)
+	mockIdempotencyRepository.On("CreateKey", mock.Anything).Return(nil)
+
+	// act
+	_, err := useCase.Handle(acc.Number, domain.Money{Amount: 300}, idempotencyKey.String(), "bob")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-200), acc.Balance)
+
+	mockOutboxRepository.AssertExpectations(t)
+}
@@ -4,11 +4,12 @@ import (
 	"fmt"
 
 	"github.com/gin-gonic/gin"
-	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/infrastructure/broker"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/configs"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/repositories"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/controllers"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
 	"github.com/spf13/viper"
 	"golang.org/x/exp/slog"
 )
@@ -33,18 +34,86 @@ func (s *APIServer) SetupRoutes() {
 
 	var db = repositories.NewDBConnection()
 	accountRepository := repositories.NewAccountRepository(db)
-	idempotencyKeysRepository := repositories.NewIdempotencyKeysRepository(db)
+	ledgerRepository := repositories.NewLedgerRepository(db)
+	scheduledTransfersRepository := repositories.NewScheduledTransfersRepository(db)
+	pixKeysRepository := repositories.NewPixKeysRepository(db)
+	payeesRepository := repositories.NewPayeesRepository(db)
+	accountHoldersRepository := repositories.NewAccountHoldersRepository(db)
+	riskRules := configs.RiskRules()
+	feeSchedule := configs.FeeSchedule()
 
-	var broker = broker.NewBroker(broker.BuildConnectionUrl())
+	createAccountUseCase := usecases.NewCreateAccountUseCase(accountRepository, configs.AccountNumberPolicy())
+	getAccountUseCase := usecases.NewGetAccountUseCase(accountRepository, repositories.NewPocketsRepository(db), accountHoldersRepository)
+	getAccountsByDocumentUseCase := usecases.NewGetAccountsByDocumentUseCase(accountRepository, accountHoldersRepository)
+	depositUseCase := usecases.NewDepositAccountUseCase(accountRepository, riskRules, feeSchedule)
+	transferUseCase := usecases.NewTransferAccountUseCase(accountRepository, pixKeysRepository, payeesRepository, configs.DefaultTransferLimits(), configs.TransferApprovalPolicy(), configs.PayeePolicy(), riskRules, configs.FxRates(), feeSchedule)
+	withdrawUseCase := usecases.NewWithdrawAccountUseCase(accountRepository)
+	getAccountTransactionsUseCase := usecases.NewGetAccountTransactionsUseCase(accountRepository, ledgerRepository)
 
-	createAccountUseCase := usecases.NewCreateAccountUseCase(accountRepository, broker)
-	getAccountUseCase := usecases.NewGetAccountUseCase(accountRepository)
-	depositUseCase := usecases.NewDepositAccountUseCase(accountRepository, broker, idempotencyKeysRepository)
-	transferUseCase := usecases.NewTransferAccountUseCase(accountRepository, broker, idempotencyKeysRepository)
+	updateAccountProfileUseCase := usecases.NewUpdateAccountProfileUseCase(accountRepository)
+	getAccountProfileChangesUseCase := usecases.NewGetAccountProfileChangesUseCase(repositories.NewAccountProfileChangesRepository(db))
 
-	accountController := controllers.NewAccountController(createAccountUseCase, getAccountUseCase, depositUseCase, transferUseCase)
+	accountController := controllers.NewAccountController(createAccountUseCase, getAccountUseCase, depositUseCase, transferUseCase, withdrawUseCase,
+		getAccountTransactionsUseCase, updateAccountProfileUseCase, getAccountProfileChangesUseCase, getAccountsByDocumentUseCase)
 
 	accountController.RegisterRoutes(v1Group)
+
+	changeAccountStatusUseCase := usecases.NewChangeAccountStatusUseCase(accountRepository)
+	setOverdraftLimitUseCase := usecases.NewSetOverdraftLimitUseCase(accountRepository)
+	setTransferLimitsUseCase := usecases.NewSetTransferLimitsUseCase(accountRepository)
+
+	accountAdminController := controllers.NewAccountAdminController(changeAccountStatusUseCase, setOverdraftLimitUseCase, setTransferLimitsUseCase)
+	accountAdminController.RegisterRoutes(v1Group)
+
+	createScheduledTransferUseCase := usecases.NewCreateScheduledTransferUseCase(accountRepository, scheduledTransfersRepository)
+	getScheduledTransfersUseCase := usecases.NewGetScheduledTransfersUseCase(scheduledTransfersRepository)
+	getScheduledTransferUseCase := usecases.NewGetScheduledTransferUseCase(scheduledTransfersRepository)
+	updateScheduledTransferUseCase := usecases.NewUpdateScheduledTransferUseCase(scheduledTransfersRepository)
+	cancelScheduledTransferUseCase := usecases.NewCancelScheduledTransferUseCase(scheduledTransfersRepository)
+
+	scheduledTransferController := controllers.NewScheduledTransferController(createScheduledTransferUseCase, getScheduledTransfersUseCase,
+		getScheduledTransferUseCase, updateScheduledTransferUseCase, cancelScheduledTransferUseCase)
+	scheduledTransferController.RegisterRoutes(v1Group)
+
+	registerPixKeyUseCase := usecases.NewRegisterPixKeyUseCase(accountRepository, pixKeysRepository)
+	getPixKeysUseCase := usecases.NewGetPixKeysUseCase(pixKeysRepository)
+	deletePixKeyUseCase := usecases.NewDeletePixKeyUseCase(pixKeysRepository)
+	controllers.NewPixKeyController(registerPixKeyUseCase, getPixKeysUseCase, deletePixKeyUseCase).RegisterRoutes(v1Group)
+
+	createPayeeUseCase := usecases.NewCreatePayeeUseCase(accountRepository, pixKeysRepository, payeesRepository)
+	getPayeesUseCase := usecases.NewGetPayeesUseCase(payeesRepository)
+	updatePayeeUseCase := usecases.NewUpdatePayeeUseCase(payeesRepository)
+	deletePayeeUseCase := usecases.NewDeletePayeeUseCase(payeesRepository)
+	controllers.NewPayeeController(createPayeeUseCase, getPayeesUseCase, updatePayeeUseCase, deletePayeeUseCase).RegisterRoutes(v1Group)
+
+	reverseTransferUseCase := usecases.NewReverseTransferUseCase(accountRepository)
+	transferBatchUseCase := usecases.NewTransferBatchUseCase(accountRepository, transferUseCase, viper.GetInt("transferBatch.maxLines"), configs.AccountNumberPolicy())
+	controllers.NewTransferController(reverseTransferUseCase, transferBatchUseCase).RegisterRoutes(v1Group)
+
+	placeHoldUseCase := usecases.NewPlaceHoldUseCase(accountRepository, viper.GetDuration("holds.ttl"))
+	getHoldsUseCase := usecases.NewGetHoldsUseCase(repositories.NewHoldsRepository(db))
+	captureHoldUseCase := usecases.NewCaptureHoldUseCase(accountRepository, transferUseCase)
+	releaseHoldUseCase := usecases.NewReleaseHoldUseCase(accountRepository)
+	controllers.NewHoldController(placeHoldUseCase, getHoldsUseCase, captureHoldUseCase, releaseHoldUseCase).RegisterRoutes(v1Group)
+
+	createPocketUseCase := usecases.NewCreatePocketUseCase(accountRepository)
+	getPocketsUseCase := usecases.NewGetPocketsUseCase(repositories.NewPocketsRepository(db))
+	movePocketFundsUseCase := usecases.NewMovePocketFundsUseCase(accountRepository)
+	closePocketUseCase := usecases.NewClosePocketUseCase(accountRepository)
+	controllers.NewPocketController(createPocketUseCase, getPocketsUseCase, movePocketFundsUseCase, closePocketUseCase).RegisterRoutes(v1Group)
+
+	getTransferRequestsUseCase := usecases.NewGetTransferRequestsUseCase(repositories.NewTransferRequestsRepository(db))
+	approveTransferRequestUseCase := usecases.NewApproveTransferRequestUseCase(accountRepository, transferUseCase)
+	rejectTransferRequestUseCase := usecases.NewRejectTransferRequestUseCase(accountRepository)
+	controllers.NewTransferRequestController(getTransferRequestsUseCase, approveTransferRequestUseCase, rejectTransferRequestUseCase).RegisterRoutes(v1Group)
+}
+
+func (s *APIServer) SetupValidators() {
+	err := models.RegisterValidators(configs.AccountNumberPolicy())
+	if err != nil {
+		slog.Error(err.Error())
+		panic(err)
+	}
 }
 
 func (s *APIServer) SetupMiddlewares() {
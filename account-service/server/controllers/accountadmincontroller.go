@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
+)
+
+type AccountAdminController struct {
+	changeAccountStatusUseCase usecases.ChangeAccountStatusUseCaseInterface
+	setOverdraftLimitUseCase   usecases.SetOverdraftLimitUseCaseInterface
+	setTransferLimitsUseCase   usecases.SetTransferLimitsUseCaseInterface
+}
+
+func NewAccountAdminController(changeAccountStatusUseCase usecases.ChangeAccountStatusUseCaseInterface,
+	setOverdraftLimitUseCase usecases.SetOverdraftLimitUseCaseInterface,
+	setTransferLimitsUseCase usecases.SetTransferLimitsUseCaseInterface) *AccountAdminController {
+	return &AccountAdminController{
+		changeAccountStatusUseCase: changeAccountStatusUseCase,
+		setOverdraftLimitUseCase:   setOverdraftLimitUseCase,
+		setTransferLimitsUseCase:   setTransferLimitsUseCase,
+	}
+}
+
+func (c *AccountAdminController) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/account/:number/block", middleware.NewAuthMiddleware("admin"), c.changeStatusHandler(domain.AccountStatusBlocked))
+	router.POST("/account/:number/unblock", middleware.NewAuthMiddleware("admin"), c.changeStatusHandler(domain.AccountStatusActive))
+	router.POST("/account/:number/close", middleware.NewAuthMiddleware("admin"), c.changeStatusHandler(domain.AccountStatusClosed))
+	router.PUT("/account/:number/overdraft-limit", middleware.NewAuthMiddleware("admin"), c.setOverdraftLimitHandler)
+	router.PUT("/account/:number/transfer-limits", middleware.NewAuthMiddleware("admin"), c.setTransferLimitsHandler)
+}
+
+func (c *AccountAdminController) changeStatusHandler(status domain.AccountStatus) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req models.GetAccountRequest
+		if err := ctx.ShouldBindUri(&req); err != nil {
+			slog.Error(err.Error())
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errorMessage": err.Error(),
+			})
+			return
+		}
+
+		err := c.changeAccountStatusUseCase.Handle(req.Number, status)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errorMessage": err.Error(),
+			})
+
+			return
+		}
+
+		ctx.Writer.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (c *AccountAdminController) setOverdraftLimitHandler(ctx *gin.Context) {
+	var req models.SetOverdraftLimitRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.setOverdraftLimitUseCase.Handle(req.Number, *req.OverdraftLimit)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
+
+func (c *AccountAdminController) setTransferLimitsHandler(ctx *gin.Context) {
+	var req models.SetTransferLimitsRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.setTransferLimitsUseCase.Handle(req.Number, req.ToTransferLimits())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
@@ -2,39 +2,61 @@ package controllers
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
 	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
 )
 
 type AccountController struct {
-	createAccountUseCase   usecases.CreateAccountUseCaseInterface
-	getAccountUseCase      usecases.GetAccountUseCaseInterface
-	depositAccountUseCase  usecases.DepositAccountUseCaseInterface
-	transferAccountUseCase usecases.TransferAccountUseCaseInterface
+	createAccountUseCase            usecases.CreateAccountUseCaseInterface
+	getAccountUseCase               usecases.GetAccountUseCaseInterface
+	depositAccountUseCase           usecases.DepositAccountUseCaseInterface
+	transferAccountUseCase          usecases.TransferAccountUseCaseInterface
+	withdrawAccountUseCase          usecases.WithdrawAccountUseCaseInterface
+	getAccountTransactionsUseCase   usecases.GetAccountTransactionsUseCaseInterface
+	updateAccountProfileUseCase     usecases.UpdateAccountProfileUseCaseInterface
+	getAccountProfileChangesUseCase usecases.GetAccountProfileChangesUseCaseInterface
+	getAccountsByDocumentUseCase    usecases.GetAccountsByDocumentUseCaseInterface
 }
 
 func NewAccountController(createAccountUseCase usecases.CreateAccountUseCaseInterface,
 	getAccountUseCase usecases.GetAccountUseCaseInterface,
 	depositAccountUseCase usecases.DepositAccountUseCaseInterface,
-	transferAccountUseCase usecases.TransferAccountUseCaseInterface) *AccountController {
+	transferAccountUseCase usecases.TransferAccountUseCaseInterface,
+	withdrawAccountUseCase usecases.WithdrawAccountUseCaseInterface,
+	getAccountTransactionsUseCase usecases.GetAccountTransactionsUseCaseInterface,
+	updateAccountProfileUseCase usecases.UpdateAccountProfileUseCaseInterface,
+	getAccountProfileChangesUseCase usecases.GetAccountProfileChangesUseCaseInterface,
+	getAccountsByDocumentUseCase usecases.GetAccountsByDocumentUseCaseInterface) *AccountController {
 	return &AccountController{
-		createAccountUseCase:   createAccountUseCase,
-		getAccountUseCase:      getAccountUseCase,
-		depositAccountUseCase:  depositAccountUseCase,
-		transferAccountUseCase: transferAccountUseCase,
+		createAccountUseCase:            createAccountUseCase,
+		getAccountUseCase:               getAccountUseCase,
+		depositAccountUseCase:           depositAccountUseCase,
+		transferAccountUseCase:          transferAccountUseCase,
+		withdrawAccountUseCase:          withdrawAccountUseCase,
+		getAccountTransactionsUseCase:   getAccountTransactionsUseCase,
+		updateAccountProfileUseCase:     updateAccountProfileUseCase,
+		getAccountProfileChangesUseCase: getAccountProfileChangesUseCase,
+		getAccountsByDocumentUseCase:    getAccountsByDocumentUseCase,
 	}
 }
 
 func (a *AccountController) RegisterRoutes(router *gin.RouterGroup) {
 	router.POST("/account", middleware.NewAuthMiddleware("account"), a.createAccountHandler)
 	router.GET("/account/:number", middleware.NewAuthMiddleware("account"), a.getAccountHandler)
+	router.GET("/accounts", middleware.NewAuthMiddleware("account"), a.getAccountsByDocumentHandler)
+	router.PATCH("/account/:number", middleware.NewAuthMiddleware("account"), a.updateAccountProfileHandler)
+	router.GET("/account/:number/profile-changes", middleware.NewAuthMiddleware("account"), a.getAccountProfileChangesHandler)
+	router.GET("/account/:number/transactions", middleware.NewAuthMiddleware("account"), a.getAccountTransactionsHandler)
 	router.POST("/account/:number/deposit", middleware.NewAuthMiddleware("account"), a.depositAccountHandler)
 	router.POST("/account/:number/transfer", middleware.NewAuthMiddleware("account"), a.transferAccountHandler)
+	router.POST("/account/:number/withdraw", middleware.NewAuthMiddleware("account"), a.withdrawAccountHandler)
 }
 
 func (c *AccountController) createAccountHandler(ctx *gin.Context) {
@@ -48,7 +70,7 @@ func (c *AccountController) createAccountHandler(ctx *gin.Context) {
 		return
 	}
 
-	number, err := c.createAccountUseCase.Handle(req.Document, req.Name)
+	number, err := c.createAccountUseCase.Handle(req.ToHolders(), req.Currency, req.Type)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{
 			"errorMessage": err.Error(),
@@ -70,7 +92,7 @@ func (c *AccountController) getAccountHandler(ctx *gin.Context) {
 		return
 	}
 
-	acc, err := c.getAccountUseCase.Handle(req.Number)
+	acc, pockets, err := c.getAccountUseCase.Handle(req.Number)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{
 			"errorMessage": err.Error(),
@@ -84,7 +106,116 @@ func (c *AccountController) getAccountHandler(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, models.NewGetAccountResponse(acc))
+	ctx.JSON(http.StatusOK, models.NewGetAccountResponse(acc, pockets))
+}
+
+func (c *AccountController) getAccountsByDocumentHandler(ctx *gin.Context) {
+	var req models.GetAccountsByDocumentRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	accounts, err := c.getAccountsByDocumentUseCase.Handle(req.Document)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetAccountsResponse(accounts))
+}
+
+func (c *AccountController) updateAccountProfileHandler(ctx *gin.Context) {
+	var req models.UpdateAccountProfileRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	_, err := c.updateAccountProfileUseCase.Handle(req.Number, req.ToUpdate(), middleware.Subject(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	acc, pockets, err := c.getAccountUseCase.Handle(req.Number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetAccountResponse(acc, pockets))
+}
+
+func (c *AccountController) getAccountProfileChangesHandler(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	changes, err := c.getAccountProfileChangesUseCase.Handle(req.Number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetAccountProfileChangesResponse(changes))
+}
+
+func (c *AccountController) getAccountTransactionsHandler(ctx *gin.Context) {
+	var req models.GetAccountTransactionsRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	filter, err := req.ToFilter()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	page, err := c.getAccountTransactionsUseCase.Handle(filter)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetAccountTransactionsResponse(page))
 }
 
 func (c *AccountController) depositAccountHandler(ctx *gin.Context) {
@@ -99,16 +230,16 @@ func (c *AccountController) depositAccountHandler(ctx *gin.Context) {
 		return
 	}
 
-	err := c.depositAccountUseCase.Handle(req.Number, req.Value, req.IdempotencyKey)
+	outcome, err := c.depositAccountUseCase.Handle(req.Number, req.Value, req.IdempotencyKey, middleware.Subject(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
+		ctx.JSON(idempotentErrorStatus(err), gin.H{
 			"errorMessage": err.Error(),
 		})
 
 		return
 	}
 
-	ctx.Writer.WriteHeader(http.StatusNoContent)
+	writeIdempotentResponse(ctx, outcome)
 }
 
 func (c *AccountController) transferAccountHandler(ctx *gin.Context) {
@@ -123,14 +254,86 @@ func (c *AccountController) transferAccountHandler(ctx *gin.Context) {
 		return
 	}
 
-	err := c.transferAccountUseCase.Handle(req.FromNumber, req.ToNumber, req.Value, req.IdempotencyKey)
+	var outcome *domain.IdempotencyKey
+	var err error
+	switch {
+	case req.PayeeId != "":
+		outcome, err = c.transferAccountUseCase.HandleToPayee(req.FromNumber, req.PayeeId, req.Value, req.IdempotencyKey, middleware.Subject(ctx))
+	case req.PixKey != "":
+		outcome, err = c.transferAccountUseCase.HandleToPixKey(req.FromNumber, req.PixKey, req.Value, req.IdempotencyKey, middleware.Subject(ctx))
+	default:
+		outcome, err = c.transferAccountUseCase.Handle(req.FromNumber, req.ToNumber, req.Value, req.IdempotencyKey, middleware.Subject(ctx))
+	}
+
 	if err != nil {
+		writeTransferError(ctx, err)
+		return
+	}
+
+	writeIdempotentResponse(ctx, outcome)
+}
+
+func (c *AccountController) withdrawAccountHandler(ctx *gin.Context) {
+	var req models.WithdrawAccountRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
 		ctx.JSON(http.StatusBadRequest, gin.H{
 			"errorMessage": err.Error(),
 		})
+		return
+	}
+
+	outcome, err := c.withdrawAccountUseCase.Handle(req.Number, req.Value, req.IdempotencyKey, middleware.Subject(ctx))
+	if err != nil {
+		ctx.JSON(idempotentErrorStatus(err), gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	writeIdempotentResponse(ctx, outcome)
+}
+
+func idempotentErrorStatus(err error) int {
+	if errors.Is(err, domain.ErrIdempotencyKeyReused) || errors.Is(err, domain.ErrOperationBlocked) ||
+		errors.Is(err, domain.ErrCurrencyMismatch) || errors.Is(err, domain.ErrFxRateNotFound) ||
+		errors.Is(err, domain.ErrPayeeRequired) || errors.Is(err, domain.ErrCaptureRequiresApproval) {
+		return http.StatusUnprocessableEntity
+	}
+
+	return http.StatusBadRequest
+}
+
+// writeTransferError writes the error of a transfer, exceeded transfer limits with their own
+// error code and the limit still available.
+func writeTransferError(ctx *gin.Context, err error) {
+	var limitErr *domain.TransferLimitExceededError
+	if errors.As(err, &limitErr) {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"errorMessage": err.Error(),
+			"errorCode":    "transfer_limit_exceeded",
+			"limit":        limitErr.Limit,
+			"available":    limitErr.Available,
+		})
+
+		return
+	}
+
+	ctx.JSON(idempotentErrorStatus(err), gin.H{
+		"errorMessage": err.Error(),
+	})
+}
 
+// writeIdempotentResponse writes the response recorded for the idempotency key, so retries
+// receive exactly what the first request received.
+func writeIdempotentResponse(ctx *gin.Context, outcome *domain.IdempotencyKey) {
+	if outcome.Response == "" {
+		ctx.Writer.WriteHeader(outcome.StatusCode)
 		return
 	}
 
-	ctx.Writer.WriteHeader(http.StatusNoContent)
+	ctx.Data(outcome.StatusCode, "application/json", []byte(outcome.Response))
 }
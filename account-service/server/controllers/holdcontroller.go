@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
+)
+
+type HoldController struct {
+	placeHoldUseCase   usecases.PlaceHoldUseCaseInterface
+	getHoldsUseCase    usecases.GetHoldsUseCaseInterface
+	captureHoldUseCase usecases.CaptureHoldUseCaseInterface
+	releaseHoldUseCase usecases.ReleaseHoldUseCaseInterface
+}
+
+func NewHoldController(placeHoldUseCase usecases.PlaceHoldUseCaseInterface,
+	getHoldsUseCase usecases.GetHoldsUseCaseInterface,
+	captureHoldUseCase usecases.CaptureHoldUseCaseInterface,
+	releaseHoldUseCase usecases.ReleaseHoldUseCaseInterface) *HoldController {
+	return &HoldController{
+		placeHoldUseCase:   placeHoldUseCase,
+		getHoldsUseCase:    getHoldsUseCase,
+		captureHoldUseCase: captureHoldUseCase,
+		releaseHoldUseCase: releaseHoldUseCase,
+	}
+}
+
+func (c *HoldController) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/account/:number/holds", middleware.NewAuthMiddleware("account"), c.placeHoldHandler)
+	router.GET("/account/:number/holds", middleware.NewAuthMiddleware("account"), c.getHoldsHandler)
+	router.POST("/account/:number/holds/:id/capture", middleware.NewAuthMiddleware("account"), c.captureHoldHandler)
+	router.POST("/account/:number/holds/:id/release", middleware.NewAuthMiddleware("account"), c.releaseHoldHandler)
+}
+
+func (c *HoldController) placeHoldHandler(ctx *gin.Context) {
+	var req models.PlaceHoldRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	outcome, err := c.placeHoldUseCase.Handle(req.Number, req.Amount, req.Description, req.IdempotencyKey, middleware.Subject(ctx))
+	if err != nil {
+		ctx.JSON(idempotentErrorStatus(err), gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	writeIdempotentResponse(ctx, outcome)
+}
+
+func (c *HoldController) getHoldsHandler(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	holds, err := c.getHoldsUseCase.Handle(req.Number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetHoldsResponse(holds))
+}
+
+func (c *HoldController) captureHoldHandler(ctx *gin.Context) {
+	var req models.CaptureHoldRequest
+	req.Number = ctx.Param("number")
+	req.HoldId = ctx.Param("id")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	hold, err := c.captureHoldUseCase.Handle(req.Number, req.HoldId, req.Value, req.ToNumber)
+	if err != nil {
+		writeTransferError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetHoldResponse(hold))
+}
+
+func (c *HoldController) releaseHoldHandler(ctx *gin.Context) {
+	var req models.HoldRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.releaseHoldUseCase.Handle(req.Number, req.HoldId)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
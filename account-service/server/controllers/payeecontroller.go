@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
+)
+
+type PayeeController struct {
+	createPayeeUseCase usecases.CreatePayeeUseCaseInterface
+	getPayeesUseCase   usecases.GetPayeesUseCaseInterface
+	updatePayeeUseCase usecases.UpdatePayeeUseCaseInterface
+	deletePayeeUseCase usecases.DeletePayeeUseCaseInterface
+}
+
+func NewPayeeController(createPayeeUseCase usecases.CreatePayeeUseCaseInterface,
+	getPayeesUseCase usecases.GetPayeesUseCaseInterface,
+	updatePayeeUseCase usecases.UpdatePayeeUseCaseInterface,
+	deletePayeeUseCase usecases.DeletePayeeUseCaseInterface) *PayeeController {
+	return &PayeeController{
+		createPayeeUseCase: createPayeeUseCase,
+		getPayeesUseCase:   getPayeesUseCase,
+		updatePayeeUseCase: updatePayeeUseCase,
+		deletePayeeUseCase: deletePayeeUseCase,
+	}
+}
+
+func (c *PayeeController) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/account/:number/payees", middleware.NewAuthMiddleware("account"), c.createPayeeHandler)
+	router.GET("/account/:number/payees", middleware.NewAuthMiddleware("account"), c.getPayeesHandler)
+	router.PUT("/account/:number/payees/:id", middleware.NewAuthMiddleware("account"), c.updatePayeeHandler)
+	router.DELETE("/account/:number/payees/:id", middleware.NewAuthMiddleware("account"), c.deletePayeeHandler)
+}
+
+func (c *PayeeController) createPayeeHandler(ctx *gin.Context) {
+	var req models.CreatePayeeRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	payee, err := c.createPayeeUseCase.Handle(req.Number, req.Nickname, req.ToNumber, req.PixKey)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, models.NewGetPayeeResponse(payee))
+}
+
+func (c *PayeeController) getPayeesHandler(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	payees, err := c.getPayeesUseCase.Handle(req.Number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetPayeesResponse(payees))
+}
+
+func (c *PayeeController) updatePayeeHandler(ctx *gin.Context) {
+	var req models.UpdatePayeeRequest
+	req.Number = ctx.Param("number")
+	req.Id = ctx.Param("id")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	payee, err := c.updatePayeeUseCase.Handle(req.Number, req.Id, req.Nickname)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetPayeeResponse(payee))
+}
+
+func (c *PayeeController) deletePayeeHandler(ctx *gin.Context) {
+	var req models.DeletePayeeRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.deletePayeeUseCase.Handle(req.Number, req.Id)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
+)
+
+type PixKeyController struct {
+	registerPixKeyUseCase usecases.RegisterPixKeyUseCaseInterface
+	getPixKeysUseCase     usecases.GetPixKeysUseCaseInterface
+	deletePixKeyUseCase   usecases.DeletePixKeyUseCaseInterface
+}
+
+func NewPixKeyController(registerPixKeyUseCase usecases.RegisterPixKeyUseCaseInterface,
+	getPixKeysUseCase usecases.GetPixKeysUseCaseInterface,
+	deletePixKeyUseCase usecases.DeletePixKeyUseCaseInterface) *PixKeyController {
+	return &PixKeyController{
+		registerPixKeyUseCase: registerPixKeyUseCase,
+		getPixKeysUseCase:     getPixKeysUseCase,
+		deletePixKeyUseCase:   deletePixKeyUseCase,
+	}
+}
+
+func (c *PixKeyController) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/account/:number/pix-keys", middleware.NewAuthMiddleware("account"), c.registerPixKeyHandler)
+	router.GET("/account/:number/pix-keys", middleware.NewAuthMiddleware("account"), c.getPixKeysHandler)
+	router.DELETE("/account/:number/pix-keys/:key", middleware.NewAuthMiddleware("account"), c.deletePixKeyHandler)
+}
+
+func (c *PixKeyController) registerPixKeyHandler(ctx *gin.Context) {
+	var req models.RegisterPixKeyRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	pixKey, err := c.registerPixKeyUseCase.Handle(req.Number, domain.PixKeyType(req.Type), req.Key)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, models.NewGetPixKeyResponse(pixKey))
+}
+
+func (c *PixKeyController) getPixKeysHandler(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	pixKeys, err := c.getPixKeysUseCase.Handle(req.Number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetPixKeysResponse(pixKeys))
+}
+
+func (c *PixKeyController) deletePixKeyHandler(ctx *gin.Context) {
+	var req models.DeletePixKeyRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.deletePixKeyUseCase.Handle(req.Number, req.Key)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,131 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
+)
+
+type PocketController struct {
+	createPocketUseCase    usecases.CreatePocketUseCaseInterface
+	getPocketsUseCase      usecases.GetPocketsUseCaseInterface
+	movePocketFundsUseCase usecases.MovePocketFundsUseCaseInterface
+	closePocketUseCase     usecases.ClosePocketUseCaseInterface
+}
+
+func NewPocketController(createPocketUseCase usecases.CreatePocketUseCaseInterface,
+	getPocketsUseCase usecases.GetPocketsUseCaseInterface,
+	movePocketFundsUseCase usecases.MovePocketFundsUseCaseInterface,
+	closePocketUseCase usecases.ClosePocketUseCaseInterface) *PocketController {
+	return &PocketController{
+		createPocketUseCase:    createPocketUseCase,
+		getPocketsUseCase:      getPocketsUseCase,
+		movePocketFundsUseCase: movePocketFundsUseCase,
+		closePocketUseCase:     closePocketUseCase,
+	}
+}
+
+func (c *PocketController) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/account/:number/pockets", middleware.NewAuthMiddleware("account"), c.createPocketHandler)
+	router.GET("/account/:number/pockets", middleware.NewAuthMiddleware("account"), c.getPocketsHandler)
+	router.POST("/account/:number/pockets/:id/moves", middleware.NewAuthMiddleware("account"), c.movePocketFundsHandler)
+	router.POST("/account/:number/pockets/:id/close", middleware.NewAuthMiddleware("account"), c.closePocketHandler)
+}
+
+func (c *PocketController) createPocketHandler(ctx *gin.Context) {
+	var req models.CreatePocketRequest
+	req.Number = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	pocket, err := c.createPocketUseCase.Handle(req.Number, req.Name)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, models.NewGetPocketResponse(pocket))
+}
+
+func (c *PocketController) getPocketsHandler(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	pockets, err := c.getPocketsUseCase.Handle(req.Number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetPocketsResponse(pockets))
+}
+
+func (c *PocketController) movePocketFundsHandler(ctx *gin.Context) {
+	var req models.MovePocketFundsRequest
+	req.Number = ctx.Param("number")
+	req.PocketId = ctx.Param("id")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	outcome, err := c.movePocketFundsUseCase.Handle(req.Number, req.PocketId, domain.PocketMoveDirection(req.Direction), req.Value, req.IdempotencyKey, middleware.Subject(ctx))
+	if err != nil {
+		ctx.JSON(idempotentErrorStatus(err), gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	writeIdempotentResponse(ctx, outcome)
+}
+
+func (c *PocketController) closePocketHandler(ctx *gin.Context) {
+	var req models.PocketRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.closePocketUseCase.Handle(req.Number, req.PocketId)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
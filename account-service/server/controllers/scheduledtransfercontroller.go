@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
+)
+
+type ScheduledTransferController struct {
+	createScheduledTransferUseCase usecases.CreateScheduledTransferUseCaseInterface
+	getScheduledTransfersUseCase   usecases.GetScheduledTransfersUseCaseInterface
+	getScheduledTransferUseCase    usecases.GetScheduledTransferUseCaseInterface
+	updateScheduledTransferUseCase usecases.UpdateScheduledTransferUseCaseInterface
+	cancelScheduledTransferUseCase usecases.CancelScheduledTransferUseCaseInterface
+}
+
+func NewScheduledTransferController(createScheduledTransferUseCase usecases.CreateScheduledTransferUseCaseInterface,
+	getScheduledTransfersUseCase usecases.GetScheduledTransfersUseCaseInterface,
+	getScheduledTransferUseCase usecases.GetScheduledTransferUseCaseInterface,
+	updateScheduledTransferUseCase usecases.UpdateScheduledTransferUseCaseInterface,
+	cancelScheduledTransferUseCase usecases.CancelScheduledTransferUseCaseInterface) *ScheduledTransferController {
+	return &ScheduledTransferController{
+		createScheduledTransferUseCase: createScheduledTransferUseCase,
+		getScheduledTransfersUseCase:   getScheduledTransfersUseCase,
+		getScheduledTransferUseCase:    getScheduledTransferUseCase,
+		updateScheduledTransferUseCase: updateScheduledTransferUseCase,
+		cancelScheduledTransferUseCase: cancelScheduledTransferUseCase,
+	}
+}
+
+func (c *ScheduledTransferController) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/account/:number/scheduled-transfers", middleware.NewAuthMiddleware("account"), c.createScheduledTransferHandler)
+	router.GET("/account/:number/scheduled-transfers", middleware.NewAuthMiddleware("account"), c.getScheduledTransfersHandler)
+	router.GET("/account/:number/scheduled-transfers/:id", middleware.NewAuthMiddleware("account"), c.getScheduledTransferHandler)
+	router.PUT("/account/:number/scheduled-transfers/:id", middleware.NewAuthMiddleware("account"), c.updateScheduledTransferHandler)
+	router.DELETE("/account/:number/scheduled-transfers/:id", middleware.NewAuthMiddleware("account"), c.cancelScheduledTransferHandler)
+}
+
+func (c *ScheduledTransferController) createScheduledTransferHandler(ctx *gin.Context) {
+	var req models.CreateScheduledTransferRequest
+	req.FromNumber = ctx.Param("number")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	scheduledTransfer, err := req.ToScheduledTransfer(middleware.Subject(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	id, err := c.createScheduledTransferUseCase.Handle(scheduledTransfer)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, models.NewCreateScheduledTransferResponse(id))
+}
+
+func (c *ScheduledTransferController) getScheduledTransfersHandler(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	scheduledTransfers, err := c.getScheduledTransfersUseCase.Handle(req.Number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetScheduledTransfersResponse(scheduledTransfers))
+}
+
+func (c *ScheduledTransferController) getScheduledTransferHandler(ctx *gin.Context) {
+	var req models.GetScheduledTransferRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	scheduledTransfer, err := c.getScheduledTransferUseCase.Handle(req.FromNumber, req.Id)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	if scheduledTransfer == nil {
+		ctx.Writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetScheduledTransferResponse(scheduledTransfer))
+}
+
+func (c *ScheduledTransferController) updateScheduledTransferHandler(ctx *gin.Context) {
+	var req models.UpdateScheduledTransferRequest
+	req.FromNumber = ctx.Param("number")
+	req.Id = ctx.Param("id")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.updateScheduledTransferUseCase.Handle(req.FromNumber, req.Id, req.Value, req.EndDate, req.Occurrences)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
+
+func (c *ScheduledTransferController) cancelScheduledTransferHandler(ctx *gin.Context) {
+	var req models.GetScheduledTransferRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.cancelScheduledTransferUseCase.Handle(req.FromNumber, req.Id)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
+)
+
+type TransferController struct {
+	reverseTransferUseCase usecases.ReverseTransferUseCaseInterface
+	transferBatchUseCase   usecases.TransferBatchUseCaseInterface
+}
+
+func NewTransferController(reverseTransferUseCase usecases.ReverseTransferUseCaseInterface,
+	transferBatchUseCase usecases.TransferBatchUseCaseInterface) *TransferController {
+	return &TransferController{
+		reverseTransferUseCase: reverseTransferUseCase,
+		transferBatchUseCase:   transferBatchUseCase,
+	}
+}
+
+func (c *TransferController) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/transfers/:id/reverse", middleware.NewAuthMiddleware("admin"), c.reverseTransferHandler)
+	router.POST("/account/:number/transfers/batch", middleware.NewAuthMiddleware("account"), c.transferBatchHandler)
+}
+
+func (c *TransferController) reverseTransferHandler(ctx *gin.Context) {
+	var req models.ReverseTransferRequest
+	req.TransferId = ctx.Param("id")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	outcome, err := c.reverseTransferUseCase.Handle(req.TransferId, req.Value, req.IdempotencyKey, middleware.Subject(ctx))
+	if err != nil {
+		ctx.JSON(idempotentErrorStatus(err), gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	writeIdempotentResponse(ctx, outcome)
+}
+
+// transferBatchHandler accepts the batch as JSON or as a multipart form with the mode field
+// and a CSV file in the file field.
+func (c *TransferController) transferBatchHandler(ctx *gin.Context) {
+	var req models.TransferBatchRequest
+	req.FromNumber = ctx.Param("number")
+
+	err := c.bindTransferBatchRequest(ctx, &req)
+	if err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	batch := req.ToTransferBatch(middleware.Subject(ctx))
+
+	err = c.transferBatchUseCase.Handle(batch)
+	if errors.Is(err, domain.ErrInvalidTransferBatch) {
+		response := models.NewTransferBatchResponse(batch)
+		response.ErrorMessage = err.Error()
+
+		ctx.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewTransferBatchResponse(batch))
+}
+
+func (c *TransferController) bindTransferBatchRequest(ctx *gin.Context, req *models.TransferBatchRequest) error {
+	if ctx.ContentType() != "multipart/form-data" {
+		return ctx.ShouldBindJSON(req)
+	}
+
+	err := ctx.ShouldBind(req)
+	if err != nil {
+		return err
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return err
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	req.Transfers, err = models.ParseTransferBatchCSV(file)
+
+	return err
+}
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/middleware"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/server/models"
+)
+
+type TransferRequestController struct {
+	getTransferRequestsUseCase    usecases.GetTransferRequestsUseCaseInterface
+	approveTransferRequestUseCase usecases.ApproveTransferRequestUseCaseInterface
+	rejectTransferRequestUseCase  usecases.RejectTransferRequestUseCaseInterface
+}
+
+func NewTransferRequestController(getTransferRequestsUseCase usecases.GetTransferRequestsUseCaseInterface,
+	approveTransferRequestUseCase usecases.ApproveTransferRequestUseCaseInterface,
+	rejectTransferRequestUseCase usecases.RejectTransferRequestUseCaseInterface) *TransferRequestController {
+	return &TransferRequestController{
+		getTransferRequestsUseCase:    getTransferRequestsUseCase,
+		approveTransferRequestUseCase: approveTransferRequestUseCase,
+		rejectTransferRequestUseCase:  rejectTransferRequestUseCase,
+	}
+}
+
+func (c *TransferRequestController) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/account/:number/transfer-requests", middleware.NewAuthMiddleware("account"), c.getTransferRequestsHandler)
+	router.POST("/transfer-requests/:id/approve", middleware.NewAuthMiddleware("approver"), c.approveTransferRequestHandler)
+	router.POST("/transfer-requests/:id/reject", middleware.NewAuthMiddleware("approver"), c.rejectTransferRequestHandler)
+}
+
+func (c *TransferRequestController) getTransferRequestsHandler(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	transferRequests, err := c.getTransferRequestsUseCase.Handle(req.Number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewGetTransferRequestsResponse(transferRequests))
+}
+
+func (c *TransferRequestController) approveTransferRequestHandler(ctx *gin.Context) {
+	var req models.TransferRequestRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.approveTransferRequestUseCase.Handle(req.Id, middleware.Subject(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
+
+func (c *TransferRequestController) rejectTransferRequestHandler(ctx *gin.Context) {
+	var req models.RejectTransferRequestRequest
+	req.Id = ctx.Param("id")
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		slog.Error(err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	err := c.rejectTransferRequestUseCase.Handle(req.Id, middleware.Subject(ctx), req.Reason)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"errorMessage": err.Error(),
+		})
+
+		return
+	}
+
+	ctx.Writer.WriteHeader(http.StatusNoContent)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/spf13/viper"
 )
 
+const subjectKey = "subject"
+
 func NewAuthMiddleware(requiredScope string) gin.HandlerFunc {
 	return checkAuthHandle(requiredScope)
 }
@@ -47,12 +49,16 @@ func checkAuthHandle(requiredScope string) gin.HandlerFunc {
 			return
 		}
 
-		if !hasRequiredScope(tokenParsed.Claims.(jwt.MapClaims), requiredScope) {
+		claims := tokenParsed.Claims.(jwt.MapClaims)
+		if !hasRequiredScope(claims, requiredScope) {
 			slog.Info("required scope to access not found")
 			c.AbortWithStatus(http.StatusForbidden)
 			return
 		}
 
+		subject, _ := claims.GetSubject()
+		c.Set(subjectKey, subject)
+
 		c.Next()
 	}
 }
@@ -84,3 +90,8 @@ func hasRequiredScope(claims jwt.MapClaims, requiredScope string) bool {
 
 	return wasFound
 }
+
+// Subject returns the subject of the token authorized by the middleware, the user making the request.
+func Subject(c *gin.Context) string {
+	return c.GetString(subjectKey)
+}
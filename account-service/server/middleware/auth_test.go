@@ -120,3 +120,33 @@ func TestAuthHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthHandler_SetsSubject(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.ReleaseMode)
+
+	defer viper.Reset()
+	viper.Set("authSettings.secret", "123456")
+
+	subject := ""
+
+	router := gin.New()
+	router.Use(NewAuthMiddleware("ABC"))
+	router.GET("/test", func(c *gin.Context) {
+		subject = Subject(c)
+		c.String(http.StatusOK, "Hello, World!")
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	req.Header = map[string][]string{
+		"Authorization": {generateTestJWTToken("123456", 1, "ABC")},
+	}
+	recorder := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(recorder, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "123456", subject)
+}
@@ -0,0 +1,8 @@
+package models
+
+type CaptureHoldRequest struct {
+	Number   string `uri:"number" binding:"required,accountnumber"`
+	HoldId   string `uri:"id" binding:"required,numeric"`
+	Value    int64  `json:"value"`
+	ToNumber string `json:"toNumber" binding:"omitempty,accountnumber"`
+}
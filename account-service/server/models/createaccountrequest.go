@@ -1,6 +1,32 @@
 package models
 
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+// CreateAccountRequest opens an account for holders, or for the single holder of document
+// and name when no holders are informed.
 type CreateAccountRequest struct {
+	Document string                       `json:"document"`
+	Name     string                       `json:"name"`
+	Holders  []CreateAccountHolderRequest `json:"holders"`
+	Currency string                       `json:"currency"`
+	Type     string                       `json:"type"`
+}
+
+type CreateAccountHolderRequest struct {
 	Document string `json:"document"`
 	Name     string `json:"name"`
+	Role     string `json:"role"`
+}
+
+func (r *CreateAccountRequest) ToHolders() []*domain.AccountHolder {
+	if len(r.Holders) == 0 {
+		return []*domain.AccountHolder{domain.NewAccountHolder(r.Document, r.Name, domain.AccountHolderRolePrimary)}
+	}
+
+	holders := []*domain.AccountHolder{}
+	for _, holder := range r.Holders {
+		holders = append(holders, domain.NewAccountHolder(holder.Document, holder.Name, domain.AccountHolderRole(holder.Role)))
+	}
+
+	return holders
 }
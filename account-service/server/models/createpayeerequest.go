@@ -0,0 +1,8 @@
+package models
+
+type CreatePayeeRequest struct {
+	Number   string `uri:"number" binding:"required,accountnumber"`
+	Nickname string `json:"nickname" binding:"required"`
+	ToNumber string `json:"toNumber" binding:"required_without=PixKey,excluded_with=PixKey,omitempty,accountnumber"`
+	PixKey   string `json:"pixKey"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type CreatePocketRequest struct {
+	Number string `uri:"number" binding:"required,accountnumber"`
+	Name   string `json:"name" binding:"required"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type CreateScheduledTransferRequest struct {
+	FromNumber  string     `uri:"number" binding:"required,accountnumber"`
+	ToNumber    string     `json:"toNumber" binding:"required,accountnumber"`
+	Value       int64      `json:"value" binding:"required"`
+	Frequency   string     `json:"frequency" binding:"required"`
+	StartAt     time.Time  `json:"startAt" binding:"required"`
+	DayOfMonth  int        `json:"dayOfMonth"`
+	EndDate     *time.Time `json:"endDate"`
+	Occurrences int        `json:"occurrences"`
+}
+
+func (r *CreateScheduledTransferRequest) ToScheduledTransfer(createdBy string) (*domain.ScheduledTransfer, error) {
+	return domain.NewScheduledTransfer(r.FromNumber, r.ToNumber, r.Value, domain.ScheduledTransferFrequency(r.Frequency),
+		r.StartAt, r.DayOfMonth, r.EndDate, r.Occurrences, createdBy)
+}
@@ -0,0 +1,11 @@
+package models
+
+type CreateScheduledTransferResponse struct {
+	Id string `json:"id"`
+}
+
+func NewCreateScheduledTransferResponse(id string) *CreateScheduledTransferResponse {
+	return &CreateScheduledTransferResponse{
+		Id: id,
+	}
+}
@@ -0,0 +1,6 @@
+package models
+
+type DeletePayeeRequest struct {
+	Number string `uri:"number" binding:"required,accountnumber"`
+	Id     string `uri:"id" binding:"required"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type DeletePixKeyRequest struct {
+	Number string `uri:"number" binding:"required,accountnumber"`
+	Key    string `uri:"key" binding:"required"`
+}
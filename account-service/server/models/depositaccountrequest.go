@@ -1,7 +1,9 @@
 package models
 
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
 type DepositAccountRequest struct {
-	Number         string `uri:"number" binding:"required"`
-	Value          int64  `json:"value" binding:"required"`
-	IdempotencyKey string `json:"idempotencyKey" binding:"required"`
+	Number         string       `uri:"number" binding:"required,accountnumber"`
+	Value          domain.Money `json:"value"`
+	IdempotencyKey string       `json:"idempotencyKey" binding:"required,max=40"`
 }
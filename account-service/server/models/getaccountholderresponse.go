@@ -0,0 +1,23 @@
+package models
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+type GetAccountHolderResponse struct {
+	Document string `json:"document"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+}
+
+func NewGetAccountHoldersResponse(holders []*domain.AccountHolder) []*GetAccountHolderResponse {
+	response := []*GetAccountHolderResponse{}
+
+	for _, holder := range holders {
+		response = append(response, &GetAccountHolderResponse{
+			Document: holder.Document,
+			Name:     holder.Name,
+			Role:     string(holder.Role),
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type GetAccountProfileChangeResponse struct {
+	Id        string    `json:"id"`
+	Field     string    `json:"field"`
+	OldValue  string    `json:"oldValue"`
+	NewValue  string    `json:"newValue"`
+	ChangedBy string    `json:"changedBy"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+func NewGetAccountProfileChangesResponse(changes []*domain.AccountProfileChange) []*GetAccountProfileChangeResponse {
+	response := []*GetAccountProfileChangeResponse{}
+
+	for _, change := range changes {
+		response = append(response, &GetAccountProfileChangeResponse{
+			Id:        change.Id,
+			Field:     change.Field,
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+
+	return response
+}
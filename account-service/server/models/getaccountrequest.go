@@ -1,5 +1,5 @@
 package models
 
 type GetAccountRequest struct {
-	Number string `uri:"number" binding:"required"`
+	Number string `uri:"number" binding:"required,accountnumber"`
 }
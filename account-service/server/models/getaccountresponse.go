@@ -7,19 +7,65 @@ import (
 )
 
 type GetAccountResponse struct {
-	Number    string    `json:"number"`
-	Document  string    `json:"document"`
-	Balance   int64     `json:"balance"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	Number                  string                      `json:"number"`
+	Name                    string                      `json:"name"`
+	Email                   string                      `json:"email,omitempty"`
+	Phone                   string                      `json:"phone,omitempty"`
+	Address                 string                      `json:"address,omitempty"`
+	Document                string                      `json:"document"`
+	DocumentType            string                      `json:"documentType"`
+	Holders                 []*GetAccountHolderResponse `json:"holders"`
+	Type                    string                      `json:"type"`
+	Currency                string                      `json:"currency"`
+	Balance                 int64                       `json:"balance"`
+	LedgerBalance           int64                       `json:"ledgerBalance"`
+	HeldBalance             int64                       `json:"heldBalance"`
+	PocketBalance           int64                       `json:"pocketBalance"`
+	MainBalance             int64                       `json:"mainBalance"`
+	AccruedInterest         int64                       `json:"accruedInterest"`
+	AvailableBalance        int64                       `json:"availableBalance"`
+	OverdraftLimit          int64                       `json:"overdraftLimit"`
+	AvailableOverdraftLimit int64                       `json:"availableOverdraftLimit"`
+	Status                  string                      `json:"status"`
+	Pockets                 []*GetPocketResponse        `json:"pockets,omitempty"`
+	CreatedAt               time.Time                   `json:"createdAt"`
+	UpdatedAt               time.Time                   `json:"updatedAt"`
 }
 
-func NewGetAccountResponse(acc *domain.Account) *GetAccountResponse {
+func NewGetAccountResponse(acc *domain.Account, pockets []*domain.Pocket) *GetAccountResponse {
 	return &GetAccountResponse{
-		Number:    acc.Number,
-		Document:  acc.Document,
-		Balance:   acc.Balance,
-		CreatedAt: acc.CreatedAt,
-		UpdatedAt: acc.UpdatedAt,
+		Number:                  acc.Number,
+		Name:                    acc.Name,
+		Email:                   acc.Email,
+		Phone:                   acc.Phone,
+		Address:                 acc.Address,
+		Document:                acc.Document,
+		DocumentType:            string(acc.DocumentType()),
+		Holders:                 NewGetAccountHoldersResponse(acc.AccountHolders()),
+		Type:                    string(acc.Type),
+		Currency:                string(acc.Currency),
+		Balance:                 acc.Balance,
+		LedgerBalance:           acc.Balance,
+		HeldBalance:             acc.HeldBalance,
+		PocketBalance:           acc.PocketBalance,
+		MainBalance:             acc.MainBalance(),
+		AccruedInterest:         acc.AccruedInterest,
+		AvailableBalance:        acc.AvailableBalance(),
+		OverdraftLimit:          acc.OverdraftLimit,
+		AvailableOverdraftLimit: acc.AvailableOverdraftLimit(),
+		Status:                  string(acc.Status),
+		Pockets:                 NewGetPocketsResponse(pockets),
+		CreatedAt:               acc.CreatedAt,
+		UpdatedAt:               acc.UpdatedAt,
 	}
 }
+
+func NewGetAccountsResponse(accounts []*domain.Account) []*GetAccountResponse {
+	response := []*GetAccountResponse{}
+
+	for _, acc := range accounts {
+		response = append(response, NewGetAccountResponse(acc, nil))
+	}
+
+	return response
+}
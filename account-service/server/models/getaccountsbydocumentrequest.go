@@ -0,0 +1,5 @@
+package models
+
+type GetAccountsByDocumentRequest struct {
+	Document string `form:"document" binding:"required"`
+}
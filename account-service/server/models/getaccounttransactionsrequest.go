@@ -0,0 +1,50 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+const transactionsDateLayout = "2006-01-02"
+
+type GetAccountTransactionsRequest struct {
+	Number string   `uri:"number" binding:"required,accountnumber"`
+	Cursor int64    `form:"cursor"`
+	Limit  int      `form:"limit"`
+	From   string   `form:"from"`
+	To     string   `form:"to"`
+	Types  []string `form:"type"`
+}
+
+// ToFilter converts the request to a transactions filter, the to date is inclusive.
+func (r *GetAccountTransactionsRequest) ToFilter() (domain.AccountTransactionsFilter, error) {
+	filter := domain.AccountTransactionsFilter{
+		AccountNumber: r.Number,
+		Cursor:        r.Cursor,
+		Limit:         r.Limit,
+		Types:         r.Types,
+	}
+
+	if r.From != "" {
+		from, err := time.Parse(transactionsDateLayout, r.From)
+		if err != nil {
+			return filter, fmt.Errorf("invalid from date, expected format %v", transactionsDateLayout)
+		}
+
+		filter.From = &from
+	}
+
+	if r.To != "" {
+		to, err := time.Parse(transactionsDateLayout, r.To)
+		if err != nil {
+			return filter, fmt.Errorf("invalid to date, expected format %v", transactionsDateLayout)
+		}
+
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	return filter, nil
+}
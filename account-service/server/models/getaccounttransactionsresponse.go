@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type AccountTransactionResponse struct {
+	TransactionId string    `json:"transactionId"`
+	Type          string    `json:"type"`
+	Counterparty  string    `json:"counterparty,omitempty"`
+	Amount        int64     `json:"amount"`
+	Balance       int64     `json:"balance"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type GetAccountTransactionsResponse struct {
+	Transactions []AccountTransactionResponse `json:"transactions"`
+	NextCursor   int64                        `json:"nextCursor,omitempty"`
+}
+
+func NewGetAccountTransactionsResponse(page *domain.AccountTransactionsPage) *GetAccountTransactionsResponse {
+	response := &GetAccountTransactionsResponse{
+		Transactions: []AccountTransactionResponse{},
+		NextCursor:   page.NextCursor,
+	}
+
+	for _, transaction := range page.Transactions {
+		response.Transactions = append(response.Transactions, AccountTransactionResponse{
+			TransactionId: transaction.TransactionId,
+			Type:          transaction.Type,
+			Counterparty:  transaction.Counterparty,
+			Amount:        transaction.Amount,
+			Balance:       transaction.Balance,
+			CreatedAt:     transaction.CreatedAt,
+		})
+	}
+
+	return response
+}
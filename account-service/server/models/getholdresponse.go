@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type GetHoldResponse struct {
+	Id             string    `json:"id"`
+	Amount         int64     `json:"amount"`
+	CapturedAmount int64     `json:"capturedAmount"`
+	Description    string    `json:"description"`
+	Status         string    `json:"status"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+func NewGetHoldResponse(hold *domain.Hold) *GetHoldResponse {
+	return &GetHoldResponse{
+		Id:             hold.Id,
+		Amount:         hold.Amount,
+		CapturedAmount: hold.CapturedAmount,
+		Description:    hold.Description,
+		Status:         string(hold.Status),
+		ExpiresAt:      hold.ExpiresAt,
+		CreatedAt:      hold.CreatedAt,
+		UpdatedAt:      hold.UpdatedAt,
+	}
+}
+
+func NewGetHoldsResponse(holds []*domain.Hold) []*GetHoldResponse {
+	response := []*GetHoldResponse{}
+
+	for _, hold := range holds {
+		response = append(response, NewGetHoldResponse(hold))
+	}
+
+	return response
+}
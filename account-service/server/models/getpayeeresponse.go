@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type GetPayeeResponse struct {
+	Id        string    `json:"id"`
+	Nickname  string    `json:"nickname"`
+	ToNumber  string    `json:"toNumber"`
+	PixKey    string    `json:"pixKey,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func NewGetPayeeResponse(payee *domain.Payee) *GetPayeeResponse {
+	return &GetPayeeResponse{
+		Id:        payee.Id,
+		Nickname:  payee.Nickname,
+		ToNumber:  payee.ToNumber,
+		PixKey:    payee.PixKey,
+		CreatedAt: payee.CreatedAt,
+		UpdatedAt: payee.UpdatedAt,
+	}
+}
+
+func NewGetPayeesResponse(payees []*domain.Payee) []*GetPayeeResponse {
+	response := []*GetPayeeResponse{}
+
+	for _, payee := range payees {
+		response = append(response, NewGetPayeeResponse(payee))
+	}
+
+	return response
+}
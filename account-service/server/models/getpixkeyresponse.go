@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type GetPixKeyResponse struct {
+	Id        string    `json:"id"`
+	Type      string    `json:"type"`
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func NewGetPixKeyResponse(pixKey *domain.PixKey) *GetPixKeyResponse {
+	return &GetPixKeyResponse{
+		Id:        pixKey.Id,
+		Type:      string(pixKey.Type),
+		Key:       pixKey.Key,
+		CreatedAt: pixKey.CreatedAt,
+	}
+}
+
+func NewGetPixKeysResponse(pixKeys []*domain.PixKey) []*GetPixKeyResponse {
+	response := []*GetPixKeyResponse{}
+
+	for _, pixKey := range pixKeys {
+		response = append(response, NewGetPixKeyResponse(pixKey))
+	}
+
+	return response
+}
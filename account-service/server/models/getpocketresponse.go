@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type GetPocketResponse struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Balance   int64     `json:"balance"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func NewGetPocketResponse(pocket *domain.Pocket) *GetPocketResponse {
+	return &GetPocketResponse{
+		Id:        pocket.Id,
+		Name:      pocket.Name,
+		Balance:   pocket.Balance,
+		Status:    string(pocket.Status),
+		CreatedAt: pocket.CreatedAt,
+		UpdatedAt: pocket.UpdatedAt,
+	}
+}
+
+func NewGetPocketsResponse(pockets []*domain.Pocket) []*GetPocketResponse {
+	response := []*GetPocketResponse{}
+
+	for _, pocket := range pockets {
+		response = append(response, NewGetPocketResponse(pocket))
+	}
+
+	return response
+}
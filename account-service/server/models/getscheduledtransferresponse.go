@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type GetScheduledTransferResponse struct {
+	Id                    string     `json:"id"`
+	ToNumber              string     `json:"toNumber"`
+	Value                 int64      `json:"value"`
+	Frequency             string     `json:"frequency"`
+	DayOfMonth            int        `json:"dayOfMonth,omitempty"`
+	EndDate               *time.Time `json:"endDate,omitempty"`
+	MaxOccurrences        int        `json:"occurrences,omitempty"`
+	Occurrences           int        `json:"executedOccurrences"`
+	FailedOccurrences     int        `json:"failedOccurrences"`
+	PendingOccurrences    int        `json:"pendingApprovalOccurrences"`
+	LastTransferRequestId string     `json:"lastTransferRequestId,omitempty"`
+	NextRunAt             *time.Time `json:"nextRunAt,omitempty"`
+	Attempts              int        `json:"attempts"`
+	LastError             string     `json:"lastError,omitempty"`
+	LastAttemptAt         *time.Time `json:"lastAttemptAt,omitempty"`
+	Status                string     `json:"status"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	UpdatedAt             time.Time  `json:"updatedAt"`
+}
+
+func NewGetScheduledTransferResponse(st *domain.ScheduledTransfer) *GetScheduledTransferResponse {
+	response := &GetScheduledTransferResponse{
+		Id:                    st.Id,
+		ToNumber:              st.ToNumber,
+		Value:                 st.Value,
+		Frequency:             string(st.Frequency),
+		DayOfMonth:            st.DayOfMonth,
+		EndDate:               st.EndDate,
+		MaxOccurrences:        st.MaxOccurrences,
+		Occurrences:           st.Occurrences,
+		FailedOccurrences:     st.FailedOccurrences,
+		PendingOccurrences:    st.PendingOccurrences,
+		LastTransferRequestId: st.LastTransferRequestId,
+		Attempts:              st.Attempts,
+		LastError:             st.LastError,
+		LastAttemptAt:         st.LastAttemptAt,
+		Status:                string(st.Status),
+		CreatedAt:             st.CreatedAt,
+		UpdatedAt:             st.UpdatedAt,
+	}
+
+	if st.Status == domain.ScheduledTransferActive {
+		response.NextRunAt = &st.NextRunAt
+	}
+
+	return response
+}
+
+func NewGetScheduledTransfersResponse(scheduledTransfers []*domain.ScheduledTransfer) []*GetScheduledTransferResponse {
+	response := []*GetScheduledTransferResponse{}
+
+	for _, st := range scheduledTransfers {
+		response = append(response, NewGetScheduledTransferResponse(st))
+	}
+
+	return response
+}
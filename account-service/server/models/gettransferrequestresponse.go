@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type GetTransferRequestResponse struct {
+	Id              string    `json:"id"`
+	ToNumber        string    `json:"toNumber"`
+	PixKey          string    `json:"pixKey,omitempty"`
+	Value           int64     `json:"value"`
+	Status          string    `json:"status"`
+	RequestedBy     string    `json:"requestedBy"`
+	ReviewedBy      string    `json:"reviewedBy,omitempty"`
+	RejectionReason string    `json:"rejectionReason,omitempty"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+func NewGetTransferRequestResponse(transferRequest *domain.TransferRequest) *GetTransferRequestResponse {
+	return &GetTransferRequestResponse{
+		Id:              transferRequest.Id,
+		ToNumber:        transferRequest.ToNumber,
+		PixKey:          transferRequest.PixKey,
+		Value:           transferRequest.Value,
+		Status:          string(transferRequest.Status),
+		RequestedBy:     transferRequest.RequestedBy,
+		ReviewedBy:      transferRequest.ReviewedBy,
+		RejectionReason: transferRequest.RejectionReason,
+		ExpiresAt:       transferRequest.ExpiresAt,
+		CreatedAt:       transferRequest.CreatedAt,
+		UpdatedAt:       transferRequest.UpdatedAt,
+	}
+}
+
+func NewGetTransferRequestsResponse(transferRequests []*domain.TransferRequest) []*GetTransferRequestResponse {
+	response := []*GetTransferRequestResponse{}
+
+	for _, transferRequest := range transferRequests {
+		response = append(response, NewGetTransferRequestResponse(transferRequest))
+	}
+
+	return response
+}
@@ -0,0 +1,6 @@
+package models
+
+type HoldRequest struct {
+	Number string `uri:"number" binding:"required,accountnumber"`
+	HoldId string `uri:"id" binding:"required,numeric"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+type MovePocketFundsRequest struct {
+	Number         string       `uri:"number" binding:"required,accountnumber"`
+	PocketId       string       `uri:"id" binding:"required,numeric"`
+	Direction      string       `json:"direction" binding:"required,oneof=in out"`
+	Value          domain.Money `json:"value"`
+	IdempotencyKey string       `json:"idempotencyKey" binding:"required,max=40"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type PlaceHoldRequest struct {
+	Number         string `uri:"number" binding:"required,accountnumber"`
+	Amount         int64  `json:"amount" binding:"required"`
+	Description    string `json:"description" binding:"max=140"`
+	IdempotencyKey string `json:"idempotencyKey" binding:"required,max=40"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type PocketRequest struct {
+	Number   string `uri:"number" binding:"required,accountnumber"`
+	PocketId string `uri:"id" binding:"required,numeric"`
+}
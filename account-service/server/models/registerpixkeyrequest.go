@@ -0,0 +1,7 @@
+package models
+
+type RegisterPixKeyRequest struct {
+	Number string `uri:"number" binding:"required,accountnumber"`
+	Type   string `json:"type" binding:"required"`
+	Key    string `json:"key"`
+}
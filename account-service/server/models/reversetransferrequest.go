@@ -0,0 +1,7 @@
+package models
+
+type ReverseTransferRequest struct {
+	TransferId     string `uri:"id" binding:"required,numeric"`
+	Value          int64  `json:"value"`
+	IdempotencyKey string `json:"idempotencyKey" binding:"required,max=40"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type SetOverdraftLimitRequest struct {
+	Number         string `uri:"number" binding:"required,accountnumber"`
+	OverdraftLimit *int64 `json:"overdraftLimit" binding:"required"`
+}
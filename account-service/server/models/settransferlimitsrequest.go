@@ -0,0 +1,18 @@
+package models
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+type SetTransferLimitsRequest struct {
+	Number         string `uri:"number" binding:"required,accountnumber"`
+	PerTransaction int64  `json:"perTransaction"`
+	Daily          int64  `json:"daily"`
+	Nightly        int64  `json:"nightly"`
+}
+
+func (r *SetTransferLimitsRequest) ToTransferLimits() domain.TransferLimits {
+	return domain.TransferLimits{
+		PerTransaction: r.PerTransaction,
+		Daily:          r.Daily,
+		Nightly:        r.Nightly,
+	}
+}
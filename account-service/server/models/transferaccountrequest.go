@@ -1,8 +1,12 @@
 package models
 
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
 type TransferAccountRequest struct {
-	FromNumber     string `uri:"fromNumber" binding:"required"`
-	ToNumber       string `uri:"toNumber" binding:"required"`
-	Value          int64  `json:"value" binding:"required"`
-	IdempotencyKey string `json:"idempotencyKey" binding:"required"`
+	FromNumber     string       `uri:"fromNumber" binding:"required,accountnumber"`
+	ToNumber       string       `uri:"toNumber" binding:"required_without_all=PixKey PayeeId,excluded_with=PixKey PayeeId,omitempty,accountnumber"`
+	PixKey         string       `json:"pixKey" binding:"excluded_with=PayeeId"`
+	PayeeId        string       `json:"payeeId"`
+	Value          domain.Money `json:"value"`
+	IdempotencyKey string       `json:"idempotencyKey" binding:"required,max=40"`
 }
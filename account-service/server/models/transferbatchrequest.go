@@ -0,0 +1,99 @@
+package models
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type TransferBatchRequest struct {
+	FromNumber string                     `uri:"number" binding:"required,accountnumber"`
+	Mode       string                     `json:"mode" form:"mode" binding:"required"`
+	Transfers  []TransferBatchLineRequest `json:"transfers"`
+}
+
+type TransferBatchLineRequest struct {
+	ToNumber       string `json:"toNumber"`
+	PixKey         string `json:"pixKey"`
+	Value          int64  `json:"value"`
+	IdempotencyKey string `json:"idempotencyKey"`
+}
+
+func (r *TransferBatchRequest) ToTransferBatch(requestedBy string) *domain.TransferBatch {
+	lines := []*domain.TransferBatchLine{}
+
+	for _, transfer := range r.Transfers {
+		lines = append(lines, &domain.TransferBatchLine{
+			ToNumber:       transfer.ToNumber,
+			PixKey:         transfer.PixKey,
+			Value:          transfer.Value,
+			IdempotencyKey: transfer.IdempotencyKey,
+		})
+	}
+
+	return domain.NewTransferBatch(r.FromNumber, domain.TransferBatchMode(r.Mode), requestedBy, lines)
+}
+
+// ParseTransferBatchCSV reads the transfers of a CSV file whose header names the columns
+// toNumber, pixKey, value and idempotencyKey. The pixKey column is optional.
+func ParseTransferBatchCSV(file io.Reader) ([]TransferBatchLineRequest, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("csv file must have a header with the columns toNumber, pixKey, value and idempotencyKey")
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	for _, required := range []string{"toNumber", "value", "idempotencyKey"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("csv file must have the %v column", required)
+		}
+	}
+
+	column := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	transfers := []TransferBatchLineRequest{}
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv line %v: %w", line, err)
+		}
+
+		value, err := strconv.ParseInt(column(record, "value"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value on csv line %v", line)
+		}
+
+		transfers = append(transfers, TransferBatchLineRequest{
+			ToNumber:       column(record, "toNumber"),
+			PixKey:         column(record, "pixKey"),
+			Value:          value,
+			IdempotencyKey: column(record, "idempotencyKey"),
+		})
+	}
+
+	return transfers, nil
+}
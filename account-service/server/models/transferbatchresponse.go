@@ -0,0 +1,46 @@
+package models
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+type TransferBatchResponse struct {
+	ErrorMessage string                       `json:"errorMessage,omitempty"`
+	Mode         string                       `json:"mode"`
+	Succeeded    int                          `json:"succeeded"`
+	Pending      int                          `json:"pendingApproval"`
+	Failed       int                          `json:"failed"`
+	Lines        []*TransferBatchLineResponse `json:"lines"`
+}
+
+type TransferBatchLineResponse struct {
+	Line           int    `json:"line"`
+	ToNumber       string `json:"toNumber,omitempty"`
+	PixKey         string `json:"pixKey,omitempty"`
+	Value          int64  `json:"value"`
+	IdempotencyKey string `json:"idempotencyKey"`
+	Status         string `json:"status"`
+	ErrorMessage   string `json:"errorMessage,omitempty"`
+}
+
+func NewTransferBatchResponse(batch *domain.TransferBatch) *TransferBatchResponse {
+	response := &TransferBatchResponse{
+		Mode:      string(batch.Mode),
+		Succeeded: batch.Count(domain.TransferBatchLineSucceeded),
+		Pending:   batch.Count(domain.TransferBatchLinePendingApproval),
+		Failed:    batch.Count(domain.TransferBatchLineFailed) + batch.Count(domain.TransferBatchLineInvalid),
+		Lines:     []*TransferBatchLineResponse{},
+	}
+
+	for _, line := range batch.Lines {
+		response.Lines = append(response.Lines, &TransferBatchLineResponse{
+			Line:           line.Line,
+			ToNumber:       line.ToNumber,
+			PixKey:         line.PixKey,
+			Value:          line.Value,
+			IdempotencyKey: line.IdempotencyKey,
+			Status:         string(line.Status),
+			ErrorMessage:   line.Error,
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,10 @@
+package models
+
+type TransferRequestRequest struct {
+	Id string `uri:"id" binding:"required,numeric"`
+}
+
+type RejectTransferRequestRequest struct {
+	Id     string `uri:"id" binding:"required,numeric"`
+	Reason string `json:"reason" binding:"required,max=140"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+// UpdateAccountProfileRequest only changes the fields informed, an empty e-mail, phone or
+// address removes it.
+type UpdateAccountProfileRequest struct {
+	Number  string  `uri:"number" binding:"required,accountnumber"`
+	Name    *string `json:"name"`
+	Email   *string `json:"email"`
+	Phone   *string `json:"phone"`
+	Address *string `json:"address"`
+}
+
+func (r *UpdateAccountProfileRequest) ToUpdate() domain.AccountProfileUpdate {
+	return domain.AccountProfileUpdate{
+		Name:    r.Name,
+		Email:   r.Email,
+		Phone:   r.Phone,
+		Address: r.Address,
+	}
+}
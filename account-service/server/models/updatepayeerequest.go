@@ -0,0 +1,7 @@
+package models
+
+type UpdatePayeeRequest struct {
+	Number   string `uri:"number" binding:"required,accountnumber"`
+	Id       string `uri:"id" binding:"required"`
+	Nickname string `json:"nickname" binding:"required"`
+}
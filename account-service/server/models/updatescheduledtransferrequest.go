@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type UpdateScheduledTransferRequest struct {
+	FromNumber  string     `uri:"number" binding:"required,accountnumber"`
+	Id          string     `uri:"id" binding:"required"`
+	Value       int64      `json:"value" binding:"required"`
+	EndDate     *time.Time `json:"endDate"`
+	Occurrences int        `json:"occurrences"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+// RegisterValidators adds the custom binding tags used by the request models, like
+// accountnumber that checks the check digit of account numbers, accepting the legacy ones.
+func RegisterValidators(accountNumberPolicy domain.AccountNumberPolicy) error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return nil
+	}
+
+	return v.RegisterValidation("accountnumber", func(fl validator.FieldLevel) bool {
+		return accountNumberPolicy.ValidateNumber(fl.Field().String()) == nil
+	})
+}
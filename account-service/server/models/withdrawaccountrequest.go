@@ -0,0 +1,9 @@
+package models
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+type WithdrawAccountRequest struct {
+	Number         string       `uri:"number" binding:"required,accountnumber"`
+	Value          domain.Money `json:"value"`
+	IdempotencyKey string       `json:"idempotencyKey" binding:"required,max=40"`
+}
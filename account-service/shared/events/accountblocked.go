@@ -0,0 +1,11 @@
+package events
+
+type AccountBlocked struct {
+	Number string `json:"number"`
+}
+
+func NewAccountBlocked(number string) *AccountBlocked {
+	return &AccountBlocked{
+		Number: number,
+	}
+}
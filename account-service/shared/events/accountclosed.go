@@ -0,0 +1,11 @@
+package events
+
+type AccountClosed struct {
+	Number string `json:"number"`
+}
+
+func NewAccountClosed(number string) *AccountClosed {
+	return &AccountClosed{
+		Number: number,
+	}
+}
@@ -1,15 +1,26 @@
 package events
 
 type AccountCreated struct {
-	Number   string `json:"number"`
-	Name     string `json:"name"`
+	Number   string          `json:"number"`
+	Name     string          `json:"name"`
+	Document string          `json:"document"`
+	Currency string          `json:"currency"`
+	Holders  []AccountHolder `json:"holders"`
+}
+
+// AccountHolder is a holder of the account, the primary holder is the one named by the account.
+type AccountHolder struct {
 	Document string `json:"document"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
 }
 
-func NewAccountCreated(number, name, document string) *AccountCreated {
+func NewAccountCreated(number, name, document, currency string, holders []AccountHolder) *AccountCreated {
 	return &AccountCreated{
 		Number:   number,
 		Name:     name,
 		Document: document,
+		Currency: currency,
+		Holders:  holders,
 	}
 }
@@ -0,0 +1,15 @@
+package events
+
+type AccountEnteredOverdraft struct {
+	Number         string `json:"number"`
+	Balance        int64  `json:"balance"`
+	OverdraftLimit int64  `json:"overdraftLimit"`
+}
+
+func NewAccountEnteredOverdraft(number string, balance int64, overdraftLimit int64) *AccountEnteredOverdraft {
+	return &AccountEnteredOverdraft{
+		Number:         number,
+		Balance:        balance,
+		OverdraftLimit: overdraftLimit,
+	}
+}
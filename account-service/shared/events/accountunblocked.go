@@ -0,0 +1,11 @@
+package events
+
+type AccountUnblocked struct {
+	Number string `json:"number"`
+}
+
+func NewAccountUnblocked(number string) *AccountUnblocked {
+	return &AccountUnblocked{
+		Number: number,
+	}
+}
@@ -0,0 +1,23 @@
+package events
+
+// AccountUpdated carries the whole profile of the account after the change, so consumers do
+// not depend on the order events arrive, and the fields that changed.
+type AccountUpdated struct {
+	Number        string   `json:"number"`
+	Name          string   `json:"name"`
+	Email         string   `json:"email"`
+	Phone         string   `json:"phone"`
+	Address       string   `json:"address"`
+	ChangedFields []string `json:"changedFields"`
+}
+
+func NewAccountUpdated(number, name, email, phone, address string, changedFields []string) *AccountUpdated {
+	return &AccountUpdated{
+		Number:        number,
+		Name:          name,
+		Email:         email,
+		Phone:         phone,
+		Address:       address,
+		ChangedFields: changedFields,
+	}
+}
@@ -8,9 +8,9 @@ import (
 
 func TestNewEventPublish_success(t *testing.T) {
 	// Arrange
-	event := NewAccountCreated("1", "name 1", "01234567890")
+	event := NewAccountCreated("1", "name 1", "01234567890", "BRL", []AccountHolder{{Document: "01234567890", Name: "name 1", Role: "primary"}})
 	expectedType := "AccountCreated"
-	expectedData := `{"number":"1","name":"name 1","document":"01234567890"}`
+	expectedData := `{"number":"1","name":"name 1","document":"01234567890","currency":"BRL","holders":[{"document":"01234567890","name":"name 1","role":"primary"}]}`
 
 	// Act
 	result, err := NewEventPublish(event)
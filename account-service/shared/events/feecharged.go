@@ -0,0 +1,23 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+type FeeCharged struct {
+	Number              string              `json:"number"`
+	Operation           domain.FeeOperation `json:"operation"`
+	Value               domain.Money        `json:"value"`
+	Balance             domain.Money        `json:"balance"`
+	TransactionId       string              `json:"transactionId"`
+	OriginTransactionId string              `json:"originTransactionId,omitempty"`
+}
+
+func NewFeeCharged(number string, operation domain.FeeOperation, value domain.Money, balance domain.Money, transactionId string, originTransactionId string) *FeeCharged {
+	return &FeeCharged{
+		Number:              number,
+		Operation:           operation,
+		Value:               value,
+		Balance:             balance,
+		TransactionId:       transactionId,
+		OriginTransactionId: originTransactionId,
+	}
+}
@@ -1,11 +1,13 @@
 package events
 
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
 type FundsDeposited struct {
-	Number string `json:"number"`
-	Value  int64  `json:"value"`
+	Number string       `json:"number"`
+	Value  domain.Money `json:"value"`
 }
 
-func NewFundsDeposited(number string, value int64) *FundsDeposited {
+func NewFundsDeposited(number string, value domain.Money) *FundsDeposited {
 	return &FundsDeposited{
 		Number: number,
 		Value:  value,
@@ -0,0 +1,15 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+type FundsWithdrawn struct {
+	Number string       `json:"number"`
+	Value  domain.Money `json:"value"`
+}
+
+func NewFundsWithdrawn(number string, value domain.Money) *FundsWithdrawn {
+	return &FundsWithdrawn{
+		Number: number,
+		Value:  value,
+	}
+}
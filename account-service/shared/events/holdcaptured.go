@@ -0,0 +1,19 @@
+package events
+
+type HoldCaptured struct {
+	Number        string `json:"number"`
+	HoldId        string `json:"holdId"`
+	Value         int64  `json:"value"`
+	ToNumber      string `json:"toNumber,omitempty"`
+	TransactionId string `json:"transactionId"`
+}
+
+func NewHoldCaptured(number string, holdId string, value int64, toNumber string, transactionId string) *HoldCaptured {
+	return &HoldCaptured{
+		Number:        number,
+		HoldId:        holdId,
+		Value:         value,
+		ToNumber:      toNumber,
+		TransactionId: transactionId,
+	}
+}
@@ -0,0 +1,19 @@
+package events
+
+import "time"
+
+type HoldPlaced struct {
+	Number    string    `json:"number"`
+	HoldId    string    `json:"holdId"`
+	Amount    int64     `json:"amount"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func NewHoldPlaced(number string, holdId string, amount int64, expiresAt time.Time) *HoldPlaced {
+	return &HoldPlaced{
+		Number:    number,
+		HoldId:    holdId,
+		Amount:    amount,
+		ExpiresAt: expiresAt,
+	}
+}
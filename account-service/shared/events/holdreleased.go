@@ -0,0 +1,17 @@
+package events
+
+type HoldReleased struct {
+	Number string `json:"number"`
+	HoldId string `json:"holdId"`
+	Amount int64  `json:"amount"`
+	Status string `json:"status"`
+}
+
+func NewHoldReleased(number string, holdId string, amount int64, status string) *HoldReleased {
+	return &HoldReleased{
+		Number: number,
+		HoldId: holdId,
+		Amount: amount,
+		Status: status,
+	}
+}
@@ -0,0 +1,24 @@
+package events
+
+import (
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+)
+
+type InterestCredited struct {
+	Number  string       `json:"number"`
+	Value   domain.Money `json:"value"`
+	Balance domain.Money `json:"balance"`
+	Period  string       `json:"period"`
+}
+
+// NewInterestCredited describes the interest of the month of day credited to a savings account.
+func NewInterestCredited(number string, value domain.Money, balance domain.Money, day time.Time) *InterestCredited {
+	return &InterestCredited{
+		Number:  number,
+		Value:   value,
+		Balance: balance,
+		Period:  day.Format("2006-01"),
+	}
+}
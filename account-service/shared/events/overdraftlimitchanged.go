@@ -0,0 +1,13 @@
+package events
+
+type OverdraftLimitChanged struct {
+	Number         string `json:"number"`
+	OverdraftLimit int64  `json:"overdraftLimit"`
+}
+
+func NewOverdraftLimitChanged(number string, overdraftLimit int64) *OverdraftLimitChanged {
+	return &OverdraftLimitChanged{
+		Number:         number,
+		OverdraftLimit: overdraftLimit,
+	}
+}
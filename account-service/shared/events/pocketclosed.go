@@ -0,0 +1,15 @@
+package events
+
+type PocketClosed struct {
+	Number   string `json:"number"`
+	PocketId string `json:"pocketId"`
+	Name     string `json:"name"`
+}
+
+func NewPocketClosed(number, pocketId, name string) *PocketClosed {
+	return &PocketClosed{
+		Number:   number,
+		PocketId: pocketId,
+		Name:     name,
+	}
+}
@@ -0,0 +1,15 @@
+package events
+
+type PocketCreated struct {
+	Number   string `json:"number"`
+	PocketId string `json:"pocketId"`
+	Name     string `json:"name"`
+}
+
+func NewPocketCreated(number, pocketId, name string) *PocketCreated {
+	return &PocketCreated{
+		Number:   number,
+		PocketId: pocketId,
+		Name:     name,
+	}
+}
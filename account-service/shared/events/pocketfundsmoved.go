@@ -0,0 +1,25 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+// PocketFundsMoved is a move between the main balance of the account and one of its pockets,
+// direction in moves into the pocket. The account balance is unchanged.
+type PocketFundsMoved struct {
+	Number        string                     `json:"number"`
+	PocketId      string                     `json:"pocketId"`
+	PocketName    string                     `json:"pocketName"`
+	Direction     domain.PocketMoveDirection `json:"direction"`
+	Value         domain.Money               `json:"value"`
+	PocketBalance domain.Money               `json:"pocketBalance"`
+}
+
+func NewPocketFundsMoved(number, pocketId, pocketName string, direction domain.PocketMoveDirection, value, pocketBalance domain.Money) *PocketFundsMoved {
+	return &PocketFundsMoved{
+		Number:        number,
+		PocketId:      pocketId,
+		PocketName:    pocketName,
+		Direction:     direction,
+		Value:         value,
+		PocketBalance: pocketBalance,
+	}
+}
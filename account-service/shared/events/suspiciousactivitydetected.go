@@ -0,0 +1,23 @@
+package events
+
+type SuspiciousActivityDetected struct {
+	AssessmentId       string `json:"assessmentId"`
+	Operation          string `json:"operation"`
+	Number             string `json:"number"`
+	CounterpartyNumber string `json:"counterpartyNumber,omitempty"`
+	Value              int64  `json:"value"`
+	Decision           string `json:"decision"`
+	Rule               string `json:"rule"`
+}
+
+func NewSuspiciousActivityDetected(assessmentId, operation, number, counterpartyNumber string, value int64, decision, rule string) *SuspiciousActivityDetected {
+	return &SuspiciousActivityDetected{
+		AssessmentId:       assessmentId,
+		Operation:          operation,
+		Number:             number,
+		CounterpartyNumber: counterpartyNumber,
+		Value:              value,
+		Decision:           decision,
+		Rule:               rule,
+	}
+}
@@ -0,0 +1,19 @@
+package events
+
+type TransferApproved struct {
+	TransferRequestId string `json:"transferRequestId"`
+	FromNumber        string `json:"fromNumber"`
+	ToNumber          string `json:"toNumber"`
+	Value             int64  `json:"value"`
+	ApprovedBy        string `json:"approvedBy"`
+}
+
+func NewTransferApproved(transferRequestId, fromNumber, toNumber string, value int64, approvedBy string) *TransferApproved {
+	return &TransferApproved{
+		TransferRequestId: transferRequestId,
+		FromNumber:        fromNumber,
+		ToNumber:          toNumber,
+		Value:             value,
+		ApprovedBy:        approvedBy,
+	}
+}
@@ -0,0 +1,25 @@
+package events
+
+// TransferLimitsChanged audits a change of the transfer limits of an account, a zero limit
+// means the account uses the default of its tier.
+type TransferLimitsChanged struct {
+	Number                 string `json:"number"`
+	PerTransaction         int64  `json:"perTransaction"`
+	Daily                  int64  `json:"daily"`
+	Nightly                int64  `json:"nightly"`
+	PreviousPerTransaction int64  `json:"previousPerTransaction"`
+	PreviousDaily          int64  `json:"previousDaily"`
+	PreviousNightly        int64  `json:"previousNightly"`
+}
+
+func NewTransferLimitsChanged(number string, perTransaction, daily, nightly, previousPerTransaction, previousDaily, previousNightly int64) *TransferLimitsChanged {
+	return &TransferLimitsChanged{
+		Number:                 number,
+		PerTransaction:         perTransaction,
+		Daily:                  daily,
+		Nightly:                nightly,
+		PreviousPerTransaction: previousPerTransaction,
+		PreviousDaily:          previousDaily,
+		PreviousNightly:        previousNightly,
+	}
+}
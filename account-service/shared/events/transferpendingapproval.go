@@ -0,0 +1,23 @@
+package events
+
+import "time"
+
+type TransferPendingApproval struct {
+	TransferRequestId string    `json:"transferRequestId"`
+	FromNumber        string    `json:"fromNumber"`
+	ToNumber          string    `json:"toNumber"`
+	Value             int64     `json:"value"`
+	PixKey            string    `json:"pixKey,omitempty"`
+	ExpiresAt         time.Time `json:"expiresAt"`
+}
+
+func NewTransferPendingApproval(transferRequestId, fromNumber, toNumber string, value int64, pixKey string, expiresAt time.Time) *TransferPendingApproval {
+	return &TransferPendingApproval{
+		TransferRequestId: transferRequestId,
+		FromNumber:        fromNumber,
+		ToNumber:          toNumber,
+		Value:             value,
+		PixKey:            pixKey,
+		ExpiresAt:         expiresAt,
+	}
+}
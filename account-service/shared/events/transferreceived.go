@@ -1,17 +1,21 @@
 package events
 
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
 type TransferReceived struct {
-	FromNumber string `json:"fromNumber"`
-	ToNumber   string `json:"toNumber"`
-	Value      int64  `json:"value"`
-	Balance    int64  `json:"balance"`
+	FromNumber string       `json:"fromNumber"`
+	ToNumber   string       `json:"toNumber"`
+	Value      domain.Money `json:"value"`
+	Balance    domain.Money `json:"balance"`
+	TransferId string       `json:"transferId"`
 }
 
-func NewTransferReceived(fromNumber, toNumber string, value int64, balance int64) *TransferReceived {
+func NewTransferReceived(fromNumber, toNumber string, value domain.Money, balance domain.Money, transferId string) *TransferReceived {
 	return &TransferReceived{
 		FromNumber: fromNumber,
 		ToNumber:   toNumber,
 		Value:      value,
 		Balance:    balance,
+		TransferId: transferId,
 	}
 }
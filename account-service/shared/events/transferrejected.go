@@ -0,0 +1,21 @@
+package events
+
+type TransferRejected struct {
+	TransferRequestId string `json:"transferRequestId"`
+	FromNumber        string `json:"fromNumber"`
+	ToNumber          string `json:"toNumber"`
+	Value             int64  `json:"value"`
+	RejectedBy        string `json:"rejectedBy"`
+	Reason            string `json:"reason"`
+}
+
+func NewTransferRejected(transferRequestId, fromNumber, toNumber string, value int64, rejectedBy string, reason string) *TransferRejected {
+	return &TransferRejected{
+		TransferRequestId: transferRequestId,
+		FromNumber:        fromNumber,
+		ToNumber:          toNumber,
+		Value:             value,
+		RejectedBy:        rejectedBy,
+		Reason:            reason,
+	}
+}
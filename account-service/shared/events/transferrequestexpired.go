@@ -0,0 +1,17 @@
+package events
+
+type TransferRequestExpired struct {
+	TransferRequestId string `json:"transferRequestId"`
+	FromNumber        string `json:"fromNumber"`
+	ToNumber          string `json:"toNumber"`
+	Value             int64  `json:"value"`
+}
+
+func NewTransferRequestExpired(transferRequestId, fromNumber, toNumber string, value int64) *TransferRequestExpired {
+	return &TransferRequestExpired{
+		TransferRequestId: transferRequestId,
+		FromNumber:        fromNumber,
+		ToNumber:          toNumber,
+		Value:             value,
+	}
+}
@@ -0,0 +1,27 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/account-service/internal/domain"
+
+// TransferReversed moves Value back from the receiver of the original transfer (FromNumber)
+// to its sender (ToNumber).
+type TransferReversed struct {
+	TransferId  string       `json:"transferId"`
+	ReversalId  string       `json:"reversalId"`
+	FromNumber  string       `json:"fromNumber"`
+	ToNumber    string       `json:"toNumber"`
+	Value       domain.Money `json:"value"`
+	FromBalance domain.Money `json:"fromBalance"`
+	ToBalance   domain.Money `json:"toBalance"`
+}
+
+func NewTransferReversed(transferId, reversalId, fromNumber, toNumber string, value domain.Money, fromBalance domain.Money, toBalance domain.Money) *TransferReversed {
+	return &TransferReversed{
+		TransferId:  transferId,
+		ReversalId:  reversalId,
+		FromNumber:  fromNumber,
+		ToNumber:    toNumber,
+		Value:       value,
+		FromBalance: fromBalance,
+		ToBalance:   toBalance,
+	}
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/internal/logger"
 	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/server"
@@ -28,6 +30,25 @@ func initConfigFile() {
 	if err != nil {
 		panic(err)
 	}
+
+	initClients()
+}
+
+// initClients replaces the configured clients by the JSON list in AUTH_CLIENTS, so the clients of production
+// come from secret storage instead of the config file
+func initClients() {
+	value := os.Getenv("AUTH_CLIENTS")
+	if value == "" {
+		return
+	}
+
+	var clients []map[string]any
+	err := json.Unmarshal([]byte(value), &clients)
+	if err != nil {
+		panic(err)
+	}
+
+	viper.Set("authSettings.clients", clients)
 }
 
 func main() {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/internal/domain"
 	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/server"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -27,3 +28,29 @@ func TestApiServerStart(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestInitClients_FromEnvironment(t *testing.T) {
+	viper.Set("authSettings.clients", nil)
+	t.Setenv("AUTH_CLIENTS", `[{"id": "bob", "secretHash": "hash", "roles": ["admin"]}]`)
+
+	initClients()
+
+	var clients []domain.Client
+	err := viper.UnmarshalKey("authSettings.clients", &clients)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Client{{Id: "bob", SecretHash: "hash", Roles: []string{"admin"}}}, clients)
+}
+
+func TestInitClients_WithoutEnvironmentKeepsConfig(t *testing.T) {
+	viper.Set("authSettings.clients", []map[string]any{{"id": "alice"}})
+	t.Setenv("AUTH_CLIENTS", "")
+
+	initClients()
+
+	var clients []domain.Client
+	err := viper.UnmarshalKey("authSettings.clients", &clients)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Client{{Id: "alice"}}, clients)
+}
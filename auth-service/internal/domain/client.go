@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrInvalidCredentials = errors.New("invalid client credentials")
+
+// Client is an identity allowed to request tokens. Its roles are granted as scopes on top of the default ones.
+type Client struct {
+	Id         string   `mapstructure:"id"`
+	SecretHash string   `mapstructure:"secretHash"`
+	Roles      []string `mapstructure:"roles"`
+}
+
+func (c *Client) CheckSecret(secret string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(c.SecretHash), []byte(secret)) == nil
+}
+
+func (c *Client) Scopes(defaultScopes []string) []string {
+	scopes := slices.Clone(defaultScopes)
+	for _, role := range c.Roles {
+		if !slices.Contains(scopes, role) {
+			scopes = append(scopes, role)
+		}
+	}
+
+	return scopes
+}
+
+func FindClient(clients []Client, id string) (*Client, bool) {
+	for i := range clients {
+		if clients[i].Id == id {
+			return &clients[i], true
+		}
+	}
+
+	return nil, false
+}
@@ -5,12 +5,12 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
+	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/internal/domain"
 	"github.com/spf13/viper"
 )
 
 type CreateJWTTokenUseCaseInterface interface {
-	Handle() (string, error)
+	Handle(clientId, clientSecret string) (string, error)
 }
 
 type CreateJWTTokenUseCase struct {
@@ -20,11 +20,24 @@ func NewCreateJWTTokenUseCase() *CreateJWTTokenUseCase {
 	return &CreateJWTTokenUseCase{}
 }
 
-func (*CreateJWTTokenUseCase) Handle() (string, error) {
-	slog.Info("Creating JWT token")
+func (*CreateJWTTokenUseCase) Handle(clientId, clientSecret string) (string, error) {
+	slog.Info("Creating JWT token", "clientId", clientId)
+
+	var clients []domain.Client
+	err := viper.UnmarshalKey("authSettings.clients", &clients)
+	if err != nil {
+		slog.Error("Error reading clients", "err", err.Error())
+		return "", err
+	}
+
+	client, found := domain.FindClient(clients, clientId)
+	if !found || !client.CheckSecret(clientSecret) {
+		slog.Info("Invalid client credentials", "clientId", clientId)
+		return "", domain.ErrInvalidCredentials
+	}
 
 	audience := viper.GetString("authSettings.audience")
-	scopes := viper.GetStringSlice("authSettings.scopes")
+	scopes := client.Scopes(viper.GetStringSlice("authSettings.scopes"))
 	secret := viper.GetString("authSettings.secret")
 	expirationHours := viper.GetInt("authSettings.expirationHours")
 
@@ -32,7 +45,7 @@ func (*CreateJWTTokenUseCase) Handle() (string, error) {
 
 	claims := jwt.MapClaims{
 		"exp":    expirationTime,
-		"sub":    uuid.New().String(),
+		"sub":    client.Id,
 		"aud":    audience,
 		"scopes": scopes,
 	}
@@ -47,7 +60,7 @@ func (*CreateJWTTokenUseCase) Handle() (string, error) {
 		return "", err
 	}
 
-	slog.Info("Token created")
+	slog.Info("Token created", "clientId", clientId)
 
 	return tokenGenerated, nil
 }
@@ -3,34 +3,117 @@ package usecases
 import (
 	"testing"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/internal/domain"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
 )
 
+func setupAuthSettings(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	assert.Nil(t, err)
+
+	viper.Set("authSettings.secret", "secret")
+	viper.Set("authSettings.audience", "webAPIs")
+	viper.Set("authSettings.expirationHours", 4)
+	viper.Set("authSettings.scopes", []string{
+		"account",
+		"bankstatement",
+	})
+	viper.Set("authSettings.clients", []map[string]interface{}{
+		{"id": "bob", "secretHash": string(hash), "roles": []string{}},
+		{"id": "admin", "secretHash": string(hash), "roles": []string{"admin"}},
+		{"id": "carol", "secretHash": string(hash), "roles": []string{"approver"}},
+	})
+}
+
+func parseClaims(t *testing.T, token string) jwt.MapClaims {
+	tokenParsed, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		return []byte("secret"), nil
+	}, jwt.WithAudience("webAPIs"))
+	assert.Nil(t, err)
+
+	return tokenParsed.Claims.(jwt.MapClaims)
+}
+
 func TestHandle(t *testing.T) {
 	testsCase := []struct {
-		name          string
-		accountNumber string
+		name           string
+		clientId       string
+		expectedScopes []interface{}
 	}{
 		{
-			name: "check JWT creation",
+			name:           "check JWT creation",
+			clientId:       "bob",
+			expectedScopes: []interface{}{"account", "bankstatement"},
+		},
+		{
+			name:           "check JWT creation with role scopes",
+			clientId:       "admin",
+			expectedScopes: []interface{}{"account", "bankstatement", "admin"},
+		},
+		{
+			name:           "check JWT creation with approver scope",
+			clientId:       "carol",
+			expectedScopes: []interface{}{"account", "bankstatement", "approver"},
 		},
 	}
 
 	for _, tc := range testsCase {
 		t.Run(tc.name, func(t *testing.T) {
-			viper.Set("authSettings.secret", "secret")
-			viper.Set("authSettings.audience", "webAPIs")
-			viper.Set("authSettings.expirationHours", 4)
-			viper.Set("authSettings.scopes", []string{
-				"account",
-				"bankstatement",
-			})
+			setupAuthSettings(t)
 
-			token, err := NewCreateJWTTokenUseCase().Handle()
+			token, err := NewCreateJWTTokenUseCase().Handle(tc.clientId, "secret")
 
 			assert.Nil(t, err)
 			assert.NotNil(t, token)
+
+			claims := parseClaims(t, token)
+			assert.Equal(t, tc.clientId, claims["sub"])
+			assert.Equal(t, tc.expectedScopes, claims["scopes"])
+		})
+	}
+}
+
+func TestHandle_SameSubjectForClient(t *testing.T) {
+	setupAuthSettings(t)
+
+	firstToken, err := NewCreateJWTTokenUseCase().Handle("bob", "secret")
+	assert.Nil(t, err)
+
+	secondToken, err := NewCreateJWTTokenUseCase().Handle("bob", "secret")
+	assert.Nil(t, err)
+
+	assert.Equal(t, parseClaims(t, firstToken)["sub"], parseClaims(t, secondToken)["sub"])
+}
+
+func TestHandle_InvalidCredentials(t *testing.T) {
+	testsCase := []struct {
+		name         string
+		clientId     string
+		clientSecret string
+	}{
+		{
+			name:         "unknown client",
+			clientId:     "alice",
+			clientSecret: "secret",
+		},
+		{
+			name:         "wrong secret",
+			clientId:     "admin",
+			clientSecret: "wrong",
+		},
+	}
+
+	for _, tc := range testsCase {
+		t.Run(tc.name, func(t *testing.T) {
+			setupAuthSettings(t)
+
+			token, err := NewCreateJWTTokenUseCase().Handle(tc.clientId, tc.clientSecret)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+			assert.Empty(t, token)
 		})
 	}
 }
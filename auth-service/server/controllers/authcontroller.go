@@ -1,10 +1,13 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/internal/domain"
 	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/internal/usecases"
+	"github.com/matheus-oliveira-andrade/bank-statement/auth-service/server/models"
 )
 
 type AuthController struct {
@@ -22,7 +25,18 @@ func (controller *AuthController) RegisterRoutes(routerGroup *gin.RouterGroup) {
 }
 
 func (controller *AuthController) CreateToken(ctx *gin.Context) {
-	token, err := controller.CreateTokenUseCase.Handle()
+	var req models.TokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	token, err := controller.CreateTokenUseCase.Handle(req.ClientId, req.ClientSecret)
+	if errors.Is(err, domain.ErrInvalidCredentials) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, err)
 		return
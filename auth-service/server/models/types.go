@@ -1,5 +1,6 @@
 package models
 
 type TokenRequest struct {
-	AccountNumber string `json:"accountNumber"`
+	ClientId     string `json:"clientId" binding:"required"`
+	ClientSecret string `json:"clientSecret" binding:"required"`
 }
@@ -2,23 +2,220 @@ CREATE DATABASE accountdb;
 
 \c accountdb
 
+CREATE SEQUENCE IF NOT EXISTS accounts_number_seq;
+
 CREATE TABLE IF NOT EXISTS accounts (
    Id SERIAL PRIMARY KEY,
-   Number VARCHAR(15),
+   Number VARCHAR(15) UNIQUE,
    Name VARCHAR(120),
    Document VARCHAR(14),
+   Email VARCHAR(254) NOT NULL DEFAULT '',
+   Phone VARCHAR(16) NOT NULL DEFAULT '',
+   Address VARCHAR(200) NOT NULL DEFAULT '',
+   Type VARCHAR(10) NOT NULL DEFAULT 'checking',
+   Currency VARCHAR(3) NOT NULL DEFAULT 'BRL',
    Balance BIGINT,
+   HeldBalance BIGINT DEFAULT 0,
+   PocketBalance BIGINT NOT NULL DEFAULT 0,
+   OverdraftLimit BIGINT DEFAULT 0,
+   PerTransactionLimit BIGINT DEFAULT 0,
+   DailyLimit BIGINT DEFAULT 0,
+   NightlyLimit BIGINT DEFAULT 0,
+   AccruedInterest BIGINT NOT NULL DEFAULT 0,
+   InterestAccruedOn DATE,
+   MaintenanceFeeChargedOn DATE,
+   Status VARCHAR(10) DEFAULT 'active',
    CreatedAt TIMESTAMP,
    UpdatedAt TIMESTAMP
 );
 
 CREATE INDEX accounts_Document_idx ON accounts (Document);
 
+CREATE TABLE IF NOT EXISTS accountprofilechanges (
+   Id BIGSERIAL PRIMARY KEY,
+   AccountNumber VARCHAR(15),
+   Field VARCHAR(20),
+   OldValue VARCHAR(254),
+   NewValue VARCHAR(254),
+   ChangedBy VARCHAR(60),
+   ChangedAt TIMESTAMP
+);
+
+CREATE INDEX accountprofilechanges_AccountNumber_idx ON accountprofilechanges (AccountNumber);
+
+CREATE TABLE IF NOT EXISTS accountholders (
+   Id SERIAL PRIMARY KEY,
+   AccountNumber VARCHAR(15),
+   Document VARCHAR(14),
+   Name VARCHAR(120),
+   Role VARCHAR(10),
+   CreatedAt TIMESTAMP,
+   UNIQUE (AccountNumber, Document)
+);
+
+CREATE INDEX accountholders_Document_idx ON accountholders (Document);
+
+CREATE UNIQUE INDEX accountholders_primary_Document_idx ON accountholders (Document) WHERE Role = 'primary';
+
 CREATE TABLE IF NOT EXISTS idempotencykeys (
-   Key VARCHAR(40) PRIMARY KEY,
+   Scope VARCHAR(40),
+   Subject VARCHAR(64) NOT NULL DEFAULT '',
+   Key VARCHAR(40),
+   Operation VARCHAR(30),
+   RequestHash VARCHAR(64),
+   StatusCode INT,
+   Response TEXT,
+   CreatedAt TIMESTAMP,
+   PRIMARY KEY (Scope, Subject, Key)
+);
+
+CREATE INDEX idempotencykeys_CreatedAt_idx ON idempotencykeys (CreatedAt);
+
+CREATE TABLE IF NOT EXISTS outbox (
+   Id BIGSERIAL PRIMARY KEY,
+   EventId VARCHAR(36) NOT NULL UNIQUE,
+   Topic VARCHAR(60),
+   Type VARCHAR(60),
+   Data TEXT,
+   Attempts INT DEFAULT 0,
+   LastError TEXT,
+   CreatedAt TIMESTAMP,
+   NextAttemptAt TIMESTAMP,
+   SentAt TIMESTAMP,
+   DeadLetteredAt TIMESTAMP
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (Id) WHERE SentAt IS NULL AND DeadLetteredAt IS NULL;
+
+CREATE TABLE IF NOT EXISTS ledgertransactions (
+   Id BIGSERIAL PRIMARY KEY,
+   Type VARCHAR(30),
+   ReversedTransactionId BIGINT REFERENCES ledgertransactions (Id),
+   OriginTransactionId BIGINT REFERENCES ledgertransactions (Id),
+   CreatedAt TIMESTAMP
+);
+
+CREATE INDEX ledgertransactions_ReversedTransactionId_idx ON ledgertransactions (ReversedTransactionId);
+
+CREATE TABLE IF NOT EXISTS ledgerentries (
+   Id BIGSERIAL PRIMARY KEY,
+   TransactionId BIGINT REFERENCES ledgertransactions (Id),
+   AccountNumber VARCHAR(15),
+   Amount BIGINT NOT NULL CHECK (Amount <> 0),
+   Currency VARCHAR(3) NOT NULL DEFAULT 'BRL',
+   CreatedAt TIMESTAMP
+);
+
+CREATE INDEX ledgerentries_TransactionId_idx ON ledgerentries (TransactionId);
+CREATE INDEX ledgerentries_AccountNumber_idx ON ledgerentries (AccountNumber);
+
+CREATE TABLE IF NOT EXISTS scheduledtransfers (
+   Id SERIAL PRIMARY KEY,
+   FromNumber VARCHAR(15),
+   ToNumber VARCHAR(15),
+   Value BIGINT,
+   Frequency VARCHAR(10),
+   DayOfMonth INT,
+   EndDate TIMESTAMP,
+   MaxOccurrences INT DEFAULT 0,
+   Occurrences INT DEFAULT 0,
+   FailedOccurrences INT DEFAULT 0,
+   PendingOccurrences INT DEFAULT 0,
+   LastTransferRequestId VARCHAR(20),
+   ScheduledFor TIMESTAMP,
+   NextRunAt TIMESTAMP,
+   Attempts INT DEFAULT 0,
+   LastError TEXT,
+   LastAttemptAt TIMESTAMP,
+   Status VARCHAR(10),
+   CreatedBy VARCHAR(64),
+   CreatedAt TIMESTAMP,
+   UpdatedAt TIMESTAMP
+);
+
+CREATE INDEX scheduledtransfers_FromNumber_idx ON scheduledtransfers (FromNumber);
+CREATE INDEX scheduledtransfers_due_idx ON scheduledtransfers (NextRunAt) WHERE Status = 'active';
+
+CREATE TABLE IF NOT EXISTS pixkeys (
+   Id SERIAL PRIMARY KEY,
+   AccountNumber VARCHAR(15),
+   Type VARCHAR(10),
+   Key VARCHAR(77) UNIQUE,
+   CreatedAt TIMESTAMP
+);
+
+CREATE INDEX pixkeys_AccountNumber_idx ON pixkeys (AccountNumber);
+
+CREATE TABLE IF NOT EXISTS payees (
+   Id SERIAL PRIMARY KEY,
+   AccountNumber VARCHAR(15),
+   Nickname VARCHAR(50),
+   ToNumber VARCHAR(15),
+   PixKey VARCHAR(77),
+   CreatedAt TIMESTAMP,
+   UpdatedAt TIMESTAMP
+);
+
+CREATE INDEX payees_AccountNumber_idx ON payees (AccountNumber);
+
+CREATE TABLE IF NOT EXISTS holds (
+   Id SERIAL PRIMARY KEY,
+   AccountNumber VARCHAR(15),
+   Amount BIGINT,
+   CapturedAmount BIGINT DEFAULT 0,
+   Description VARCHAR(140),
+   Status VARCHAR(10) DEFAULT 'active',
+   ExpiresAt TIMESTAMP,
+   CreatedAt TIMESTAMP,
+   UpdatedAt TIMESTAMP
+);
+
+CREATE INDEX holds_AccountNumber_idx ON holds (AccountNumber);
+CREATE INDEX holds_expiry_idx ON holds (ExpiresAt) WHERE Status = 'active';
+
+CREATE TABLE IF NOT EXISTS pockets (
+   Id SERIAL PRIMARY KEY,
+   AccountNumber VARCHAR(15),
+   Name VARCHAR(40),
+   Balance BIGINT DEFAULT 0,
+   Status VARCHAR(10) DEFAULT 'active',
+   CreatedAt TIMESTAMP,
+   UpdatedAt TIMESTAMP
+);
+
+CREATE INDEX pockets_AccountNumber_idx ON pockets (AccountNumber);
+
+CREATE TABLE IF NOT EXISTS transferrequests (
+   Id SERIAL PRIMARY KEY,
+   FromNumber VARCHAR(15),
+   ToNumber VARCHAR(15),
+   PixKey VARCHAR(77),
+   Value BIGINT,
+   Status VARCHAR(20) DEFAULT 'pending_approval',
+   RequestedBy VARCHAR(64),
+   ReviewedBy VARCHAR(64),
+   RejectionReason VARCHAR(140),
+   ExpiresAt TIMESTAMP,
+   CreatedAt TIMESTAMP,
+   UpdatedAt TIMESTAMP
+);
+
+CREATE INDEX transferrequests_FromNumber_idx ON transferrequests (FromNumber);
+CREATE INDEX transferrequests_expiry_idx ON transferrequests (ExpiresAt) WHERE Status = 'pending_approval';
+
+CREATE TABLE IF NOT EXISTS riskassessments (
+   Id BIGSERIAL PRIMARY KEY,
+   Operation VARCHAR(10),
+   AccountNumber VARCHAR(15),
+   CounterpartyNumber VARCHAR(15),
+   Value BIGINT,
+   Decision VARCHAR(10),
+   Rule VARCHAR(60),
    CreatedAt TIMESTAMP
 );
 
+CREATE INDEX riskassessments_AccountNumber_idx ON riskassessments (AccountNumber);
+
 CREATE DATABASE statementdb;
 
 \c statementdb
@@ -27,7 +224,18 @@ CREATE TABLE IF NOT EXISTS accounts (
    Number VARCHAR(15) PRIMARY KEY,
    Name VARCHAR(120),
    Document VARCHAR(14),
-   Balance BIGINT   
+   Currency VARCHAR(3) NOT NULL DEFAULT 'BRL',
+   Balance BIGINT,
+   OverdraftLimit BIGINT DEFAULT 0,
+   Status VARCHAR(10) DEFAULT 'active'
+);
+
+CREATE TABLE IF NOT EXISTS accountholders (
+   AccountNumber VARCHAR(15),
+   Document VARCHAR(14),
+   Name VARCHAR(120),
+   Role VARCHAR(10),
+   PRIMARY KEY (AccountNumber, Document)
 );
 
 CREATE TABLE IF NOT EXISTS movements (
@@ -36,11 +244,29 @@ CREATE TABLE IF NOT EXISTS movements (
    AccountNumber VARCHAR(15),
    Value BIGINT,
    ToAccountNumber VARCHAR(15),
+   TransferId VARCHAR(20),
+   ReversedTransferId VARCHAR(20),
+   OriginTransactionId VARCHAR(20),
+   PocketId VARCHAR(20),
+   PocketName VARCHAR(40),
    CreatedAt TIMESTAMP
 );
 
 CREATE INDEX movements_AccountNumber_idx ON movements (AccountNumber);
 
+CREATE TABLE IF NOT EXISTS transferrequests (
+   Id VARCHAR(20) PRIMARY KEY,
+   FromNumber VARCHAR(15),
+   ToNumber VARCHAR(15),
+   Value BIGINT,
+   Status VARCHAR(20),
+   Reason VARCHAR(140),
+   CreatedAt TIMESTAMP,
+   UpdatedAt TIMESTAMP
+);
+
+CREATE INDEX transferrequests_FromNumber_idx ON transferrequests (FromNumber);
+
 CREATE TABLE IF NOT EXISTS statementsgeneration (
    Id SERIAL PRIMARY KEY,
    status VARCHAR(30),
@@ -51,4 +277,10 @@ CREATE TABLE IF NOT EXISTS statementsgeneration (
    DocumentContent TEXT
 );
 
-CREATE INDEX statementsgeneration_AccountNumber_idx ON statementsgeneration (AccountNumber);
\ No newline at end of file
+CREATE INDEX statementsgeneration_AccountNumber_idx ON statementsgeneration (AccountNumber);
+
+CREATE TABLE IF NOT EXISTS processedevents (
+   EventId VARCHAR(36) PRIMARY KEY,
+   Type VARCHAR(60),
+   ProcessedAt TIMESTAMP
+);
\ No newline at end of file
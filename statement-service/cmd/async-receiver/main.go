@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 
 	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/configs"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
 	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers"
 	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/infrastructure/broker"
 	documentgenerator "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/infrastructure/documentgenerator"
@@ -62,7 +64,13 @@ func main() {
 
 	go func() {
 		for consumedMessage := range consumedMessages {
-			tryHandleMessage(consumedMessage, dbConnection)
+			err := tryHandleMessage(consumedMessage, dbConnection)
+			if err != nil {
+				// the queue dead-letters a message after x-delivery-limit redeliveries, invalid
+				// events are dead-lettered at once since no redelivery can handle them
+				consumedMessage.Nack(false, !errors.Is(err, errInvalidEvent))
+				continue
+			}
 
 			consumedMessage.Ack(false)
 		}
@@ -72,49 +80,172 @@ func main() {
 	<-forever
 }
 
-func tryHandleMessage(consumedMessage amqp091.Delivery, dbConnection *sql.DB) {
+// errInvalidEvent is returned for messages that cannot be decoded, redelivering them is useless.
+var errInvalidEvent = errors.New("invalid event")
+
+// tryHandleMessage handles the event inside a transaction that records its id, so an event
+// delivered again by the account service relay is skipped instead of applied twice, and a failed
+// event is rolled back as a whole. Events without an id are handled as they arrive, as well as
+// statement generations, which call the document generator and must not hold a transaction
+// open meanwhile; generating a statement again only rewrites it. An error means the message
+// should be redelivered.
+func tryHandleMessage(consumedMessage amqp091.Delivery, dbConnection *sql.DB) error {
 	var EventPublish events.EventPublish
 	err := decodeEvent(consumedMessage.Body, &EventPublish)
 	if err != nil {
-		fmt.Println(err)
+		slog.Error("error decoding message", "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	if EventPublish.Id == "" || EventPublish.Type == events.StatementGenerationRequestedEventKey {
+		return handleEvent(EventPublish, dbConnection)
+	}
+
+	tx, err := dbConnection.Begin()
+	if err != nil {
+		slog.Error("error starting transaction", "type", EventPublish.Type, "error", err)
+		return err
+	}
+
+	processed, err := repositories.NewProcessedEventsRepository(tx).MarkAsProcessed(EventPublish.Id, EventPublish.Type)
+	if err != nil {
+		slog.Error("error marking event as processed", "id", EventPublish.Id, "type", EventPublish.Type, "error", err)
+		tx.Rollback()
+		return err
+	}
+
+	if !processed {
+		slog.Info("event already processed, skipping", "id", EventPublish.Id, "type", EventPublish.Type)
+		tx.Rollback()
+		return nil
+	}
+
+	err = handleEvent(EventPublish, tx)
+	if err != nil {
+		slog.Error("error handling event", "id", EventPublish.Id, "type", EventPublish.Type, "error", err)
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error("error committing event", "id", EventPublish.Id, "type", EventPublish.Type, "error", err)
+		return err
 	}
 
+	return nil
+}
+
+func handleEvent(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
 	switch EventPublish.Type {
 	case events.AccountCreatedEventKey:
-		eventAccountCreatedConsume(EventPublish, dbConnection)
+		return eventAccountCreatedConsume(EventPublish, dbConnection)
+	case events.AccountUpdatedEventKey:
+		return eventAccountUpdatedConsume(EventPublish, dbConnection)
 	case events.FundsDepositedEventKey:
-		eventFundsDepositedConsume(EventPublish, dbConnection)
+		return eventFundsDepositedConsume(EventPublish, dbConnection)
+	case events.InterestCreditedEventKey:
+		return eventInterestCreditedConsume(EventPublish, dbConnection)
+	case events.FeeChargedEventKey:
+		return eventFeeChargedConsume(EventPublish, dbConnection)
+	case events.FundsWithdrawnEventKey:
+		return eventFundsWithdrawnConsume(EventPublish, dbConnection)
+	case events.PocketFundsMovedEventKey:
+		return eventPocketFundsMovedConsume(EventPublish, dbConnection)
 	case events.TransferRealizedEventKey:
-		eventTransferRealizedConsume(EventPublish, dbConnection)
+		return eventTransferRealizedConsume(EventPublish, dbConnection)
 	case events.TransferReceivedEventKey:
-		eventTransferReceivedConsume(EventPublish, dbConnection)
+		return eventTransferReceivedConsume(EventPublish, dbConnection)
+	case events.TransferReversedEventKey:
+		return eventTransferReversedConsume(EventPublish, dbConnection)
+	case events.AccountBlockedEventKey:
+		return eventAccountStatusChangedConsume(EventPublish, dbConnection, domain.AccountStatusBlocked)
+	case events.AccountUnblockedEventKey:
+		return eventAccountStatusChangedConsume(EventPublish, dbConnection, domain.AccountStatusActive)
+	case events.AccountClosedEventKey:
+		return eventAccountStatusChangedConsume(EventPublish, dbConnection, domain.AccountStatusClosed)
+	case events.OverdraftLimitChangedEventKey:
+		return eventOverdraftLimitChangedConsume(EventPublish, dbConnection)
+	case events.TransferPendingApprovalEventKey:
+		return eventTransferPendingApprovalConsume(EventPublish, dbConnection)
+	case events.TransferApprovedEventKey:
+		return eventTransferApprovedConsume(EventPublish, dbConnection)
+	case events.TransferRejectedEventKey:
+		return eventTransferRejectedConsume(EventPublish, dbConnection)
+	case events.TransferRequestExpiredEventKey:
+		return eventTransferRequestExpiredConsume(EventPublish, dbConnection)
 	case events.StatementGenerationRequestedEventKey:
-		eventStatementGenerationRequested(EventPublish, dbConnection)
+		return eventStatementGenerationRequested(EventPublish, dbConnection)
 	default:
 		slog.Info("event type not mapped", "eventType", EventPublish.Type)
+		return nil
 	}
 }
 
-func eventAccountCreatedConsume(EventPublish events.EventPublish, dbConnection *sql.DB) {
+func eventAccountCreatedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
 	var obj events.AccountCreated
 	err := decodeEvent([]byte(EventPublish.Data), &obj)
 	if err != nil {
 		slog.Error("error decoding event", "type", EventPublish.Type, "error", err)
-		return
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
 	}
 
 	repository := repositories.NewAccountRepository(dbConnection)
 	handler := eventhandlers.NewAccountCreatedHandler(repository)
 
-	handler.Handler(obj)
+	return handler.Handler(obj)
 }
 
-func eventFundsDepositedConsume(EventPublish events.EventPublish, dbConnection *sql.DB) {
+func eventAccountUpdatedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.AccountUpdated
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	repository := repositories.NewAccountRepository(dbConnection)
+	handler := eventhandlers.NewAccountUpdatedHandler(repository)
+
+	return handler.Handler(obj)
+}
+
+// eventAccountStatusChangedConsume handles the account status events, they share the same
+// payload and only differ by the status they set.
+func eventAccountStatusChangedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX, status domain.AccountStatus) error {
+	var obj events.AccountBlocked
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	repository := repositories.NewAccountRepository(dbConnection)
+	handler := eventhandlers.NewAccountStatusChangedHandler(repository)
+
+	return handler.Handler(obj.Number, status)
+}
+
+func eventOverdraftLimitChangedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.OverdraftLimitChanged
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	repository := repositories.NewAccountRepository(dbConnection)
+	handler := eventhandlers.NewOverdraftLimitChangedHandler(repository)
+
+	return handler.Handler(obj)
+}
+
+func eventFundsDepositedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
 	var obj events.FundsDeposited
 	err := decodeEvent([]byte(EventPublish.Data), &obj)
 	if err != nil {
 		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
-		return
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
 	}
 
 	accountRepository := repositories.NewAccountRepository(dbConnection)
@@ -122,15 +253,79 @@ func eventFundsDepositedConsume(EventPublish events.EventPublish, dbConnection *
 
 	handler := eventhandlers.NewFundsDepositedHandler(accountRepository, movementRepository)
 
-	handler.Handler(obj)
+	return handler.Handler(obj)
 }
 
-func eventTransferRealizedConsume(EventPublish events.EventPublish, dbConnection *sql.DB) {
+func eventInterestCreditedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.InterestCredited
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	accountRepository := repositories.NewAccountRepository(dbConnection)
+	movementRepository := repositories.NewMovementRepository(dbConnection)
+
+	handler := eventhandlers.NewInterestCreditedHandler(accountRepository, movementRepository)
+
+	return handler.Handler(obj)
+}
+
+func eventPocketFundsMovedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.PocketFundsMoved
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	accountRepository := repositories.NewAccountRepository(dbConnection)
+	movementRepository := repositories.NewMovementRepository(dbConnection)
+
+	handler := eventhandlers.NewPocketFundsMovedHandler(accountRepository, movementRepository)
+
+	return handler.Handler(obj)
+}
+
+func eventFeeChargedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.FeeCharged
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	accountRepository := repositories.NewAccountRepository(dbConnection)
+	movementRepository := repositories.NewMovementRepository(dbConnection)
+
+	handler := eventhandlers.NewFeeChargedHandler(accountRepository, movementRepository)
+
+	return handler.Handler(obj)
+}
+
+func eventFundsWithdrawnConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.FundsWithdrawn
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	accountRepository := repositories.NewAccountRepository(dbConnection)
+	movementRepository := repositories.NewMovementRepository(dbConnection)
+
+	handler := eventhandlers.NewFundsWithdrawnHandler(accountRepository, movementRepository)
+
+	return handler.Handler(obj)
+}
+
+func eventTransferRealizedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
 	var obj events.TransferRealized
 	err := decodeEvent([]byte(EventPublish.Data), &obj)
 	if err != nil {
 		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
-		return
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
 	}
 
 	accountRepository := repositories.NewAccountRepository(dbConnection)
@@ -138,15 +333,15 @@ func eventTransferRealizedConsume(EventPublish events.EventPublish, dbConnection
 
 	handler := eventhandlers.NewTransferRealizedHandler(accountRepository, movementRepository)
 
-	handler.Handler(obj)
+	return handler.Handler(obj)
 }
 
-func eventTransferReceivedConsume(EventPublish events.EventPublish, dbConnection *sql.DB) {
+func eventTransferReceivedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
 	var obj events.TransferReceived
 	err := decodeEvent([]byte(EventPublish.Data), &obj)
 	if err != nil {
 		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
-		return
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
 	}
 
 	accountRepository := repositories.NewAccountRepository(dbConnection)
@@ -154,20 +349,93 @@ func eventTransferReceivedConsume(EventPublish events.EventPublish, dbConnection
 
 	handler := eventhandlers.NewTransferReceivedHandler(accountRepository, movementRepository)
 
-	handler.Handler(obj)
+	return handler.Handler(obj)
+}
+
+func eventTransferReversedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.TransferReversed
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	accountRepository := repositories.NewAccountRepository(dbConnection)
+	movementRepository := repositories.NewMovementRepository(dbConnection)
+
+	handler := eventhandlers.NewTransferReversedHandler(accountRepository, movementRepository)
+
+	return handler.Handler(obj)
+}
+
+func eventTransferPendingApprovalConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.TransferPendingApproval
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	repository := repositories.NewTransferRequestRepository(dbConnection)
+	handler := eventhandlers.NewTransferPendingApprovalHandler(repository)
+
+	return handler.Handler(obj)
+}
+
+func eventTransferApprovedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.TransferApproved
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	repository := repositories.NewTransferRequestRepository(dbConnection)
+	handler := eventhandlers.NewTransferRequestStatusChangedHandler(repository)
+
+	return handler.Handler(obj.TransferRequestId, domain.TransferRequestApproved, "")
+}
+
+func eventTransferRejectedConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.TransferRejected
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	repository := repositories.NewTransferRequestRepository(dbConnection)
+	handler := eventhandlers.NewTransferRequestStatusChangedHandler(repository)
+
+	return handler.Handler(obj.TransferRequestId, domain.TransferRequestRejected, obj.Reason)
+}
+
+func eventTransferRequestExpiredConsume(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
+	var obj events.TransferRequestExpired
+	err := decodeEvent([]byte(EventPublish.Data), &obj)
+	if err != nil {
+		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
+	}
+
+	repository := repositories.NewTransferRequestRepository(dbConnection)
+	handler := eventhandlers.NewTransferRequestStatusChangedHandler(repository)
+
+	return handler.Handler(obj.TransferRequestId, domain.TransferRequestExpired, "")
 }
 
-func eventStatementGenerationRequested(EventPublish events.EventPublish, dbConnection *sql.DB) {
+func eventStatementGenerationRequested(EventPublish events.EventPublish, dbConnection repositories.DBTX) error {
 	var obj events.StatementGenerationRequested
 	err := decodeEvent([]byte(EventPublish.Data), &obj)
 	if err != nil {
 		slog.Error("error decoding event", "Type", EventPublish.Type, "error", err)
-		return
+		return fmt.Errorf("%w: %w", errInvalidEvent, err)
 	}
 
 	accountRepository := repositories.NewAccountRepository(dbConnection)
 	statementGenerationRepository := repositories.NewStatementGenerationRepository(dbConnection)
 	movementRepository := repositories.NewMovementRepository(dbConnection)
+	transferRequestRepository := repositories.NewTransferRequestRepository(dbConnection)
 	documentGenerationApi := documentgenerator.NewGenerateDocumentApi(http.Client{})
 	templateCompiler := templatecompiler.NewTemplateCompile()
 
@@ -175,10 +443,11 @@ func eventStatementGenerationRequested(EventPublish events.EventPublish, dbConne
 		accountRepository,
 		statementGenerationRepository,
 		movementRepository,
+		transferRequestRepository,
 		documentGenerationApi,
 		templateCompiler)
 
-	handler.Handle(obj)
+	return handler.Handle(obj)
 }
 
 func decodeEvent(data []byte, obj any) error {
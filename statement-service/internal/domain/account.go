@@ -1,10 +1,25 @@
 package domain
 
+import "errors"
+
+var ErrAccountNotFound = errors.New("account not found")
+
+type AccountStatus string
+
+const (
+	AccountStatusActive  AccountStatus = "active"
+	AccountStatusBlocked AccountStatus = "blocked"
+	AccountStatusClosed  AccountStatus = "closed"
+)
+
 type Account struct {
-	Number   string
-	Document string
-	Name     string
-	Balance  int64
+	Number         string
+	Document       string
+	Name           string
+	Currency       Currency
+	Balance        int64
+	OverdraftLimit int64
+	Status         AccountStatus
 }
 
 func NewAccount(number, document, name string) *Account {
@@ -12,5 +27,21 @@ func NewAccount(number, document, name string) *Account {
 		Number:   number,
 		Document: document,
 		Name:     name,
+		Currency: DefaultCurrency,
+		Status:   AccountStatusActive,
+	}
+}
+
+// Money returns the amount in the currency of the account.
+func (acc *Account) Money(amount int64) Money {
+	return NewMoney(amount, acc.Currency)
+}
+
+// AvailableOverdraftLimit returns how much of the overdraft limit the account can still use.
+func (acc *Account) AvailableOverdraftLimit() int64 {
+	if acc.Balance >= 0 {
+		return acc.OverdraftLimit
 	}
+
+	return acc.OverdraftLimit + acc.Balance
 }
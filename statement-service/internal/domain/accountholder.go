@@ -0,0 +1,25 @@
+package domain
+
+type AccountHolderRole string
+
+const (
+	AccountHolderRolePrimary   AccountHolderRole = "primary"
+	AccountHolderRoleSecondary AccountHolderRole = "secondary"
+)
+
+// AccountHolder is a holder of a joint account, listed in the statement with the account.
+type AccountHolder struct {
+	AccountNumber string
+	Document      string
+	Name          string
+	Role          AccountHolderRole
+}
+
+func NewAccountHolder(accountNumber, document, name string, role AccountHolderRole) *AccountHolder {
+	return &AccountHolder{
+		AccountNumber: accountNumber,
+		Document:      document,
+		Name:          name,
+		Role:          role,
+	}
+}
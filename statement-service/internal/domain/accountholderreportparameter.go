@@ -0,0 +1,7 @@
+package domain
+
+type AccountHolderReportParameter struct {
+	Document string
+	Name     string
+	Role     string
+}
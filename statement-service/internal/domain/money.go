@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+const DefaultCurrency Currency = "BRL"
+
+type currencyFormat struct {
+	symbol     string
+	minorUnits int
+}
+
+// currencyFormats are how the statement renders each currency, unknown currencies are shown
+// by their code with two decimal digits.
+var currencyFormats = map[Currency]currencyFormat{
+	"BRL": {symbol: "R$", minorUnits: 2},
+	"USD": {symbol: "US$", minorUnits: 2},
+	"EUR": {symbol: "€", minorUnits: 2},
+	"GBP": {symbol: "£", minorUnits: 2},
+	"ARS": {symbol: "AR$", minorUnits: 2},
+	"CLP": {symbol: "CLP$", minorUnits: 0},
+	"JPY": {symbol: "¥", minorUnits: 0},
+}
+
+// NormalizeCurrency upper cases the code, an empty code is the default currency.
+func NormalizeCurrency(currency string) Currency {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return DefaultCurrency
+	}
+
+	return Currency(currency)
+}
+
+// Money is an amount in the minor units of its currency, like cents.
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+// Format renders the money with the symbol of its currency, like R$ 12.05. Money without a
+// currency predates multi-currency accounts and is in the default currency.
+func (m Money) Format() string {
+	if m.Currency == "" {
+		m.Currency = DefaultCurrency
+	}
+
+	format, ok := currencyFormats[m.Currency]
+	if !ok {
+		format = currencyFormat{symbol: string(m.Currency), minorUnits: 2}
+	}
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	if format.minorUnits == 0 {
+		return fmt.Sprintf("%v %v%d", format.symbol, sign, amount)
+	}
+
+	scale := int64(1)
+	for range format.minorUnits {
+		scale *= 10
+	}
+
+	return fmt.Sprintf("%v %v%d.%0*d", format.symbol, sign, amount/scale, format.minorUnits, amount%scale)
+}
+
+// UnmarshalJSON accepts a bare amount, as events were published before accounts had a
+// currency, besides the amount and currency object.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var amount int64
+	if json.Unmarshal(data, &amount) == nil {
+		*m = Money{Amount: amount}
+		return nil
+	}
+
+	type money Money
+	var value money
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	*m = Money(value)
+	m.Currency = Currency(strings.ToUpper(string(m.Currency)))
+
+	return nil
+}
@@ -5,17 +5,32 @@ import "time"
 type MovementType string
 
 const (
-	In  MovementType = "in"
-	Out MovementType = "out"
+	In          MovementType = "in"
+	Out         MovementType = "out"
+	ReversalIn  MovementType = "reversal_in"
+	ReversalOut MovementType = "reversal_out"
+	Interest    MovementType = "interest"
+	Fee         MovementType = "fee"
+	PocketIn    MovementType = "pocket_in"
+	PocketOut   MovementType = "pocket_out"
 )
 
+// Movement is a balance change of the account. Transfers and reversals carry the transfer id
+// and reversals also the id of the transfer they reverse, fees carry the id of the transaction
+// they were charged on. Pocket movements are moves between the main balance and a pocket of the
+// account, they carry the pocket and do not change the account balance.
 type Movement struct {
-	Id              int
-	Type            string
-	AccountNumber   string
-	Value           int64
-	ToAccountNumber string
-	CreatedAt       time.Time
+	Id                  int
+	Type                string
+	AccountNumber       string
+	Value               int64
+	ToAccountNumber     string
+	TransferId          string
+	ReversedTransferId  string
+	OriginTransactionId string
+	PocketId            string
+	PocketName          string
+	CreatedAt           time.Time
 }
 
 func NewDepositedFundsMovement(accountNumber string, value int64) *Movement {
@@ -27,22 +42,88 @@ func NewDepositedFundsMovement(accountNumber string, value int64) *Movement {
 	}
 }
 
-func NewTransferRealizedMovement(accountNumber, toAccountNumber string, value int64) *Movement {
+func NewInterestCreditedMovement(accountNumber string, value int64) *Movement {
+	return &Movement{
+		Type:          string(Interest),
+		AccountNumber: accountNumber,
+		Value:         value,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func NewFeeChargedMovement(accountNumber string, value int64, transactionId, originTransactionId string) *Movement {
+	return &Movement{
+		Type:                string(Fee),
+		AccountNumber:       accountNumber,
+		Value:               value,
+		TransferId:          transactionId,
+		OriginTransactionId: originTransactionId,
+		CreatedAt:           time.Now(),
+	}
+}
+
+func NewWithdrawnFundsMovement(accountNumber string, value int64) *Movement {
+	return &Movement{
+		Type:          string(Out),
+		AccountNumber: accountNumber,
+		Value:         value,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func NewTransferRealizedMovement(accountNumber, toAccountNumber string, value int64, transferId string) *Movement {
 	return &Movement{
 		Type:            string(Out),
 		AccountNumber:   accountNumber,
 		Value:           value,
 		ToAccountNumber: toAccountNumber,
+		TransferId:      transferId,
 		CreatedAt:       time.Now(),
 	}
 }
 
-func NewTransferReceivedMovement(accountNumber, toAccountNumber string, value int64) *Movement {
+func NewTransferReceivedMovement(accountNumber, toAccountNumber string, value int64, transferId string) *Movement {
 	return &Movement{
 		Type:            string(In),
 		AccountNumber:   accountNumber,
 		Value:           value,
 		ToAccountNumber: toAccountNumber,
+		TransferId:      transferId,
 		CreatedAt:       time.Now(),
 	}
 }
+
+func NewReversalRealizedMovement(accountNumber, toAccountNumber string, value int64, reversalId, reversedTransferId string) *Movement {
+	return &Movement{
+		Type:               string(ReversalOut),
+		AccountNumber:      accountNumber,
+		Value:              value,
+		ToAccountNumber:    toAccountNumber,
+		TransferId:         reversalId,
+		ReversedTransferId: reversedTransferId,
+		CreatedAt:          time.Now(),
+	}
+}
+
+func NewReversalReceivedMovement(accountNumber, toAccountNumber string, value int64, reversalId, reversedTransferId string) *Movement {
+	return &Movement{
+		Type:               string(ReversalIn),
+		AccountNumber:      accountNumber,
+		Value:              value,
+		ToAccountNumber:    toAccountNumber,
+		TransferId:         reversalId,
+		ReversedTransferId: reversedTransferId,
+		CreatedAt:          time.Now(),
+	}
+}
+
+func NewPocketFundsMovedMovement(accountNumber string, movementType MovementType, value int64, pocketId, pocketName string) *Movement {
+	return &Movement{
+		Type:          string(movementType),
+		AccountNumber: accountNumber,
+		Value:         value,
+		PocketId:      pocketId,
+		PocketName:    pocketName,
+		CreatedAt:     time.Now(),
+	}
+}
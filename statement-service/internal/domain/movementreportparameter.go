@@ -4,5 +4,6 @@ type MovementReportParameter struct {
 	CreatedAt          string
 	Type               string
 	DestinationAccount string
+	Reference          string
 	Amount             string
 }
@@ -0,0 +1,7 @@
+package domain
+
+type PocketReportParameter struct {
+	Name      string
+	Balance   string
+	Movements []MovementReportParameter
+}
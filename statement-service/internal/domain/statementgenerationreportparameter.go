@@ -1,7 +1,12 @@
 package domain
 
 type StatementGenerationReportParameter struct {
-	Document     string
-	CustomerName string
-	Movements    []MovementReportParameter
+	Document                string
+	CustomerName            string
+	Holders                 []AccountHolderReportParameter
+	Status                  string
+	AvailableOverdraftLimit string
+	Movements               []MovementReportParameter
+	Pockets                 []PocketReportParameter
+	TransferRequests        []TransferRequestReportParameter
 }
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+type TransferRequestStatus string
+
+const (
+	TransferRequestPendingApproval TransferRequestStatus = "pending_approval"
+	TransferRequestApproved        TransferRequestStatus = "approved"
+	TransferRequestRejected        TransferRequestStatus = "rejected"
+	TransferRequestExpired         TransferRequestStatus = "expired"
+)
+
+// TransferRequest is a transfer of the account above the approval threshold. Until approved no
+// money moves, so it is shown apart from the movements of the statement.
+type TransferRequest struct {
+	Id         string
+	FromNumber string
+	ToNumber   string
+	Value      int64
+	Status     TransferRequestStatus
+	Reason     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewPendingTransferRequest(id, fromNumber, toNumber string, value int64) *TransferRequest {
+	now := time.Now()
+
+	return &TransferRequest{
+		Id:         id,
+		FromNumber: fromNumber,
+		ToNumber:   toNumber,
+		Value:      value,
+		Status:     TransferRequestPendingApproval,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
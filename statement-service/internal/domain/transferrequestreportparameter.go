@@ -0,0 +1,9 @@
+package domain
+
+type TransferRequestReportParameter struct {
+	CreatedAt          string
+	Status             string
+	DestinationAccount string
+	Reason             string
+	Amount             string
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type AccountCreatedHandlerInterface interface {
-	Handler(event events.AccountCreated)
+	Handler(event events.AccountCreated) error
 }
 
 type AccountCreatedHandler struct {
@@ -22,17 +22,28 @@ func NewAccountCreatedHandler(accountRepository repositories.AccountRepositoryIn
 	}
 }
 
-func (h *AccountCreatedHandler) Handler(event events.AccountCreated) {
+func (h *AccountCreatedHandler) Handler(event events.AccountCreated) error {
 	slog.Info("handling account created", "number", event.Number)
 
 	acc := domain.NewAccount(event.Number, event.Document, event.Name)
+	acc.Currency = domain.NormalizeCurrency(event.Currency)
 
 	err := h.accountRepository.CreateAccount(acc)
 
 	if err != nil {
 		slog.Error("error creating account", "error", err)
-		return
+		return err
 	}
 
-	slog.Info("account created", "number", event.Number)
+	for _, holder := range event.Holders {
+		err = h.accountRepository.CreateAccountHolder(domain.NewAccountHolder(event.Number, holder.Document, holder.Name, domain.AccountHolderRole(holder.Role)))
+		if err != nil {
+			slog.Error("error creating account holder", "error", err, "number", event.Number)
+			return err
+		}
+	}
+
+	slog.Info("account created", "number", event.Number, "holders", len(event.Holders))
+
+	return nil
 }
@@ -45,8 +45,62 @@ func TestAccountCreatedHandler_Handler(t *testing.T) {
 		})
 
 	// Act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// Assert
+	require.NoError(t, err)
+	accountRepoMock.AssertExpectations(t)
+}
+
+func TestAccountCreatedHandler_Handler_Currency(t *testing.T) {
+	// Arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountCreatedHandler(accountRepoMock)
+
+	event := events.AccountCreated{
+		Number:   "123456789",
+		Document: "12345678900",
+		Name:     "John Doe",
+		Currency: "USD",
+	}
+
+	accountRepoMock.
+		On("CreateAccount", mock.MatchedBy(func(acc *domain.Account) bool {
+			return acc.Currency == "USD"
+		})).
+		Return(nil)
+
+	// Act
+	err := handler.Handler(event)
+
+	// Assert
+	require.NoError(t, err)
+	accountRepoMock.AssertExpectations(t)
+}
+
+func TestAccountCreatedHandler_Handler_Holders(t *testing.T) {
+	// Arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountCreatedHandler(accountRepoMock)
+
+	event := events.AccountCreated{
+		Number:   "123456789",
+		Document: "01234567890",
+		Name:     "John Doe",
+		Holders: []events.AccountHolder{
+			{Document: "01234567890", Name: "John Doe", Role: "primary"},
+			{Document: "52998224725", Name: "Jane Doe", Role: "secondary"},
+		},
+	}
+
+	accountRepoMock.On("CreateAccount", mock.AnythingOfType("*domain.Account")).Return(nil)
+	accountRepoMock.On("CreateAccountHolder", domain.NewAccountHolder("123456789", "01234567890", "John Doe", domain.AccountHolderRolePrimary)).Return(nil)
+	accountRepoMock.On("CreateAccountHolder", domain.NewAccountHolder("123456789", "52998224725", "Jane Doe", domain.AccountHolderRoleSecondary)).Return(nil)
+
+	// Act
+	err := handler.Handler(event)
+
+	// Assert
+	require.NoError(t, err)
 	accountRepoMock.AssertExpectations(t)
 }
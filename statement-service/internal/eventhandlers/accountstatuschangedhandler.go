@@ -0,0 +1,51 @@
+package eventhandlers
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+)
+
+// AccountStatusChangedHandlerInterface handles the AccountBlocked, AccountUnblocked and
+// AccountClosed events, which only differ by the status they set.
+type AccountStatusChangedHandlerInterface interface {
+	Handler(number string, status domain.AccountStatus) error
+}
+
+type AccountStatusChangedHandler struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewAccountStatusChangedHandler(accountRepository repositories.AccountRepositoryInterface) AccountStatusChangedHandlerInterface {
+	return &AccountStatusChangedHandler{
+		accountRepository: accountRepository,
+	}
+}
+
+func (h *AccountStatusChangedHandler) Handler(number string, status domain.AccountStatus) error {
+	slog.Info("handling account status changed", "number", number, "status", status)
+
+	acc, err := h.accountRepository.GetAccountByNumber(number)
+	if err != nil {
+		slog.Error("error getting account", "error", err)
+		return err
+	}
+
+	if acc == nil {
+		slog.Error("account not found", "number", number)
+		return domain.ErrAccountNotFound
+	}
+
+	acc.Status = status
+
+	err = h.accountRepository.UpdateAccountStatus(acc)
+	if err != nil {
+		slog.Error("error updating account status", "error", err, "number", number)
+		return err
+	}
+
+	slog.Info("account status updated", "number", number, "status", status)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package eventhandlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccountStatusChangedHandler_Handler_ErrorGettingAccount(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountStatusChangedHandler(accountrepomock)
+
+	accountrepomock.
+		On("GetAccountByNumber", "1234567890").
+		Return((*domain.Account)(nil), errors.New("generic error"))
+
+	// act
+	err := handler.Handler("1234567890", domain.AccountStatusBlocked)
+
+	// assert
+	assert.Error(t, err)
+	accountrepomock.AssertExpectations(t)
+	accountrepomock.AssertNotCalled(t, "UpdateAccountStatus", mock.Anything)
+}
+
+func TestAccountStatusChangedHandler_Handler_AccountNotFound(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountStatusChangedHandler(accountrepomock)
+
+	accountrepomock.
+		On("GetAccountByNumber", "1234567890").
+		Return((*domain.Account)(nil), nil)
+
+	// act
+	err := handler.Handler("1234567890", domain.AccountStatusBlocked)
+
+	// assert
+	assert.Equal(t, domain.ErrAccountNotFound, err)
+	accountrepomock.AssertExpectations(t)
+	accountrepomock.AssertNotCalled(t, "UpdateAccountStatus", mock.Anything)
+}
+
+func TestAccountStatusChangedHandler_Handler_Success(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountStatusChangedHandler(accountrepomock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+
+	accountrepomock.On("GetAccountByNumber", acc.Number).Return(acc, nil)
+	accountrepomock.On("UpdateAccountStatus", acc).Return(nil)
+
+	// act
+	err := handler.Handler(acc.Number, domain.AccountStatusClosed)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AccountStatusClosed, acc.Status)
+	accountrepomock.AssertExpectations(t)
+}
@@ -0,0 +1,63 @@
+package eventhandlers
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+)
+
+type AccountUpdatedHandlerInterface interface {
+	Handler(event events.AccountUpdated) error
+}
+
+type AccountUpdatedHandler struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewAccountUpdatedHandler(accountRepository repositories.AccountRepositoryInterface) AccountUpdatedHandlerInterface {
+	return &AccountUpdatedHandler{
+		accountRepository: accountRepository,
+	}
+}
+
+// Handler keeps the name of the account current, so statements generated from now on show it.
+// The event carries the whole profile, so replaying it is harmless.
+func (h *AccountUpdatedHandler) Handler(event events.AccountUpdated) error {
+	slog.Info("handling account updated", "number", event.Number, "fields", event.ChangedFields)
+
+	acc, err := h.accountRepository.GetAccountByNumber(event.Number)
+	if err != nil {
+		slog.Error("error getting account", "error", err)
+		return err
+	}
+
+	if acc == nil {
+		slog.Error("account not found", "number", event.Number)
+		return domain.ErrAccountNotFound
+	}
+
+	if acc.Name == event.Name {
+		slog.Info("account name unchanged", "number", event.Number)
+		return nil
+	}
+
+	acc.Name = event.Name
+
+	err = h.accountRepository.UpdateAccountName(acc)
+	if err != nil {
+		slog.Error("error updating account name", "error", err, "number", event.Number)
+		return err
+	}
+
+	err = h.accountRepository.UpdatePrimaryHolderName(acc)
+	if err != nil {
+		slog.Error("error updating primary holder name", "error", err, "number", event.Number)
+		return err
+	}
+
+	slog.Info("account name updated", "number", event.Number)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package eventhandlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccountUpdatedHandler_Handler_AccountNotFound(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountUpdatedHandler(accountrepomock)
+
+	event := events.AccountUpdated{Number: "1234567890", Name: "John Bidden", ChangedFields: []string{"name"}}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return((*domain.Account)(nil), nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Equal(t, domain.ErrAccountNotFound, err)
+	accountrepomock.AssertExpectations(t)
+	accountrepomock.AssertNotCalled(t, "UpdateAccountName", mock.Anything)
+}
+
+func TestAccountUpdatedHandler_Handler_NameUnchanged(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountUpdatedHandler(accountrepomock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	event := events.AccountUpdated{Number: acc.Number, Name: acc.Name, Email: "john@example.com", ChangedFields: []string{"email"}}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.NoError(t, err)
+	accountrepomock.AssertExpectations(t)
+	accountrepomock.AssertNotCalled(t, "UpdateAccountName", mock.Anything)
+}
+
+func TestAccountUpdatedHandler_Handler_ErrorUpdatingAccount(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountUpdatedHandler(accountrepomock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	event := events.AccountUpdated{Number: acc.Number, Name: "John Bidden", ChangedFields: []string{"name"}}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountrepomock.On("UpdateAccountName", acc).Return(errors.New("update error"))
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Error(t, err)
+	accountrepomock.AssertExpectations(t)
+	accountrepomock.AssertNotCalled(t, "UpdatePrimaryHolderName", mock.Anything)
+}
+
+func TestAccountUpdatedHandler_Handler_Success(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewAccountUpdatedHandler(accountrepomock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	event := events.AccountUpdated{Number: acc.Number, Name: "John Bidden", ChangedFields: []string{"name"}}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountrepomock.On("UpdateAccountName", acc).Return(nil)
+	accountrepomock.On("UpdatePrimaryHolderName", acc).Return(nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "John Bidden", acc.Name)
+	accountrepomock.AssertExpectations(t)
+}
@@ -0,0 +1,59 @@
+package eventhandlers
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+)
+
+type FeeChargedHandlerInterface interface {
+	Handler(event events.FeeCharged) error
+}
+
+type FeeChargedHandler struct {
+	accountRepository  repositories.AccountRepositoryInterface
+	movementRepository repositories.MovementRepositoryInterface
+}
+
+func NewFeeChargedHandler(accountRepository repositories.AccountRepositoryInterface, movementRepository repositories.MovementRepositoryInterface) FeeChargedHandlerInterface {
+	return &FeeChargedHandler{
+		accountRepository:  accountRepository,
+		movementRepository: movementRepository,
+	}
+}
+
+func (h *FeeChargedHandler) Handler(event events.FeeCharged) error {
+	slog.Info("handling fee charged", "number", event.Number, "operation", event.Operation)
+
+	acc, err := h.accountRepository.GetAccountByNumber(event.Number)
+	if err != nil {
+		slog.Error("error getting account", "error", err)
+		return err
+	}
+
+	if acc == nil {
+		slog.Error("account not found", "number", event.Number)
+		return domain.ErrAccountNotFound
+	}
+
+	acc.Balance = event.Balance.Amount
+
+	err = h.accountRepository.UpdateAccountBalance(acc)
+	if err != nil {
+		slog.Error("error updating account balance", "error", err, "number", event.Number)
+		return err
+	}
+
+	movement := domain.NewFeeChargedMovement(event.Number, event.Value.Amount, event.TransactionId, event.OriginTransactionId)
+	err = h.movementRepository.CreateMovement(movement)
+	if err != nil {
+		slog.Error("error creating movement", "error", err, "number", event.Number)
+		return err
+	}
+
+	slog.Info("fee charged account updated", "number", event.Number)
+
+	return nil
+}
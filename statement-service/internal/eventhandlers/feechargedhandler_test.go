@@ -0,0 +1,74 @@
+package eventhandlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFeeChargedHandler_Handler_Success(t *testing.T) {
+	// arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewFeeChargedHandler(accountRepoMock, movementRepoMock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	acc.Balance = 100000
+
+	event := events.FeeCharged{
+		Number:              "1234567890",
+		Operation:           "transfer",
+		Value:               domain.Money{Amount: 50, Currency: "BRL"},
+		Balance:             domain.Money{Amount: 99950, Currency: "BRL"},
+		TransactionId:       "12",
+		OriginTransactionId: "11",
+	}
+
+	accountRepoMock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountRepoMock.On("UpdateAccountBalance", acc).Return(nil)
+	movementRepoMock.On("CreateMovement", mock.MatchedBy(func(movement *domain.Movement) bool {
+		return movement.Type == string(domain.Fee) && movement.AccountNumber == event.Number && movement.Value == 50 &&
+			movement.TransferId == "12" && movement.OriginTransactionId == "11"
+	})).Return(nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(99950), acc.Balance)
+	accountRepoMock.AssertExpectations(t)
+	movementRepoMock.AssertExpectations(t)
+}
+
+func TestFeeChargedHandler_Handler_ErrorUpdatingBalance(t *testing.T) {
+	// arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewFeeChargedHandler(accountRepoMock, movementRepoMock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+
+	event := events.FeeCharged{
+		Number:    "1234567890",
+		Operation: "maintenance",
+		Value:     domain.Money{Amount: 1290, Currency: "BRL"},
+		Balance:   domain.Money{Amount: -1290, Currency: "BRL"},
+	}
+
+	accountRepoMock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountRepoMock.On("UpdateAccountBalance", acc).Return(errors.New("generic error"))
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Error(t, err)
+	accountRepoMock.AssertExpectations(t)
+	movementRepoMock.AssertNotCalled(t, "CreateMovement", mock.Anything)
+}
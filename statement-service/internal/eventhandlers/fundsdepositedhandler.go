@@ -9,7 +9,7 @@ import (
 )
 
 type FundsDepositedHandlerInterface interface {
-	Handler(event events.FundsDeposited)
+	Handler(event events.FundsDeposited) error
 }
 
 type FundsDepositedHandler struct {
@@ -24,34 +24,36 @@ func NewFundsDepositedHandler(accountRepository repositories.AccountRepositoryIn
 	}
 }
 
-func (h *FundsDepositedHandler) Handler(event events.FundsDeposited) {
+func (h *FundsDepositedHandler) Handler(event events.FundsDeposited) error {
 	slog.Info("handling funds deposited", "number", event.Number)
 
 	acc, err := h.accountRepository.GetAccountByNumber(event.Number)
 	if err != nil {
 		slog.Error("error getting account", "error", err)
-		return
+		return err
 	}
 
 	if acc == nil {
 		slog.Error("account not found", "number", event.Number)
-		return
+		return domain.ErrAccountNotFound
 	}
 
-	acc.Balance += event.Value
+	acc.Balance += event.Value.Amount
 
 	err = h.accountRepository.UpdateAccountBalance(acc)
 	if err != nil {
 		slog.Error("error updating account balance", "error", err, "number", event.Number)
-		return
+		return err
 	}
 
-	movement := domain.NewDepositedFundsMovement(event.Number, event.Value)
+	movement := domain.NewDepositedFundsMovement(event.Number, event.Value.Amount)
 	err = h.movementRepository.CreateMovement(movement)
 	if err != nil {
 		slog.Error("error creating movement", "error", err, "number", event.Number)
-		return
+		return err
 	}
 
 	slog.Info("funds deposited account updated", "number", event.Number)
+
+	return nil
 }
@@ -19,7 +19,7 @@ func TestFundsDepositedHandler_Handler_ErrorGettingAccount(t *testing.T) {
 
 	event := events.FundsDeposited{
 		Number: "1234567890",
-		Value:  100,
+		Value:  domain.Money{Amount: 100, Currency: "BRL"},
 	}
 
 	accountrepomock.
@@ -29,9 +29,10 @@ func TestFundsDepositedHandler_Handler_ErrorGettingAccount(t *testing.T) {
 	movementRepoMock.On("CreateMovement", mock.Anything).Return(nil)
 
 	// act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// assert
+	assert.Error(t, err)
 	accountrepomock.AssertExpectations(t)
 }
 
@@ -43,7 +44,7 @@ func TestFundsDepositedHandler_Handler_AccountNotFound(t *testing.T) {
 
 	event := events.FundsDeposited{
 		Number: "1234567890",
-		Value:  100,
+		Value:  domain.Money{Amount: 100, Currency: "BRL"},
 	}
 
 	accountrepomock.
@@ -53,9 +54,10 @@ func TestFundsDepositedHandler_Handler_AccountNotFound(t *testing.T) {
 	movementRepoMock.On("CreateMovement", mock.Anything).Return(nil)
 
 	// act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// assert
+	assert.Equal(t, domain.ErrAccountNotFound, err)
 	accountrepomock.AssertExpectations(t)
 }
 
@@ -68,7 +70,7 @@ func TestFundsDepositedHandler_Handler_ErrorUpdatingAccountBalance(t *testing.T)
 	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
 	event := events.FundsDeposited{
 		Number: "1234567890",
-		Value:  100,
+		Value:  domain.Money{Amount: 100, Currency: "BRL"},
 	}
 
 	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
@@ -77,9 +79,10 @@ func TestFundsDepositedHandler_Handler_ErrorUpdatingAccountBalance(t *testing.T)
 	movementRepoMock.On("CreateMovement", mock.Anything).Return(nil)
 
 	// act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// assert
+	assert.Error(t, err)
 	assert.Equal(t, int64(100), acc.Balance)
 	accountrepomock.AssertExpectations(t)
 }
@@ -95,7 +98,7 @@ func TestFundsDepositedHandler_Handler_Success(t *testing.T) {
 
 	event := events.FundsDeposited{
 		Number: "1234567890",
-		Value:  100,
+		Value:  domain.Money{Amount: 100, Currency: "BRL"},
 	}
 
 	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
@@ -104,9 +107,10 @@ func TestFundsDepositedHandler_Handler_Success(t *testing.T) {
 	movementRepoMock.On("CreateMovement", mock.Anything).Return(nil)
 
 	// act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// assert
+	assert.NoError(t, err)
 	assert.Equal(t, int64(200), acc.Balance)
 	accountrepomock.AssertExpectations(t)
 }
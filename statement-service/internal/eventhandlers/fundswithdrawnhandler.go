@@ -0,0 +1,59 @@
+package eventhandlers
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+)
+
+type FundsWithdrawnHandlerInterface interface {
+	Handler(event events.FundsWithdrawn) error
+}
+
+type FundsWithdrawnHandler struct {
+	accountRepository  repositories.AccountRepositoryInterface
+	movementRepository repositories.MovementRepositoryInterface
+}
+
+func NewFundsWithdrawnHandler(accountRepository repositories.AccountRepositoryInterface, movementRepository repositories.MovementRepositoryInterface) FundsWithdrawnHandlerInterface {
+	return &FundsWithdrawnHandler{
+		accountRepository:  accountRepository,
+		movementRepository: movementRepository,
+	}
+}
+
+func (h *FundsWithdrawnHandler) Handler(event events.FundsWithdrawn) error {
+	slog.Info("handling funds withdrawn", "number", event.Number)
+
+	acc, err := h.accountRepository.GetAccountByNumber(event.Number)
+	if err != nil {
+		slog.Error("error getting account", "error", err)
+		return err
+	}
+
+	if acc == nil {
+		slog.Error("account not found", "number", event.Number)
+		return domain.ErrAccountNotFound
+	}
+
+	acc.Balance -= event.Value.Amount
+
+	err = h.accountRepository.UpdateAccountBalance(acc)
+	if err != nil {
+		slog.Error("error updating account balance", "error", err, "number", event.Number)
+		return err
+	}
+
+	movement := domain.NewWithdrawnFundsMovement(event.Number, event.Value.Amount)
+	err = h.movementRepository.CreateMovement(movement)
+	if err != nil {
+		slog.Error("error creating movement", "error", err, "number", event.Number)
+		return err
+	}
+
+	slog.Info("funds withdrawn account updated", "number", event.Number)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package eventhandlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFundsWithdrawnHandler_Handler_ErrorGettingAccount(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewFundsWithdrawnHandler(accountrepomock, movementRepoMock)
+
+	event := events.FundsWithdrawn{
+		Number: "1234567890",
+		Value:  domain.Money{Amount: 100, Currency: "BRL"},
+	}
+
+	accountrepomock.
+		On("GetAccountByNumber", event.Number).
+		Return((*domain.Account)(nil), errors.New("generic error"))
+
+	movementRepoMock.On("CreateMovement", mock.Anything).Return(nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Error(t, err)
+	accountrepomock.AssertExpectations(t)
+}
+
+func TestFundsWithdrawnHandler_Handler_AccountNotFound(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewFundsWithdrawnHandler(accountrepomock, movementRepoMock)
+
+	event := events.FundsWithdrawn{
+		Number: "1234567890",
+		Value:  domain.Money{Amount: 100, Currency: "BRL"},
+	}
+
+	accountrepomock.
+		On("GetAccountByNumber", event.Number).
+		Return((*domain.Account)(nil), nil)
+
+	movementRepoMock.On("CreateMovement", mock.Anything).Return(nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Equal(t, domain.ErrAccountNotFound, err)
+	accountrepomock.AssertExpectations(t)
+}
+
+func TestFundsWithdrawnHandler_Handler_ErrorUpdatingAccountBalance(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewFundsWithdrawnHandler(accountrepomock, movementRepoMock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	acc.Balance = 300
+	event := events.FundsWithdrawn{
+		Number: "1234567890",
+		Value:  domain.Money{Amount: 100, Currency: "BRL"},
+	}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountrepomock.On("UpdateAccountBalance", mock.Anything).Return(errors.New("update error"))
+
+	movementRepoMock.On("CreateMovement", mock.Anything).Return(nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Error(t, err)
+	assert.Equal(t, int64(200), acc.Balance)
+	accountrepomock.AssertExpectations(t)
+}
+
+func TestFundsWithdrawnHandler_Handler_Success(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewFundsWithdrawnHandler(accountrepomock, movementRepoMock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	acc.Balance = 100
+
+	event := events.FundsWithdrawn{
+		Number: "1234567890",
+		Value:  domain.Money{Amount: 100, Currency: "BRL"},
+	}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountrepomock.On("UpdateAccountBalance", acc).Return(nil)
+
+	movementRepoMock.On("CreateMovement", mock.MatchedBy(func(movement *domain.Movement) bool {
+		return movement.Type == "out" && movement.AccountNumber == event.Number && movement.Value == event.Value.Amount && movement.ToAccountNumber == ""
+	})).Return(nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), acc.Balance)
+	accountrepomock.AssertExpectations(t)
+	movementRepoMock.AssertExpectations(t)
+}
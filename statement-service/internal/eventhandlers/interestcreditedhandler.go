@@ -0,0 +1,59 @@
+package eventhandlers
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+)
+
+type InterestCreditedHandlerInterface interface {
+	Handler(event events.InterestCredited) error
+}
+
+type InterestCreditedHandler struct {
+	accountRepository  repositories.AccountRepositoryInterface
+	movementRepository repositories.MovementRepositoryInterface
+}
+
+func NewInterestCreditedHandler(accountRepository repositories.AccountRepositoryInterface, movementRepository repositories.MovementRepositoryInterface) InterestCreditedHandlerInterface {
+	return &InterestCreditedHandler{
+		accountRepository:  accountRepository,
+		movementRepository: movementRepository,
+	}
+}
+
+func (h *InterestCreditedHandler) Handler(event events.InterestCredited) error {
+	slog.Info("handling interest credited", "number", event.Number, "period", event.Period)
+
+	acc, err := h.accountRepository.GetAccountByNumber(event.Number)
+	if err != nil {
+		slog.Error("error getting account", "error", err)
+		return err
+	}
+
+	if acc == nil {
+		slog.Error("account not found", "number", event.Number)
+		return domain.ErrAccountNotFound
+	}
+
+	acc.Balance = event.Balance.Amount
+
+	err = h.accountRepository.UpdateAccountBalance(acc)
+	if err != nil {
+		slog.Error("error updating account balance", "error", err, "number", event.Number)
+		return err
+	}
+
+	movement := domain.NewInterestCreditedMovement(event.Number, event.Value.Amount)
+	err = h.movementRepository.CreateMovement(movement)
+	if err != nil {
+		slog.Error("error creating movement", "error", err, "number", event.Number)
+		return err
+	}
+
+	slog.Info("interest credited account updated", "number", event.Number)
+
+	return nil
+}
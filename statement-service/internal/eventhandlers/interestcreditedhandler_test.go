@@ -0,0 +1,66 @@
+package eventhandlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInterestCreditedHandler_Handler_Success(t *testing.T) {
+	// arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewInterestCreditedHandler(accountRepoMock, movementRepoMock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	acc.Balance = 100000
+
+	event := events.InterestCredited{
+		Number:  "1234567890",
+		Value:   domain.Money{Amount: 540, Currency: "BRL"},
+		Balance: domain.Money{Amount: 100540, Currency: "BRL"},
+		Period:  "2024-05",
+	}
+
+	accountRepoMock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountRepoMock.On("UpdateAccountBalance", acc).Return(nil)
+	movementRepoMock.On("CreateMovement", mock.MatchedBy(func(movement *domain.Movement) bool {
+		return movement.Type == string(domain.Interest) && movement.AccountNumber == event.Number && movement.Value == 540
+	})).Return(nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100540), acc.Balance)
+	accountRepoMock.AssertExpectations(t)
+	movementRepoMock.AssertExpectations(t)
+}
+
+func TestInterestCreditedHandler_Handler_ErrorGettingAccount(t *testing.T) {
+	// arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewInterestCreditedHandler(accountRepoMock, movementRepoMock)
+
+	event := events.InterestCredited{
+		Number: "1234567890",
+		Value:  domain.Money{Amount: 540, Currency: "BRL"},
+	}
+
+	accountRepoMock.On("GetAccountByNumber", event.Number).Return((*domain.Account)(nil), errors.New("generic error"))
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Error(t, err)
+	accountRepoMock.AssertExpectations(t)
+	movementRepoMock.AssertNotCalled(t, "CreateMovement", mock.Anything)
+}
@@ -0,0 +1,50 @@
+package eventhandlers
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+)
+
+type OverdraftLimitChangedHandlerInterface interface {
+	Handler(event events.OverdraftLimitChanged) error
+}
+
+type OverdraftLimitChangedHandler struct {
+	accountRepository repositories.AccountRepositoryInterface
+}
+
+func NewOverdraftLimitChangedHandler(accountRepository repositories.AccountRepositoryInterface) OverdraftLimitChangedHandlerInterface {
+	return &OverdraftLimitChangedHandler{
+		accountRepository: accountRepository,
+	}
+}
+
+func (h *OverdraftLimitChangedHandler) Handler(event events.OverdraftLimitChanged) error {
+	slog.Info("handling overdraft limit changed", "number", event.Number)
+
+	acc, err := h.accountRepository.GetAccountByNumber(event.Number)
+	if err != nil {
+		slog.Error("error getting account", "error", err)
+		return err
+	}
+
+	if acc == nil {
+		slog.Error("account not found", "number", event.Number)
+		return domain.ErrAccountNotFound
+	}
+
+	acc.OverdraftLimit = event.OverdraftLimit
+
+	err = h.accountRepository.UpdateAccountOverdraftLimit(acc)
+	if err != nil {
+		slog.Error("error updating account overdraft limit", "error", err, "number", event.Number)
+		return err
+	}
+
+	slog.Info("account overdraft limit updated", "number", event.Number)
+
+	return nil
+}
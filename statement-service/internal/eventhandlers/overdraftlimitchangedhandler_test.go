@@ -0,0 +1,69 @@
+package eventhandlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOverdraftLimitChangedHandler_Handler_AccountNotFound(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewOverdraftLimitChangedHandler(accountrepomock)
+
+	event := events.OverdraftLimitChanged{Number: "1234567890", OverdraftLimit: 500}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return((*domain.Account)(nil), nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Equal(t, domain.ErrAccountNotFound, err)
+	accountrepomock.AssertExpectations(t)
+	accountrepomock.AssertNotCalled(t, "UpdateAccountOverdraftLimit", mock.Anything)
+}
+
+func TestOverdraftLimitChangedHandler_Handler_ErrorUpdatingAccount(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewOverdraftLimitChangedHandler(accountrepomock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	event := events.OverdraftLimitChanged{Number: acc.Number, OverdraftLimit: 500}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountrepomock.On("UpdateAccountOverdraftLimit", acc).Return(errors.New("update error"))
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Error(t, err)
+	accountrepomock.AssertExpectations(t)
+}
+
+func TestOverdraftLimitChangedHandler_Handler_Success(t *testing.T) {
+	// arrange
+	accountrepomock := new(handlersmock.MockAccountRepository)
+	handler := NewOverdraftLimitChangedHandler(accountrepomock)
+
+	acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+	event := events.OverdraftLimitChanged{Number: acc.Number, OverdraftLimit: 500}
+
+	accountrepomock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+	accountrepomock.On("UpdateAccountOverdraftLimit", acc).Return(nil)
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), acc.OverdraftLimit)
+	accountrepomock.AssertExpectations(t)
+}
@@ -0,0 +1,65 @@
+package eventhandlers
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+)
+
+type PocketFundsMovedHandlerInterface interface {
+	Handler(event events.PocketFundsMoved) error
+}
+
+type PocketFundsMovedHandler struct {
+	accountRepository  repositories.AccountRepositoryInterface
+	movementRepository repositories.MovementRepositoryInterface
+}
+
+func NewPocketFundsMovedHandler(accountRepository repositories.AccountRepositoryInterface, movementRepository repositories.MovementRepositoryInterface) PocketFundsMovedHandlerInterface {
+	return &PocketFundsMovedHandler{
+		accountRepository:  accountRepository,
+		movementRepository: movementRepository,
+	}
+}
+
+// Handler records the move in the statement of the account, pocket moves do not change the
+// account balance.
+func (h *PocketFundsMovedHandler) Handler(event events.PocketFundsMoved) error {
+	slog.Info("handling pocket funds moved", "number", event.Number, "pocketId", event.PocketId)
+
+	var movementType domain.MovementType
+	switch event.Direction {
+	case "in":
+		movementType = domain.PocketIn
+	case "out":
+		movementType = domain.PocketOut
+	default:
+		slog.Error("invalid pocket move direction", "number", event.Number, "direction", event.Direction)
+		return fmt.Errorf("invalid pocket move direction %v", event.Direction)
+	}
+
+	acc, err := h.accountRepository.GetAccountByNumber(event.Number)
+	if err != nil {
+		slog.Error("error getting account", "error", err)
+		return err
+	}
+
+	if acc == nil {
+		slog.Error("account not found", "number", event.Number)
+		return domain.ErrAccountNotFound
+	}
+
+	movement := domain.NewPocketFundsMovedMovement(event.Number, movementType, event.Value.Amount, event.PocketId, event.PocketName)
+	err = h.movementRepository.CreateMovement(movement)
+	if err != nil {
+		slog.Error("error creating movement", "error", err, "number", event.Number)
+		return err
+	}
+
+	slog.Info("pocket funds moved movement created", "number", event.Number, "pocketId", event.PocketId)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package eventhandlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPocketFundsMovedHandler_Handler_Success(t *testing.T) {
+	testCases := []struct {
+		direction    string
+		movementType domain.MovementType
+	}{
+		{direction: "in", movementType: domain.PocketIn},
+		{direction: "out", movementType: domain.PocketOut},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.direction, func(t *testing.T) {
+			// arrange
+			accountRepoMock := new(handlersmock.MockAccountRepository)
+			movementRepoMock := new(handlersmock.MockMovementRepository)
+			handler := NewPocketFundsMovedHandler(accountRepoMock, movementRepoMock)
+
+			acc := domain.NewAccount("1234567890", "01234567890", "John Doe")
+			acc.Balance = 100000
+
+			event := events.PocketFundsMoved{
+				Number:        "1234567890",
+				PocketId:      "4",
+				PocketName:    "Vacation",
+				Direction:     tc.direction,
+				Value:         domain.Money{Amount: 300, Currency: "BRL"},
+				PocketBalance: domain.Money{Amount: 300, Currency: "BRL"},
+			}
+
+			accountRepoMock.On("GetAccountByNumber", event.Number).Return(acc, nil)
+			movementRepoMock.On("CreateMovement", mock.MatchedBy(func(movement *domain.Movement) bool {
+				return movement.Type == string(tc.movementType) && movement.Value == 300 &&
+					movement.PocketId == "4" && movement.PocketName == "Vacation"
+			})).Return(nil)
+
+			// act
+			err := handler.Handler(event)
+
+			// assert
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100000), acc.Balance)
+			accountRepoMock.AssertExpectations(t)
+			accountRepoMock.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
+			movementRepoMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPocketFundsMovedHandler_Handler_InvalidDirection(t *testing.T) {
+	// arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewPocketFundsMovedHandler(accountRepoMock, movementRepoMock)
+
+	event := events.PocketFundsMoved{
+		Number:    "1234567890",
+		PocketId:  "4",
+		Direction: "sideways",
+		Value:     domain.Money{Amount: 300, Currency: "BRL"},
+	}
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Error(t, err)
+	accountRepoMock.AssertNotCalled(t, "GetAccountByNumber", mock.Anything)
+	movementRepoMock.AssertNotCalled(t, "CreateMovement", mock.Anything)
+}
+
+func TestPocketFundsMovedHandler_Handler_ErrorGettingAccount(t *testing.T) {
+	// arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	handler := NewPocketFundsMovedHandler(accountRepoMock, movementRepoMock)
+
+	event := events.PocketFundsMoved{
+		Number:    "1234567890",
+		PocketId:  "4",
+		Direction: "in",
+		Value:     domain.Money{Amount: 300, Currency: "BRL"},
+	}
+
+	accountRepoMock.On("GetAccountByNumber", event.Number).Return((*domain.Account)(nil), errors.New("generic error"))
+
+	// act
+	err := handler.Handler(event)
+
+	// assert
+	assert.Error(t, err)
+	accountRepoMock.AssertExpectations(t)
+	movementRepoMock.AssertNotCalled(t, "CreateMovement", mock.Anything)
+}
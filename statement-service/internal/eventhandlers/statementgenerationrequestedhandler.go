@@ -1,6 +1,7 @@
 package eventhandlers
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -11,14 +12,17 @@ import (
 	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
 )
 
+var ErrMovementsNotFound = errors.New("movements not found")
+
 type StatementGenerationRequestedHandlerInterface interface {
-	Handle(event events.StatementGenerationRequested)
+	Handle(event events.StatementGenerationRequested) error
 }
 
 type StatementGenerationRequestedHandler struct {
 	accountRepository             repositories.AccountRepositoryInterface
 	statementGenerationRepository repositories.StatementGenerationRepositoryInterface
 	movementRepository            repositories.MovementRepositoryInterface
+	transferRequestRepository     repositories.TransferRequestRepositoryInterface
 	documentGeneratorApi          documentgenerator.GenerateDocumentApiInterface
 	templateCompiler              templatecompiler.TemplateCompileInterface
 }
@@ -27,6 +31,7 @@ func NewStatementGenerationRequestedHandler(
 	accountRepository repositories.AccountRepositoryInterface,
 	statementGenerationRepository repositories.StatementGenerationRepositoryInterface,
 	movementRepository repositories.MovementRepositoryInterface,
+	transferRequestRepository repositories.TransferRequestRepositoryInterface,
 	documentGeneratorApi documentgenerator.GenerateDocumentApiInterface,
 	templateCompiler templatecompiler.TemplateCompileInterface,
 ) StatementGenerationRequestedHandlerInterface {
@@ -34,57 +39,70 @@ func NewStatementGenerationRequestedHandler(
 		accountRepository:             accountRepository,
 		statementGenerationRepository: statementGenerationRepository,
 		movementRepository:            movementRepository,
+		transferRequestRepository:     transferRequestRepository,
 		documentGeneratorApi:          documentGeneratorApi,
 		templateCompiler:              templateCompiler,
 	}
 }
 
-func (us *StatementGenerationRequestedHandler) Handle(event events.StatementGenerationRequested) {
+// Handle generates the statement of the account. Failures are also recorded on the statement
+// generation, so it is shown as failed if the event is not handled by a redelivery either.
+func (us *StatementGenerationRequestedHandler) Handle(event events.StatementGenerationRequested) error {
 	slog.Info("handling account created", "number", event.AccountNumber)
 
 	statementGeneration, err := us.statementGenerationRepository.GetStatementGeneration(event.AccountNumber)
 	if err != nil {
 		slog.Error("error generating statement", "error", err)
-		return
+		return err
 	}
 
 	acc, err := us.accountRepository.GetAccountByNumber(event.AccountNumber)
 	if err != nil {
 		slog.Error("error getting account", "error", err)
-		us.UpdateStatementGenerationError(statementGeneration, err)
-		return
+		return us.UpdateStatementGenerationError(statementGeneration, err)
 	}
 
 	if acc == nil {
 		slog.Error("account not found", "number", event.AccountNumber)
-		return
+		return domain.ErrAccountNotFound
 	}
 
 	movements, err := us.movementRepository.GetMovements(event.AccountNumber)
 	if err != nil {
 		slog.Error("error getting movements", "error", err)
-		us.UpdateStatementGenerationError(statementGeneration, err)
-		return
+		return us.UpdateStatementGenerationError(statementGeneration, err)
 	}
 
 	if movements == nil {
 		slog.Error("movements not found", "number", event.AccountNumber)
-		return
+		return ErrMovementsNotFound
+	}
+
+	transferRequests, err := us.transferRequestRepository.GetUnapprovedTransferRequests(event.AccountNumber)
+	if err != nil {
+		slog.Error("error getting transfer requests", "error", err)
+		return us.UpdateStatementGenerationError(statementGeneration, err)
+	}
+
+	holders, err := us.accountRepository.GetAccountHolders(event.AccountNumber)
+	if err != nil {
+		slog.Error("error getting account holders", "error", err)
+		return us.UpdateStatementGenerationError(statementGeneration, err)
 	}
 
 	parameters := us.NewStatementGenerationReportParameter(acc, movements, statementGeneration)
+	parameters.TransferRequests = newTransferRequestReportParameters(acc, transferRequests)
+	parameters.Holders = newAccountHolderReportParameters(acc, holders)
 
 	templateCompiled, err := us.templateCompiler.Compile(parameters)
 	if err != nil {
-		us.UpdateStatementGenerationError(statementGeneration, err)
-		return
+		return us.UpdateStatementGenerationError(statementGeneration, err)
 	}
 
 	report, err := us.documentGeneratorApi.GenerateFromHtml(templateCompiled)
 	if err != nil {
 		slog.Error("error generating document", "error", err)
-		us.UpdateStatementGenerationError(statementGeneration, err)
-		return
+		return us.UpdateStatementGenerationError(statementGeneration, err)
 	}
 
 	statementGeneration.SetAsGenerated(report)
@@ -92,19 +110,23 @@ func (us *StatementGenerationRequestedHandler) Handle(event events.StatementGene
 	err = us.statementGenerationRepository.UpdateStatementGeneration(statementGeneration)
 	if err != nil {
 		slog.Error("error updating statement generation", "error", err)
-		return
+		return err
 	}
 
+	return nil
 }
 
-func (us *StatementGenerationRequestedHandler) UpdateStatementGenerationError(sg *domain.StatementGeneration, err error) {
+// UpdateStatementGenerationError records err on the statement generation and returns it.
+func (us *StatementGenerationRequestedHandler) UpdateStatementGenerationError(sg *domain.StatementGeneration, err error) error {
 	sg.SetAsGeneratedWithError(err)
 
-	err = us.statementGenerationRepository.UpdateStatementGeneration(sg)
-	if err != nil {
-		slog.Error("error updating statement generation", "error", err)
-		return
+	updateErr := us.statementGenerationRepository.UpdateStatementGeneration(sg)
+	if updateErr != nil {
+		slog.Error("error updating statement generation", "error", updateErr)
+		return errors.Join(err, updateErr)
 	}
+
+	return err
 }
 
 func (us *StatementGenerationRequestedHandler) NewStatementGenerationReportParameter(
@@ -113,17 +135,20 @@ func (us *StatementGenerationRequestedHandler) NewStatementGenerationReportParam
 	sg *domain.StatementGeneration) *domain.StatementGenerationReportParameter {
 
 	reportParameter := domain.StatementGenerationReportParameter{
-		Document:     acc.Document,
-		CustomerName: acc.Name,
-		Movements:    []domain.MovementReportParameter{},
+		Document:                acc.Document,
+		CustomerName:            acc.Name,
+		Status:                  accountStatusLabel(acc.Status),
+		AvailableOverdraftLimit: acc.Money(acc.AvailableOverdraftLimit()).Format(),
+		Movements:               []domain.MovementReportParameter{},
+		Pockets:                 []domain.PocketReportParameter{},
 	}
 
+	pocketMovements := []domain.Movement{}
+
 	for _, movement := range *movements {
-		movementType := ""
-		if movement.Type == string(domain.In) {
-			movementType = "Entrada"
-		} else {
-			movementType = "Saída"
+		if movement.PocketId != "" {
+			pocketMovements = append(pocketMovements, movement)
+			continue
 		}
 
 		destinationAccount := ""
@@ -135,13 +160,172 @@ func (us *StatementGenerationRequestedHandler) NewStatementGenerationReportParam
 
 		movementParameter := domain.MovementReportParameter{
 			CreatedAt:          movement.CreatedAt.Format("2006-01-02 15:04:05"),
-			Type:               movementType,
+			Type:               movementTypeLabel(movement.Type),
 			DestinationAccount: destinationAccount,
-			Amount:             fmt.Sprintf("R$ %.2f", float32(movement.Value/100)),
+			Reference:          movementReference(&movement),
+			Amount:             acc.Money(movement.Value).Format(),
 		}
 
 		reportParameter.Movements = append(reportParameter.Movements, movementParameter)
 	}
 
+	reportParameter.Pockets = newPocketReportParameters(acc, pocketMovements)
+
 	return &reportParameter
 }
+
+// newPocketReportParameters groups the moves between the main balance and the pockets by
+// pocket, in the order the pockets first appear, with the balance left in each one.
+func newPocketReportParameters(acc *domain.Account, movements []domain.Movement) []domain.PocketReportParameter {
+	parameters := []domain.PocketReportParameter{}
+	balances := []int64{}
+	indexes := map[string]int{}
+
+	for _, movement := range movements {
+		index, ok := indexes[movement.PocketId]
+		if !ok {
+			index = len(parameters)
+			indexes[movement.PocketId] = index
+			parameters = append(parameters, domain.PocketReportParameter{Movements: []domain.MovementReportParameter{}})
+			balances = append(balances, 0)
+		}
+
+		if movement.Type == string(domain.PocketOut) {
+			balances[index] -= movement.Value
+		} else {
+			balances[index] += movement.Value
+		}
+
+		parameters[index].Name = movement.PocketName
+		parameters[index].Movements = append(parameters[index].Movements, domain.MovementReportParameter{
+			CreatedAt:          movement.CreatedAt.Format("2006-01-02 15:04:05"),
+			Type:               movementTypeLabel(movement.Type),
+			DestinationAccount: " - ",
+			Reference:          " - ",
+			Amount:             acc.Money(movement.Value).Format(),
+		})
+	}
+
+	for index := range parameters {
+		parameters[index].Balance = acc.Money(balances[index]).Format()
+	}
+
+	return parameters
+}
+
+// newAccountHolderReportParameters lists every holder of the account, only the account name and
+// document for accounts without holders recorded.
+func newAccountHolderReportParameters(acc *domain.Account, holders []domain.AccountHolder) []domain.AccountHolderReportParameter {
+	if len(holders) == 0 {
+		holders = []domain.AccountHolder{*domain.NewAccountHolder(acc.Number, acc.Document, acc.Name, domain.AccountHolderRolePrimary)}
+	}
+
+	parameters := []domain.AccountHolderReportParameter{}
+
+	for _, holder := range holders {
+		parameters = append(parameters, domain.AccountHolderReportParameter{
+			Document: holder.Document,
+			Name:     holder.Name,
+			Role:     accountHolderRoleLabel(holder.Role),
+		})
+	}
+
+	return parameters
+}
+
+func accountHolderRoleLabel(role domain.AccountHolderRole) string {
+	if role == domain.AccountHolderRoleSecondary {
+		return "Cotitular"
+	}
+
+	return "Titular"
+}
+
+// newTransferRequestReportParameters lists the transfers that did not move money, pending
+// approval, rejected or expired, apart from the movements.
+func newTransferRequestReportParameters(acc *domain.Account, transferRequests *[]domain.TransferRequest) []domain.TransferRequestReportParameter {
+	parameters := []domain.TransferRequestReportParameter{}
+
+	for _, transferRequest := range *transferRequests {
+		reason := transferRequest.Reason
+		if reason == "" {
+			reason = " - "
+		}
+
+		parameters = append(parameters, domain.TransferRequestReportParameter{
+			CreatedAt:          transferRequest.CreatedAt.Format("2006-01-02 15:04:05"),
+			Status:             transferRequestStatusLabel(transferRequest.Status),
+			DestinationAccount: transferRequest.ToNumber,
+			Reason:             reason,
+			Amount:             acc.Money(transferRequest.Value).Format(),
+		})
+	}
+
+	return parameters
+}
+
+func transferRequestStatusLabel(status domain.TransferRequestStatus) string {
+	switch status {
+	case domain.TransferRequestRejected:
+		return "Recusada"
+	case domain.TransferRequestExpired:
+		return "Expirada"
+	default:
+		return "Aguardando aprovação"
+	}
+}
+
+func accountStatusLabel(status domain.AccountStatus) string {
+	switch status {
+	case domain.AccountStatusBlocked:
+		return "Bloqueada"
+	case domain.AccountStatusClosed:
+		return "Encerrada"
+	default:
+		return "Ativa"
+	}
+}
+
+func movementTypeLabel(movementType string) string {
+	switch domain.MovementType(movementType) {
+	case domain.In:
+		return "Entrada"
+	case domain.ReversalIn:
+		return "Estorno recebido"
+	case domain.ReversalOut:
+		return "Estorno enviado"
+	case domain.Interest:
+		return "Rendimento"
+	case domain.Fee:
+		return "Tarifa"
+	case domain.PocketIn:
+		return "Guardado na caixinha"
+	case domain.PocketOut:
+		return "Resgatado da caixinha"
+	default:
+		return "Saída"
+	}
+}
+
+// movementReference identifies the transfer of the movement, reversals point to the transfer
+// they reverse and fees to the transaction they were charged on so the rows can be matched in
+// the statement.
+func movementReference(movement *domain.Movement) string {
+	if movement.Type == string(domain.Fee) {
+		if movement.OriginTransactionId == "" {
+			return "Manutenção mensal"
+		}
+
+		return fmt.Sprintf("Tarifa da transação #%v", movement.OriginTransactionId)
+	}
+
+	if movement.ReversedTransferId != "" {
+		return fmt.Sprintf("Estorno da transferência #%v", movement.ReversedTransferId)
+	}
+
+	if movement.TransferId != "" {
+		return fmt.Sprintf("Transferência #%v", movement.TransferId)
+	}
+
+	return " - "
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers"
 	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
 	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -16,11 +17,12 @@ func TestStatementGenerationRequestedHandler_Handle_Success(t *testing.T) {
 	accountRepoMock := new(handlersmock.MockAccountRepository)
 	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
 	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
 	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
 	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
 
 	handler := eventhandlers.NewStatementGenerationRequestedHandler(
-		accountRepoMock, statementGenRepoMock, movementRepoMock, documentGenApiMock, templateCompilerMock,
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
 	)
 
 	account := &domain.Account{
@@ -35,21 +37,39 @@ func TestStatementGenerationRequestedHandler_Handle_Success(t *testing.T) {
 	accountRepoMock.On("GetAccountByNumber", mock.Anything).Return(account, nil)
 	statementGenRepoMock.On("GetStatementGeneration", mock.Anything).Return(statementGeneration, nil)
 	movementRepoMock.On("GetMovements", mock.Anything).Return(&movements, nil)
+	transferRequestRepoMock.On("GetUnapprovedTransferRequests", mock.Anything).Return(&[]domain.TransferRequest{
+		{Id: "3", ToNumber: "27", Value: 500050, Status: domain.TransferRequestRejected, Reason: "unknown payee"},
+	}, nil)
+	accountRepoMock.On("GetAccountHolders", mock.Anything).Return([]domain.AccountHolder{
+		{Document: "12345678900", Name: "John Doe", Role: domain.AccountHolderRolePrimary},
+		{Document: "52998224725", Name: "Jane Doe", Role: domain.AccountHolderRoleSecondary},
+	}, nil)
 	documentGenApiMock.On("GenerateFromHtml", mock.Anything).Return("pdf-data", nil)
 	statementGenRepoMock.On("UpdateStatementGeneration", mock.Anything).Return(nil)
-	templateCompilerMock.On("Compile", mock.Anything).Return("123XPTO321", nil)
+	templateCompilerMock.On("Compile", mock.MatchedBy(func(parameters *domain.StatementGenerationReportParameter) bool {
+		return len(parameters.Holders) == 2 &&
+			parameters.Holders[1].Name == "Jane Doe" &&
+			parameters.Holders[1].Role == "Cotitular" &&
+			len(parameters.TransferRequests) == 1 &&
+			parameters.TransferRequests[0].Status == "Recusada" &&
+			parameters.TransferRequests[0].Reason == "unknown payee" &&
+			parameters.TransferRequests[0].Amount == "R$ 5000.50"
+	})).Return("123XPTO321", nil)
 
 	event := events.StatementGenerationRequested{
 		AccountNumber: "12345678900",
 	}
 
 	// Act
-	handler.Handle(event)
+	err := handler.Handle(event)
 
 	// Assert
+	assert.NoError(t, err)
 	accountRepoMock.AssertExpectations(t)
 	statementGenRepoMock.AssertExpectations(t)
 	movementRepoMock.AssertExpectations(t)
+	transferRequestRepoMock.AssertExpectations(t)
+	templateCompilerMock.AssertExpectations(t)
 	documentGenApiMock.AssertExpectations(t)
 }
 
@@ -58,11 +78,12 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGetStatementGeneratio
 	accountRepoMock := new(handlersmock.MockAccountRepository)
 	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
 	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
 	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
 	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
 
 	handler := eventhandlers.NewStatementGenerationRequestedHandler(
-		accountRepoMock, statementGenRepoMock, movementRepoMock, documentGenApiMock, templateCompilerMock,
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
 	)
 
 	statementGenRepoMock.On("GetStatementGeneration", mock.Anything).Return((*domain.StatementGeneration)(nil), errors.New("db error"))
@@ -72,9 +93,10 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGetStatementGeneratio
 	}
 
 	// Act
-	handler.Handle(event)
+	err := handler.Handle(event)
 
 	// Assert
+	assert.Error(t, err)
 	statementGenRepoMock.AssertExpectations(t)
 }
 
@@ -83,11 +105,12 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGetAccount(t *testing
 	accountRepoMock := new(handlersmock.MockAccountRepository)
 	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
 	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
 	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
 	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
 
 	handler := eventhandlers.NewStatementGenerationRequestedHandler(
-		accountRepoMock, statementGenRepoMock, movementRepoMock, documentGenApiMock, templateCompilerMock,
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
 	)
 
 	statementGeneration := &domain.StatementGeneration{}
@@ -100,9 +123,10 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGetAccount(t *testing
 	}
 
 	// Act
-	handler.Handle(event)
+	err := handler.Handle(event)
 
 	// Assert
+	assert.Error(t, err)
 	accountRepoMock.AssertExpectations(t)
 	statementGenRepoMock.AssertExpectations(t)
 }
@@ -112,11 +136,12 @@ func TestStatementGenerationRequestedHandler_Handle_AccountNotFound(t *testing.T
 	accountRepoMock := new(handlersmock.MockAccountRepository)
 	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
 	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
 	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
 	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
 
 	handler := eventhandlers.NewStatementGenerationRequestedHandler(
-		accountRepoMock, statementGenRepoMock, movementRepoMock, documentGenApiMock, templateCompilerMock,
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
 	)
 
 	statementGeneration := &domain.StatementGeneration{}
@@ -128,9 +153,10 @@ func TestStatementGenerationRequestedHandler_Handle_AccountNotFound(t *testing.T
 	}
 
 	// Act
-	handler.Handle(event)
+	err := handler.Handle(event)
 
 	// Assert
+	assert.Equal(t, domain.ErrAccountNotFound, err)
 	accountRepoMock.AssertExpectations(t)
 	statementGenRepoMock.AssertExpectations(t)
 }
@@ -140,11 +166,12 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGetMovements(t *testi
 	accountRepoMock := new(handlersmock.MockAccountRepository)
 	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
 	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
 	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
 	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
 
 	handler := eventhandlers.NewStatementGenerationRequestedHandler(
-		accountRepoMock, statementGenRepoMock, movementRepoMock, documentGenApiMock, templateCompilerMock,
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
 	)
 
 	account := &domain.Account{}
@@ -159,23 +186,62 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGetMovements(t *testi
 	}
 
 	// Act
-	handler.Handle(event)
+	err := handler.Handle(event)
 
 	// Assert
+	assert.Error(t, err)
 	accountRepoMock.AssertExpectations(t)
 	movementRepoMock.AssertExpectations(t)
 }
 
+func TestStatementGenerationRequestedHandler_Handle_ErrorOnGetAccountHolders(t *testing.T) {
+	// Arrange
+	accountRepoMock := new(handlersmock.MockAccountRepository)
+	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
+	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
+	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
+	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
+
+	handler := eventhandlers.NewStatementGenerationRequestedHandler(
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
+	)
+
+	account := &domain.Account{}
+	movements := []domain.Movement{}
+	statementGeneration := &domain.StatementGeneration{}
+	accountRepoMock.On("GetAccountByNumber", mock.Anything).Return(account, nil)
+	statementGenRepoMock.On("GetStatementGeneration", mock.Anything).Return(statementGeneration, nil)
+	movementRepoMock.On("GetMovements", mock.Anything).Return(&movements, nil)
+	transferRequestRepoMock.On("GetUnapprovedTransferRequests", mock.Anything).Return(&[]domain.TransferRequest{}, nil)
+	accountRepoMock.On("GetAccountHolders", mock.Anything).Return([]domain.AccountHolder(nil), errors.New("db error"))
+	statementGenRepoMock.On("UpdateStatementGeneration", mock.Anything).Return(nil)
+
+	event := events.StatementGenerationRequested{
+		AccountNumber: "12345678900",
+	}
+
+	// Act
+	err := handler.Handle(event)
+
+	// Assert
+	assert.Error(t, err)
+	accountRepoMock.AssertExpectations(t)
+	statementGenRepoMock.AssertExpectations(t)
+	templateCompilerMock.AssertNotCalled(t, "Compile", mock.Anything)
+}
+
 func TestStatementGenerationRequestedHandler_Handle_MovementsNotFound(t *testing.T) {
 	// Arrange
 	accountRepoMock := new(handlersmock.MockAccountRepository)
 	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
 	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
 	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
 	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
 
 	handler := eventhandlers.NewStatementGenerationRequestedHandler(
-		accountRepoMock, statementGenRepoMock, movementRepoMock, documentGenApiMock, templateCompilerMock,
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
 	)
 
 	account := &domain.Account{}
@@ -189,9 +255,10 @@ func TestStatementGenerationRequestedHandler_Handle_MovementsNotFound(t *testing
 	}
 
 	// Act
-	handler.Handle(event)
+	err := handler.Handle(event)
 
 	// Assert
+	assert.Equal(t, eventhandlers.ErrMovementsNotFound, err)
 	accountRepoMock.AssertExpectations(t)
 	movementRepoMock.AssertExpectations(t)
 }
@@ -201,11 +268,12 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGenerateDocument(t *t
 	accountRepoMock := new(handlersmock.MockAccountRepository)
 	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
 	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
 	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
 	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
 
 	handler := eventhandlers.NewStatementGenerationRequestedHandler(
-		accountRepoMock, statementGenRepoMock, movementRepoMock, documentGenApiMock, templateCompilerMock,
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
 	)
 
 	account := &domain.Account{}
@@ -214,6 +282,8 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGenerateDocument(t *t
 	accountRepoMock.On("GetAccountByNumber", mock.Anything).Return(account, nil)
 	statementGenRepoMock.On("GetStatementGeneration", mock.Anything).Return(statementGeneration, nil)
 	movementRepoMock.On("GetMovements", mock.Anything).Return(&movements, nil)
+	transferRequestRepoMock.On("GetUnapprovedTransferRequests", mock.Anything).Return(&[]domain.TransferRequest{}, nil)
+	accountRepoMock.On("GetAccountHolders", mock.Anything).Return([]domain.AccountHolder{}, nil)
 	templateCompilerMock.On("Compile", mock.Anything).Return("123XPTO321", nil)
 	documentGenApiMock.On("GenerateFromHtml", mock.Anything).Return("", errors.New("generation error"))
 	statementGenRepoMock.On("UpdateStatementGeneration", mock.Anything).Return(nil)
@@ -223,9 +293,10 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnGenerateDocument(t *t
 	}
 
 	// Act
-	handler.Handle(event)
+	err := handler.Handle(event)
 
 	// Assert
+	assert.Error(t, err)
 	documentGenApiMock.AssertExpectations(t)
 }
 
@@ -234,11 +305,12 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnUpdateStatementGenera
 	accountRepoMock := new(handlersmock.MockAccountRepository)
 	statementGenRepoMock := new(handlersmock.MockStatementGenerationRepository)
 	movementRepoMock := new(handlersmock.MockMovementRepository)
+	transferRequestRepoMock := new(handlersmock.MockTransferRequestRepository)
 	documentGenApiMock := new(handlersmock.MockGenerateDocumentApi)
 	templateCompilerMock := new(handlersmock.MockTemplateCompiler)
 
 	handler := eventhandlers.NewStatementGenerationRequestedHandler(
-		accountRepoMock, statementGenRepoMock, movementRepoMock, documentGenApiMock, templateCompilerMock,
+		accountRepoMock, statementGenRepoMock, movementRepoMock, transferRequestRepoMock, documentGenApiMock, templateCompilerMock,
 	)
 
 	account := &domain.Account{}
@@ -247,6 +319,8 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnUpdateStatementGenera
 	accountRepoMock.On("GetAccountByNumber", mock.Anything).Return(account, nil)
 	statementGenRepoMock.On("GetStatementGeneration", mock.Anything).Return(statementGeneration, nil)
 	movementRepoMock.On("GetMovements", mock.Anything).Return(&movements, nil)
+	transferRequestRepoMock.On("GetUnapprovedTransferRequests", mock.Anything).Return(&[]domain.TransferRequest{}, nil)
+	accountRepoMock.On("GetAccountHolders", mock.Anything).Return([]domain.AccountHolder{}, nil)
 	documentGenApiMock.On("GenerateFromHtml", mock.Anything).Return("pdf-data", nil)
 	templateCompilerMock.On("Compile", mock.Anything).Return("123XPTO321", nil)
 	statementGenRepoMock.On("UpdateStatementGeneration", mock.Anything).Return(errors.New("update error"))
@@ -256,8 +330,127 @@ func TestStatementGenerationRequestedHandler_Handle_ErrorOnUpdateStatementGenera
 	}
 
 	// Act
-	handler.Handle(event)
+	err := handler.Handle(event)
 
 	// Assert
+	assert.Error(t, err)
 	statementGenRepoMock.AssertExpectations(t)
 }
+
+func TestStatementGenerationRequestedHandler_NewStatementGenerationReportParameter_AccountStatus(t *testing.T) {
+	// arrange
+	handler := &eventhandlers.StatementGenerationRequestedHandler{}
+
+	acc := domain.NewAccount("123", "01234567890", "John Doe")
+	acc.Status = domain.AccountStatusBlocked
+
+	// act
+	parameters := handler.NewStatementGenerationReportParameter(acc, &[]domain.Movement{}, &domain.StatementGeneration{})
+
+	// assert
+	assert.Equal(t, "Bloqueada", parameters.Status)
+	assert.Equal(t, acc.Name, parameters.CustomerName)
+}
+
+func TestStatementGenerationRequestedHandler_NewStatementGenerationReportParameter_AvailableOverdraftLimit(t *testing.T) {
+	// arrange
+	handler := &eventhandlers.StatementGenerationRequestedHandler{}
+
+	acc := domain.NewAccount("123", "01234567890", "John Doe")
+	acc.Balance = -15000
+	acc.OverdraftLimit = 50000
+
+	// act
+	parameters := handler.NewStatementGenerationReportParameter(acc, &[]domain.Movement{}, &domain.StatementGeneration{})
+
+	// assert
+	assert.Equal(t, "R$ 350.00", parameters.AvailableOverdraftLimit)
+}
+
+func TestStatementGenerationRequestedHandler_NewStatementGenerationReportParameter_Reversal(t *testing.T) {
+	// arrange
+	handler := &eventhandlers.StatementGenerationRequestedHandler{}
+
+	acc := domain.NewAccount("123", "01234567890", "John Doe")
+	movements := []domain.Movement{
+		*domain.NewTransferRealizedMovement("123", "456", 10000, "10"),
+		*domain.NewReversalReceivedMovement("123", "456", 4000, "11", "10"),
+	}
+
+	// act
+	parameters := handler.NewStatementGenerationReportParameter(acc, &movements, &domain.StatementGeneration{})
+
+	// assert
+	assert.Equal(t, "Saída", parameters.Movements[0].Type)
+	assert.Equal(t, "Transferência #10", parameters.Movements[0].Reference)
+	assert.Equal(t, "Estorno recebido", parameters.Movements[1].Type)
+	assert.Equal(t, "Estorno da transferência #10", parameters.Movements[1].Reference)
+}
+
+func TestStatementGenerationRequestedHandler_NewStatementGenerationReportParameter_Fee(t *testing.T) {
+	// arrange
+	handler := &eventhandlers.StatementGenerationRequestedHandler{}
+
+	acc := domain.NewAccount("123", "01234567890", "John Doe")
+	movements := []domain.Movement{
+		*domain.NewTransferRealizedMovement("123", "456", 10000, "10"),
+		*domain.NewFeeChargedMovement("123", 50, "11", "10"),
+		*domain.NewFeeChargedMovement("123", 1290, "12", ""),
+	}
+
+	// act
+	parameters := handler.NewStatementGenerationReportParameter(acc, &movements, &domain.StatementGeneration{})
+
+	// assert
+	assert.Equal(t, "Tarifa", parameters.Movements[1].Type)
+	assert.Equal(t, "Tarifa da transação #10", parameters.Movements[1].Reference)
+	assert.Equal(t, "R$ 0.50", parameters.Movements[1].Amount)
+	assert.Equal(t, "Tarifa", parameters.Movements[2].Type)
+	assert.Equal(t, "Manutenção mensal", parameters.Movements[2].Reference)
+}
+
+func TestStatementGenerationRequestedHandler_NewStatementGenerationReportParameter_Currency(t *testing.T) {
+	// arrange
+	handler := &eventhandlers.StatementGenerationRequestedHandler{}
+
+	acc := domain.NewAccount("123", "01234567890", "John Doe")
+	acc.Currency = "USD"
+	acc.OverdraftLimit = 20000
+	movements := []domain.Movement{
+		*domain.NewDepositedFundsMovement("123", 10050),
+	}
+
+	// act
+	parameters := handler.NewStatementGenerationReportParameter(acc, &movements, &domain.StatementGeneration{})
+
+	// assert
+	assert.Equal(t, "US$ 200.00", parameters.AvailableOverdraftLimit)
+	assert.Equal(t, "US$ 100.50", parameters.Movements[0].Amount)
+}
+
+func TestStatementGenerationRequestedHandler_NewStatementGenerationReportParameter_Pockets(t *testing.T) {
+	// arrange
+	handler := &eventhandlers.StatementGenerationRequestedHandler{}
+
+	acc := domain.NewAccount("123", "01234567890", "John Doe")
+	movements := []domain.Movement{
+		*domain.NewDepositedFundsMovement("123", 10000),
+		*domain.NewPocketFundsMovedMovement("123", domain.PocketIn, 3000, "4", "Vacation"),
+		*domain.NewPocketFundsMovedMovement("123", domain.PocketIn, 500, "5", "Taxes"),
+		*domain.NewPocketFundsMovedMovement("123", domain.PocketOut, 1000, "4", "Vacation"),
+	}
+
+	// act
+	parameters := handler.NewStatementGenerationReportParameter(acc, &movements, &domain.StatementGeneration{})
+
+	// assert
+	assert.Len(t, parameters.Movements, 1)
+	assert.Len(t, parameters.Pockets, 2)
+	assert.Equal(t, "Vacation", parameters.Pockets[0].Name)
+	assert.Equal(t, "R$ 20.00", parameters.Pockets[0].Balance)
+	assert.Len(t, parameters.Pockets[0].Movements, 2)
+	assert.Equal(t, "Guardado na caixinha", parameters.Pockets[0].Movements[0].Type)
+	assert.Equal(t, "Resgatado da caixinha", parameters.Pockets[0].Movements[1].Type)
+	assert.Equal(t, "Taxes", parameters.Pockets[1].Name)
+	assert.Equal(t, "R$ 5.00", parameters.Pockets[1].Balance)
+}
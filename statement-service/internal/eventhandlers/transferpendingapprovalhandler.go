@@ -0,0 +1,39 @@
+package eventhandlers
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+)
+
+type TransferPendingApprovalHandlerInterface interface {
+	Handler(event events.TransferPendingApproval) error
+}
+
+type TransferPendingApprovalHandler struct {
+	transferRequestRepository repositories.TransferRequestRepositoryInterface
+}
+
+func NewTransferPendingApprovalHandler(transferRequestRepository repositories.TransferRequestRepositoryInterface) TransferPendingApprovalHandlerInterface {
+	return &TransferPendingApprovalHandler{
+		transferRequestRepository: transferRequestRepository,
+	}
+}
+
+func (h *TransferPendingApprovalHandler) Handler(event events.TransferPendingApproval) error {
+	slog.Info("handling transfer pending approval", "transferRequestId", event.TransferRequestId, "fromNumber", event.FromNumber)
+
+	transferRequest := domain.NewPendingTransferRequest(event.TransferRequestId, event.FromNumber, event.ToNumber, event.Value)
+
+	err := h.transferRequestRepository.CreateTransferRequest(transferRequest)
+	if err != nil {
+		slog.Error("error creating transfer request", "error", err, "transferRequestId", event.TransferRequestId)
+		return err
+	}
+
+	slog.Info("transfer request created", "transferRequestId", event.TransferRequestId)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package eventhandlers
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransferPendingApprovalHandler_Handler_Success(t *testing.T) {
+	// arrange
+	transferrequestrepomock := new(handlersmock.MockTransferRequestRepository)
+	handler := NewTransferPendingApprovalHandler(transferrequestrepomock)
+
+	transferrequestrepomock.
+		On("CreateTransferRequest", mock.MatchedBy(func(transferRequest *domain.TransferRequest) bool {
+			return transferRequest.Id == "3" && transferRequest.FromNumber == "19" && transferRequest.ToNumber == "27" &&
+				transferRequest.Value == 500000 && transferRequest.Status == domain.TransferRequestPendingApproval
+		})).
+		Return(nil)
+
+	// act
+	err := handler.Handler(events.TransferPendingApproval{TransferRequestId: "3", FromNumber: "19", ToNumber: "27", Value: 500000})
+
+	// assert
+	assert.NoError(t, err)
+	transferrequestrepomock.AssertExpectations(t)
+}
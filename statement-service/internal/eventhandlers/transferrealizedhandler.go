@@ -9,7 +9,7 @@ import (
 )
 
 type TransferRealizedHandlerInterface interface {
-	Handler(event events.TransferRealized)
+	Handler(event events.TransferRealized) error
 }
 
 type TransferRealizedHandler struct {
@@ -24,34 +24,36 @@ func NewTransferRealizedHandler(accountRepository repositories.AccountRepository
 	}
 }
 
-func (h *TransferRealizedHandler) Handler(event events.TransferRealized) {
+func (h *TransferRealizedHandler) Handler(event events.TransferRealized) error {
 	slog.Info("handling transfer realized", "number", event.FromNumber)
 
 	acc, err := h.accountRepository.GetAccountByNumber(event.FromNumber)
 	if err != nil {
 		slog.Error("error getting account", "error", err)
-		return
+		return err
 	}
 
 	if acc == nil {
 		slog.Error("account not found", "number", event.FromNumber)
-		return
+		return domain.ErrAccountNotFound
 	}
 
-	acc.Balance = event.Balance
+	acc.Balance = event.Balance.Amount
 
 	err = h.accountRepository.UpdateAccountBalance(acc)
 	if err != nil {
 		slog.Error("error updating account balance", "error", err, "number", event.FromNumber)
-		return
+		return err
 	}
 
-	movement := domain.NewTransferRealizedMovement(event.FromNumber, event.ToNumber, event.Value)
+	movement := domain.NewTransferRealizedMovement(event.FromNumber, event.ToNumber, event.Value.Amount, event.TransferId)
 	err = h.movementRepository.CreateMovement(movement)
 	if err != nil {
 		slog.Error("error creating movement", "error", err, "number", event.FromNumber)
-		return
+		return err
 	}
 
 	slog.Info("transfer realized account updated", "number", event.FromNumber)
+
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
 	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
 	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -14,8 +15,8 @@ func getTestEvent() events.TransferRealized {
 	return events.TransferRealized{
 		FromNumber: "123456789",
 		ToNumber:   "987654321",
-		Value:      100.0,
-		Balance:    900.0,
+		Value:      domain.Money{Amount: 100, Currency: "BRL"},
+		Balance:    domain.Money{Amount: 900, Currency: "BRL"},
 	}
 }
 
@@ -42,9 +43,10 @@ func TestTransferRealizedHandler_Success(t *testing.T) {
 	movementRepo.On("CreateMovement", mock.Anything).Return(nil)
 
 	// Act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// Assert
+	assert.NoError(t, err)
 	accountRepo.AssertExpectations(t)
 	movementRepo.AssertExpectations(t)
 }
@@ -60,9 +62,10 @@ func TestTransferRealizedHandler_AccountNotFound(t *testing.T) {
 	accountRepo.On("GetAccountByNumber", event.FromNumber).Return((*domain.Account)(nil), nil)
 
 	// Act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// Assert
+	assert.Equal(t, domain.ErrAccountNotFound, err)
 	accountRepo.AssertExpectations(t)
 	movementRepo.AssertNotCalled(t, "CreateMovement", mock.Anything)
 	accountRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
@@ -79,9 +82,10 @@ func TestTransferRealizedHandler_DBErrorOnGetAccount(t *testing.T) {
 	accountRepo.On("GetAccountByNumber", event.FromNumber).Return((*domain.Account)(nil), errors.New("db error"))
 
 	// Act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// Assert
+	assert.Error(t, err)
 	accountRepo.AssertExpectations(t)
 	movementRepo.AssertNotCalled(t, "CreateMovement", mock.Anything)
 	accountRepo.AssertNotCalled(t, "UpdateAccountBalance", mock.Anything)
@@ -100,9 +104,10 @@ func TestTransferRealizedHandler_DBErrorOnUpdateBalance(t *testing.T) {
 	accountRepo.On("UpdateAccountBalance", mock.Anything).Return(errors.New("db error"))
 
 	// Act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// Assert
+	assert.Error(t, err)
 	accountRepo.AssertExpectations(t)
 	movementRepo.AssertNotCalled(t, "CreateMovement", mock.Anything)
 }
@@ -121,9 +126,10 @@ func TestTransferRealizedHandler_DBErrorOnCreateMovement(t *testing.T) {
 	movementRepo.On("CreateMovement", mock.Anything).Return(errors.New("db error"))
 
 	// Act
-	handler.Handler(event)
+	err := handler.Handler(event)
 
 	// Assert
+	assert.Error(t, err)
 	accountRepo.AssertExpectations(t)
 	movementRepo.AssertExpectations(t)
 }
@@ -9,7 +9,7 @@ import (
 )
 
 type TransferReceivedHandlerInterface interface {
-	Handler(event events.TransferReceived)
+	Handler(event events.TransferReceived) error
 }
 
 type TransferReceivedHandler struct {
@@ -24,34 +24,36 @@ func NewTransferReceivedHandler(accountRepository repositories.AccountRepository
 	}
 }
 
-func (h *TransferReceivedHandler) Handler(event events.TransferReceived) {
+func (h *TransferReceivedHandler) Handler(event events.TransferReceived) error {
 	slog.Info("handling transfer received", "number", event.FromNumber)
 
 	acc, err := h.accountRepository.GetAccountByNumber(event.FromNumber)
 	if err != nil {
 		slog.Error("error getting account", "error", err)
-		return
+		return err
 	}
 
 	if acc == nil {
 		slog.Error("account not found", "number", event.FromNumber)
-		return
+		return domain.ErrAccountNotFound
 	}
 
-	acc.Balance = event.Balance
+	acc.Balance = event.Balance.Amount
 
 	err = h.accountRepository.UpdateAccountBalance(acc)
 	if err != nil {
 		slog.Error("error updating account balance", "error", err, "number", event.FromNumber)
-		return
+		return err
 	}
 
-	movement := domain.NewTransferReceivedMovement(event.FromNumber, event.ToNumber, event.Value)
+	movement := domain.NewTransferReceivedMovement(event.FromNumber, event.ToNumber, event.Value.Amount, event.TransferId)
 	err = h.movementRepository.CreateMovement(movement)
 	if err != nil {
 		slog.Error("error creating movement", "error", err, "number", event.FromNumber)
-		return
+		return err
 	}
 
 	slog.Info("transfer received account updated", "number", event.FromNumber)
+
+	return nil
 }
@@ -0,0 +1,46 @@
+package eventhandlers
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+)
+
+// TransferRequestStatusChangedHandlerInterface handles the TransferApproved, TransferRejected and
+// TransferRequestExpired events, which only differ by the status they set.
+type TransferRequestStatusChangedHandlerInterface interface {
+	Handler(id string, status domain.TransferRequestStatus, reason string) error
+}
+
+type TransferRequestStatusChangedHandler struct {
+	transferRequestRepository repositories.TransferRequestRepositoryInterface
+}
+
+func NewTransferRequestStatusChangedHandler(transferRequestRepository repositories.TransferRequestRepositoryInterface) TransferRequestStatusChangedHandlerInterface {
+	return &TransferRequestStatusChangedHandler{
+		transferRequestRepository: transferRequestRepository,
+	}
+}
+
+func (h *TransferRequestStatusChangedHandler) Handler(id string, status domain.TransferRequestStatus, reason string) error {
+	slog.Info("handling transfer request status changed", "transferRequestId", id, "status", status)
+
+	transferRequest := &domain.TransferRequest{
+		Id:        id,
+		Status:    status,
+		Reason:    reason,
+		UpdatedAt: time.Now(),
+	}
+
+	err := h.transferRequestRepository.UpdateTransferRequestStatus(transferRequest)
+	if err != nil {
+		slog.Error("error updating transfer request status", "error", err, "transferRequestId", id)
+		return err
+	}
+
+	slog.Info("transfer request status updated", "transferRequestId", id, "status", status)
+
+	return nil
+}
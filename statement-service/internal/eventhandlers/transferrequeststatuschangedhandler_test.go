@@ -0,0 +1,30 @@
+package eventhandlers
+
+import (
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransferRequestStatusChangedHandler_Handler_Success(t *testing.T) {
+	// arrange
+	transferrequestrepomock := new(handlersmock.MockTransferRequestRepository)
+	handler := NewTransferRequestStatusChangedHandler(transferrequestrepomock)
+
+	transferrequestrepomock.
+		On("UpdateTransferRequestStatus", mock.MatchedBy(func(transferRequest *domain.TransferRequest) bool {
+			return transferRequest.Id == "3" && transferRequest.Status == domain.TransferRequestRejected &&
+				transferRequest.Reason == "unknown payee"
+		})).
+		Return(nil)
+
+	// act
+	err := handler.Handler("3", domain.TransferRequestRejected, "unknown payee")
+
+	// assert
+	assert.NoError(t, err)
+	transferrequestrepomock.AssertExpectations(t)
+}
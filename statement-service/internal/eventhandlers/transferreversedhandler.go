@@ -0,0 +1,76 @@
+package eventhandlers
+
+import (
+	"log/slog"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/repositories"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+)
+
+type TransferReversedHandlerInterface interface {
+	Handler(event events.TransferReversed) error
+}
+
+type TransferReversedHandler struct {
+	accountRepository  repositories.AccountRepositoryInterface
+	movementRepository repositories.MovementRepositoryInterface
+}
+
+func NewTransferReversedHandler(accountRepository repositories.AccountRepositoryInterface, movementRepository repositories.MovementRepositoryInterface) TransferReversedHandlerInterface {
+	return &TransferReversedHandler{
+		accountRepository:  accountRepository,
+		movementRepository: movementRepository,
+	}
+}
+
+// Handler records the reversal on both accounts, the event carries the resulting balance
+// of each of them.
+func (h *TransferReversedHandler) Handler(event events.TransferReversed) error {
+	slog.Info("handling transfer reversed", "transferId", event.TransferId, "reversalId", event.ReversalId)
+
+	debited := domain.NewReversalRealizedMovement(event.FromNumber, event.ToNumber, event.Value.Amount, event.ReversalId, event.TransferId)
+	err := h.recordMovement(debited, event.FromBalance.Amount)
+	if err != nil {
+		return err
+	}
+
+	credited := domain.NewReversalReceivedMovement(event.ToNumber, event.FromNumber, event.Value.Amount, event.ReversalId, event.TransferId)
+	err = h.recordMovement(credited, event.ToBalance.Amount)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("transfer reversed accounts updated", "transferId", event.TransferId, "reversalId", event.ReversalId)
+
+	return nil
+}
+
+func (h *TransferReversedHandler) recordMovement(movement *domain.Movement, balance int64) error {
+	acc, err := h.accountRepository.GetAccountByNumber(movement.AccountNumber)
+	if err != nil {
+		slog.Error("error getting account", "error", err)
+		return err
+	}
+
+	if acc == nil {
+		slog.Error("account not found", "number", movement.AccountNumber)
+		return domain.ErrAccountNotFound
+	}
+
+	acc.Balance = balance
+
+	err = h.accountRepository.UpdateAccountBalance(acc)
+	if err != nil {
+		slog.Error("error updating account balance", "error", err, "number", movement.AccountNumber)
+		return err
+	}
+
+	err = h.movementRepository.CreateMovement(movement)
+	if err != nil {
+		slog.Error("error creating movement", "error", err, "number", movement.AccountNumber)
+		return err
+	}
+
+	return nil
+}
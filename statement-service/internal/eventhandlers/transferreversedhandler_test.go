@@ -0,0 +1,99 @@
+package eventhandlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	handlersmock "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/eventhandlers/mocks"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/shared/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getTestTransferReversedEvent() events.TransferReversed {
+	return events.TransferReversed{
+		TransferId:  "10",
+		ReversalId:  "11",
+		FromNumber:  "456",
+		ToNumber:    "123",
+		Value:       domain.Money{Amount: 70, Currency: "BRL"},
+		FromBalance: domain.Money{Amount: 30, Currency: "BRL"},
+		ToBalance:   domain.Money{Amount: 170, Currency: "BRL"},
+	}
+}
+
+func TestTransferReversedHandler_Success(t *testing.T) {
+	// Arrange
+	accountRepo := new(handlersmock.MockAccountRepository)
+	movementRepo := new(handlersmock.MockMovementRepository)
+	handler := NewTransferReversedHandler(accountRepo, movementRepo)
+
+	event := getTestTransferReversedEvent()
+	receiver := domain.NewAccount("456", "09876543210", "Jane Doe")
+	sender := domain.NewAccount("123", "01234567890", "John Doe")
+
+	accountRepo.On("GetAccountByNumber", "456").Return(receiver, nil)
+	accountRepo.On("GetAccountByNumber", "123").Return(sender, nil)
+	accountRepo.On("UpdateAccountBalance", mock.Anything).Return(nil)
+	movementRepo.On("CreateMovement", mock.MatchedBy(func(movement *domain.Movement) bool {
+		return movement.Type == "reversal_out" && movement.AccountNumber == "456" && movement.ToAccountNumber == "123" &&
+			movement.Value == 70 && movement.TransferId == "11" && movement.ReversedTransferId == "10"
+	})).Return(nil)
+	movementRepo.On("CreateMovement", mock.MatchedBy(func(movement *domain.Movement) bool {
+		return movement.Type == "reversal_in" && movement.AccountNumber == "123" && movement.ToAccountNumber == "456" &&
+			movement.Value == 70 && movement.TransferId == "11" && movement.ReversedTransferId == "10"
+	})).Return(nil)
+
+	// Act
+	err := handler.Handler(event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30), receiver.Balance)
+	assert.Equal(t, int64(170), sender.Balance)
+	accountRepo.AssertExpectations(t)
+	movementRepo.AssertExpectations(t)
+}
+
+func TestTransferReversedHandler_AccountNotFound(t *testing.T) {
+	// Arrange
+	accountRepo := new(handlersmock.MockAccountRepository)
+	movementRepo := new(handlersmock.MockMovementRepository)
+	handler := NewTransferReversedHandler(accountRepo, movementRepo)
+
+	event := getTestTransferReversedEvent()
+	sender := domain.NewAccount("123", "01234567890", "John Doe")
+
+	accountRepo.On("GetAccountByNumber", "456").Return((*domain.Account)(nil), nil)
+	accountRepo.On("GetAccountByNumber", "123").Return(sender, nil)
+	accountRepo.On("UpdateAccountBalance", sender).Return(nil)
+	movementRepo.On("CreateMovement", mock.Anything).Return(nil)
+
+	// Act
+	err := handler.Handler(event)
+
+	// Assert
+	assert.Equal(t, domain.ErrAccountNotFound, err)
+	assert.Equal(t, int64(0), sender.Balance)
+	movementRepo.AssertNotCalled(t, "CreateMovement", mock.Anything)
+}
+
+func TestTransferReversedHandler_DBErrorOnUpdateBalance(t *testing.T) {
+	// Arrange
+	accountRepo := new(handlersmock.MockAccountRepository)
+	movementRepo := new(handlersmock.MockMovementRepository)
+	handler := NewTransferReversedHandler(accountRepo, movementRepo)
+
+	event := getTestTransferReversedEvent()
+
+	accountRepo.On("GetAccountByNumber", mock.Anything).Return(domain.NewAccount("1", "01234567890", "John Doe"), nil)
+	accountRepo.On("UpdateAccountBalance", mock.Anything).Return(errors.New("db error"))
+
+	// Act
+	err := handler.Handler(event)
+
+	// Assert
+	assert.Error(t, err)
+	movementRepo.AssertNotCalled(t, "CreateMovement", mock.Anything)
+}
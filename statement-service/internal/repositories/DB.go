@@ -10,6 +10,13 @@ import (
 	_ "github.com/lib/pq"
 )
 
+// DBTX is implemented by both *sql.DB and *sql.Tx, so repositories can run inside a transaction.
+type DBTX interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
 func NewDBConnection() *sql.DB {
 	host := viper.GetString("db.host")
 	port := viper.GetString("db.port")
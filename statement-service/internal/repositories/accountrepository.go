@@ -10,13 +10,19 @@ type AccountRepositoryInterface interface {
 	GetAccountByNumber(number string) (*domain.Account, error)
 	CreateAccount(account *domain.Account) error
 	UpdateAccountBalance(account *domain.Account) error
+	UpdateAccountStatus(account *domain.Account) error
+	UpdateAccountOverdraftLimit(account *domain.Account) error
+	UpdateAccountName(account *domain.Account) error
+	CreateAccountHolder(holder *domain.AccountHolder) error
+	GetAccountHolders(number string) ([]domain.AccountHolder, error)
+	UpdatePrimaryHolderName(account *domain.Account) error
 }
 
 type AccountRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewAccountRepository(db *sql.DB) *AccountRepository {
+func NewAccountRepository(db DBTX) *AccountRepository {
 	return &AccountRepository{
 		db: db,
 	}
@@ -24,13 +30,13 @@ func NewAccountRepository(db *sql.DB) *AccountRepository {
 
 func (r *AccountRepository) GetAccountByNumber(number string) (*domain.Account, error) {
 	row := r.db.QueryRow(`
-		SELECT Number, Name, Document, Balance
+		SELECT Number, Name, Document, Currency, Balance, OverdraftLimit, Status
 		FROM accounts 
 		WHERE Number = $1
 	`, number)
 
 	var account domain.Account
-	err := row.Scan(&account.Number, &account.Name, &account.Document, &account.Balance)
+	err := row.Scan(&account.Number, &account.Name, &account.Document, &account.Currency, &account.Balance, &account.OverdraftLimit, &account.Status)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -44,9 +50,9 @@ func (r *AccountRepository) GetAccountByNumber(number string) (*domain.Account,
 
 func (r *AccountRepository) CreateAccount(account *domain.Account) error {
 	result, err := r.db.Exec(`
-	INSERT INTO accounts (Number, Name, Document, Balance)
-	VALUES ($1, $2, $3, $4)
-	`, account.Number, account.Name, account.Document, account.Balance)
+	INSERT INTO accounts (Number, Name, Document, Currency, Balance, Status)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	`, account.Number, account.Name, account.Document, account.Currency, account.Balance, account.Status)
 
 	if err != nil {
 		return err
@@ -83,3 +89,124 @@ func (r *AccountRepository) UpdateAccountBalance(account *domain.Account) error
 
 	return nil
 }
+
+func (r *AccountRepository) UpdateAccountStatus(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET Status = $1 WHERE Number = $2`,
+		account.Status, account.Number)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) UpdateAccountOverdraftLimit(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET OverdraftLimit = $1 WHERE Number = $2`,
+		account.OverdraftLimit, account.Number)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) UpdateAccountName(account *domain.Account) error {
+	result, err := r.db.Exec(`UPDATE accounts SET Name = $1 WHERE Number = $2`,
+		account.Name, account.Number)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) CreateAccountHolder(holder *domain.AccountHolder) error {
+	result, err := r.db.Exec(`
+	INSERT INTO accountholders (AccountNumber, Document, Name, Role)
+	VALUES ($1, $2, $3, $4)
+	`, holder.AccountNumber, holder.Document, holder.Name, holder.Role)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetAccountHolders returns the holders of the account, the primary holder first. Accounts
+// created before joint accounts have no holders recorded.
+func (r *AccountRepository) GetAccountHolders(number string) ([]domain.AccountHolder, error) {
+	rows, err := r.db.Query(`
+		SELECT AccountNumber, Document, Name, Role
+		FROM accountholders
+		WHERE AccountNumber = $1
+		ORDER BY CASE WHEN Role = $2 THEN 0 ELSE 1 END, Document
+	`, number, domain.AccountHolderRolePrimary)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	holders := []domain.AccountHolder{}
+	for rows.Next() {
+		var holder domain.AccountHolder
+		err := rows.Scan(&holder.AccountNumber, &holder.Document, &holder.Name, &holder.Role)
+		if err != nil {
+			return nil, err
+		}
+
+		holders = append(holders, holder)
+	}
+
+	return holders, rows.Err()
+}
+
+// UpdatePrimaryHolderName keeps the primary holder named as the account, accounts without
+// holders recorded are left as they are.
+func (r *AccountRepository) UpdatePrimaryHolderName(account *domain.Account) error {
+	_, err := r.db.Exec(`UPDATE accountholders SET Name = $1 WHERE AccountNumber = $2 AND Role = $3`,
+		account.Name, account.Number, domain.AccountHolderRolePrimary)
+
+	return err
+}
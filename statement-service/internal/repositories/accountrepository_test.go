@@ -14,7 +14,9 @@ func getExpectedAccount() *domain.Account {
 		Number:   "123456789",
 		Name:     "John Doe",
 		Document: "12345678901",
+		Currency: domain.DefaultCurrency,
 		Balance:  1000.0,
+		Status:   domain.AccountStatusActive,
 	}
 }
 
@@ -26,9 +28,9 @@ func TestGetAccountByNumber_Success(t *testing.T) {
 	repo := NewAccountRepository(db)
 	expectedAccount := getExpectedAccount()
 
-	rows := sqlmock.NewRows([]string{"Number", "Name", "Document", "Balance"}).
-		AddRow(expectedAccount.Number, expectedAccount.Name, expectedAccount.Document, expectedAccount.Balance)
-	mock.ExpectQuery("SELECT Number, Name, Document, Balance FROM accounts WHERE Number = \\$1").
+	rows := sqlmock.NewRows([]string{"Number", "Name", "Document", "Currency", "Balance", "OverdraftLimit", "Status"}).
+		AddRow(expectedAccount.Number, expectedAccount.Name, expectedAccount.Document, expectedAccount.Currency, expectedAccount.Balance, expectedAccount.OverdraftLimit, expectedAccount.Status)
+	mock.ExpectQuery("SELECT Number, Name, Document, Currency, Balance, OverdraftLimit, Status FROM accounts WHERE Number = \\$1").
 		WithArgs(expectedAccount.Number).
 		WillReturnRows(rows)
 
@@ -45,7 +47,7 @@ func TestGetAccountByNumber_NotFound(t *testing.T) {
 
 	repo := NewAccountRepository(db)
 
-	mock.ExpectQuery("SELECT Number, Name, Document, Balance FROM accounts WHERE Number = \\$1").
+	mock.ExpectQuery("SELECT Number, Name, Document, Currency, Balance, OverdraftLimit, Status FROM accounts WHERE Number = \\$1").
 		WithArgs("987654321").
 		WillReturnError(sql.ErrNoRows)
 
@@ -61,7 +63,7 @@ func TestGetAccountByNumber_DBError(t *testing.T) {
 
 	repo := NewAccountRepository(db)
 
-	mock.ExpectQuery("SELECT Number, Name, Document, Balance FROM accounts WHERE Number = \\$1").
+	mock.ExpectQuery("SELECT Number, Name, Document, Currency, Balance, OverdraftLimit, Status FROM accounts WHERE Number = \\$1").
 		WithArgs("123456789").
 		WillReturnError(sql.ErrConnDone)
 
@@ -134,3 +136,114 @@ func TestUpdateAccountBalance_Success(t *testing.T) {
 	// Assert
 	assert.Nil(t, err)
 }
+
+func TestUpdateAccountName_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("1", "12345678901", "John Bidden")
+
+	mock.ExpectExec("UPDATE accounts SET Name = \\$1 WHERE Number = \\$2").
+		WithArgs(acc.Name, acc.Number).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Act
+	err = repo.UpdateAccountName(acc)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestUpdateAccountName_NotRowsAffected(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("1", "12345678901", "John Bidden")
+
+	mock.ExpectExec("UPDATE accounts SET Name = \\$1 WHERE Number = \\$2").
+		WithArgs(acc.Name, acc.Number).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
+	// Act
+	err = repo.UpdateAccountName(acc)
+
+	// Assert
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestCreateAccountHolder_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	holder := domain.NewAccountHolder("1", "52998224725", "Jane Doe", domain.AccountHolderRoleSecondary)
+
+	mock.ExpectExec("INSERT INTO accountholders").
+		WithArgs(holder.AccountNumber, holder.Document, holder.Name, holder.Role).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Act
+	err = repo.CreateAccountHolder(holder)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAccountHolders_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	rows := sqlmock.NewRows([]string{"AccountNumber", "Document", "Name", "Role"}).
+		AddRow("1", "01234567890", "John Doe", "primary").
+		AddRow("1", "52998224725", "Jane Doe", "secondary")
+
+	mock.ExpectQuery("SELECT AccountNumber, Document, Name, Role FROM accountholders WHERE AccountNumber = \\$1").
+		WithArgs("1", domain.AccountHolderRolePrimary).
+		WillReturnRows(rows)
+
+	// Act
+	holders, err := repo.GetAccountHolders("1")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, holders, 2)
+	assert.Equal(t, domain.AccountHolderRoleSecondary, holders[1].Role)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePrimaryHolderName_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAccountRepository(db)
+
+	acc := domain.NewAccount("1", "12345678901", "John Bidden")
+
+	mock.ExpectExec("UPDATE accountholders SET Name = \\$1 WHERE AccountNumber = \\$2 AND Role = \\$3").
+		WithArgs(acc.Name, acc.Number, domain.AccountHolderRolePrimary).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err = repo.UpdatePrimaryHolderName(acc)
+
+	// Assert
+	assert.Nil(t, err)
+}
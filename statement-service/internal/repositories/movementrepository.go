@@ -13,10 +13,10 @@ type MovementRepositoryInterface interface {
 }
 
 type MovementRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewMovementRepository(db *sql.DB) *MovementRepository {
+func NewMovementRepository(db DBTX) *MovementRepository {
 	return &MovementRepository{
 		db: db,
 	}
@@ -24,9 +24,10 @@ func NewMovementRepository(db *sql.DB) *MovementRepository {
 
 func (r *MovementRepository) CreateMovement(movement *domain.Movement) error {
 	result, err := r.db.Exec(`
-	INSERT INTO movements (Type, AccountNumber, Value, ToAccountNumber, CreatedAt)
-	VALUES ($1, $2, $3, $4, $5)
-	`, movement.Type, movement.AccountNumber, movement.Value, movement.ToAccountNumber, movement.CreatedAt)
+	INSERT INTO movements (Type, AccountNumber, Value, ToAccountNumber, TransferId, ReversedTransferId, OriginTransactionId, PocketId, PocketName, CreatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, movement.Type, movement.AccountNumber, movement.Value, movement.ToAccountNumber, movement.TransferId,
+		movement.ReversedTransferId, movement.OriginTransactionId, movement.PocketId, movement.PocketName, movement.CreatedAt)
 
 	if err != nil {
 		return err
@@ -45,7 +46,9 @@ func (r *MovementRepository) CreateMovement(movement *domain.Movement) error {
 }
 
 func (r *MovementRepository) GetMovements(accountNumber string) (*[]domain.Movement, error) {
-	query := `SELECT Type, AccountNumber, Value, ToAccountNumber, CreatedAt FROM movements WHERE AccountNumber = $1`
+	query := `SELECT Type, AccountNumber, Value, ToAccountNumber, COALESCE(TransferId, ''), COALESCE(ReversedTransferId, ''),
+		COALESCE(OriginTransactionId, ''), COALESCE(PocketId, ''), COALESCE(PocketName, ''), CreatedAt
+		FROM movements WHERE AccountNumber = $1`
 	rows, err := r.db.Query(query, accountNumber)
 
 	if err != nil {
@@ -57,7 +60,7 @@ func (r *MovementRepository) GetMovements(accountNumber string) (*[]domain.Movem
 
 	for rows.Next() {
 		var sg domain.Movement
-		err := rows.Scan(&sg.Type, &sg.AccountNumber, &sg.Value, &sg.ToAccountNumber, &sg.CreatedAt)
+		err := rows.Scan(&sg.Type, &sg.AccountNumber, &sg.Value, &sg.ToAccountNumber, &sg.TransferId, &sg.ReversedTransferId, &sg.OriginTransactionId, &sg.PocketId, &sg.PocketName, &sg.CreatedAt)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan movement")
 		}
@@ -31,7 +31,7 @@ func TestCreateMovement_Success(t *testing.T) {
 	testMovement := getTestMovement()
 
 	mock.ExpectExec("INSERT INTO movements").
-		WithArgs(testMovement.Type, testMovement.AccountNumber, testMovement.Value, testMovement.ToAccountNumber, testMovement.CreatedAt).
+		WithArgs(testMovement.Type, testMovement.AccountNumber, testMovement.Value, testMovement.ToAccountNumber, testMovement.TransferId, testMovement.ReversedTransferId, testMovement.OriginTransactionId, testMovement.PocketId, testMovement.PocketName, testMovement.CreatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Act
@@ -51,7 +51,7 @@ func TestCreateMovement_DBError(t *testing.T) {
 	testMovement := getTestMovement()
 
 	mock.ExpectExec("INSERT INTO movements").
-		WithArgs(testMovement.Type, testMovement.AccountNumber, testMovement.Value, testMovement.ToAccountNumber, testMovement.CreatedAt).
+		WithArgs(testMovement.Type, testMovement.AccountNumber, testMovement.Value, testMovement.ToAccountNumber, testMovement.TransferId, testMovement.ReversedTransferId, testMovement.OriginTransactionId, testMovement.PocketId, testMovement.PocketName, testMovement.CreatedAt).
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
@@ -72,7 +72,7 @@ func TestCreateMovement_NoRowsAffected(t *testing.T) {
 	testMovement := getTestMovement()
 
 	mock.ExpectExec("INSERT INTO movements").
-		WithArgs(testMovement.Type, testMovement.AccountNumber, testMovement.Value, testMovement.ToAccountNumber, testMovement.CreatedAt).
+		WithArgs(testMovement.Type, testMovement.AccountNumber, testMovement.Value, testMovement.ToAccountNumber, testMovement.TransferId, testMovement.ReversedTransferId, testMovement.OriginTransactionId, testMovement.PocketId, testMovement.PocketName, testMovement.CreatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 0))
 
 	// Act
@@ -93,7 +93,7 @@ func TestCreateMovement_RowsAffectedError(t *testing.T) {
 	testMovement := getTestMovement()
 
 	mock.ExpectExec("INSERT INTO movements").
-		WithArgs(testMovement.Type, testMovement.AccountNumber, testMovement.Value, testMovement.ToAccountNumber, testMovement.CreatedAt).
+		WithArgs(testMovement.Type, testMovement.AccountNumber, testMovement.Value, testMovement.ToAccountNumber, testMovement.TransferId, testMovement.ReversedTransferId, testMovement.OriginTransactionId, testMovement.PocketId, testMovement.PocketName, testMovement.CreatedAt).
 		WillReturnResult(sqlmock.NewErrorResult(sql.ErrConnDone))
 
 	// Act
@@ -114,9 +114,9 @@ func TestGetMovements_NotFound(t *testing.T) {
 
 	accountNumber := "123456"
 
-	mock.ExpectQuery(`SELECT Type, AccountNumber, Value, ToAccountNumber, CreatedAt FROM movements WHERE AccountNumber = \$1`).
+	mock.ExpectQuery(`SELECT Type, AccountNumber, Value, ToAccountNumber, (.+), CreatedAt FROM movements WHERE AccountNumber = \$1`).
 		WithArgs(accountNumber).
-		WillReturnRows(sqlmock.NewRows([]string{"Type", "AccountNumber", "Value", "ToAccountNumber", "CreatedAt"}))
+		WillReturnRows(sqlmock.NewRows([]string{"Type", "AccountNumber", "Value", "ToAccountNumber", "TransferId", "ReversedTransferId", "OriginTransactionId", "PocketId", "PocketName", "CreatedAt"}))
 
 	// act
 	movements, err := repo.GetMovements(accountNumber)
@@ -138,11 +138,13 @@ func TestGetMovements_FoundMovements(t *testing.T) {
 
 	accountNumber := "123456"
 
-	rows := sqlmock.NewRows([]string{"Type", "AccountNumber", "Value", "ToAccountNumber", "CreatedAt"}).
-		AddRow("deposit", "123456", 100.0, "654321", time.Now()).
-		AddRow("withdrawal", "123456", 50.0, "654321", time.Now())
+	rows := sqlmock.NewRows([]string{"Type", "AccountNumber", "Value", "ToAccountNumber", "TransferId", "ReversedTransferId", "OriginTransactionId", "PocketId", "PocketName", "CreatedAt"}).
+		AddRow("deposit", "123456", 100.0, "654321", "", "", "", "", "", time.Now()).
+		AddRow("reversal_out", "123456", 50.0, "654321", "11", "10", "", "", "", time.Now()).
+		AddRow("fee", "123456", 5.0, "", "12", "", "11", "", "", time.Now()).
+		AddRow("pocket_in", "123456", 30.0, "", "", "", "", "4", "Vacation", time.Now())
 
-	mock.ExpectQuery(`SELECT Type, AccountNumber, Value, ToAccountNumber, CreatedAt FROM movements WHERE AccountNumber = \$1`).
+	mock.ExpectQuery(`SELECT Type, AccountNumber, Value, ToAccountNumber, (.+), CreatedAt FROM movements WHERE AccountNumber = \$1`).
 		WithArgs(accountNumber).
 		WillReturnRows(rows)
 
@@ -152,10 +154,14 @@ func TestGetMovements_FoundMovements(t *testing.T) {
 	// assert
 	assert.NoError(t, err)
 	assert.NotEmpty(t, movements)
-	assert.Equal(t, 2, len(*movements))
+	assert.Equal(t, 4, len(*movements))
 
 	assert.Equal(t, "deposit", (*movements)[0].Type)
-	assert.Equal(t, "withdrawal", (*movements)[1].Type)
+	assert.Equal(t, "reversal_out", (*movements)[1].Type)
+	assert.Equal(t, "10", (*movements)[1].ReversedTransferId)
+	assert.Equal(t, "11", (*movements)[2].OriginTransactionId)
+	assert.Equal(t, "4", (*movements)[3].PocketId)
+	assert.Equal(t, "Vacation", (*movements)[3].PocketName)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -170,7 +176,7 @@ func TestGetMovements_ErrorReadingMovements(t *testing.T) {
 
 	accountNumber := "123456"
 
-	mock.ExpectQuery(`SELECT Type, AccountNumber, Value, ToAccountNumber, CreatedAt FROM movements WHERE AccountNumber = \$1`).
+	mock.ExpectQuery(`SELECT Type, AccountNumber, Value, ToAccountNumber, (.+), CreatedAt FROM movements WHERE AccountNumber = \$1`).
 		WithArgs(accountNumber).
 		WillReturnError(errors.New("query failed"))
 
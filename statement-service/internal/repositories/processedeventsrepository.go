@@ -0,0 +1,37 @@
+package repositories
+
+import "time"
+
+type ProcessedEventsRepositoryInterface interface {
+	MarkAsProcessed(eventId string, eventType string) (bool, error)
+}
+
+type ProcessedEventsRepository struct {
+	db DBTX
+}
+
+func NewProcessedEventsRepository(db DBTX) *ProcessedEventsRepository {
+	return &ProcessedEventsRepository{
+		db: db,
+	}
+}
+
+// MarkAsProcessed records the event and returns false when it was already processed, so a
+// redelivered event is not applied twice.
+func (r *ProcessedEventsRepository) MarkAsProcessed(eventId string, eventType string) (bool, error) {
+	result, err := r.db.Exec(`
+	INSERT INTO processedevents (EventId, Type, ProcessedAt)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (EventId) DO NOTHING
+	`, eventId, eventType, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
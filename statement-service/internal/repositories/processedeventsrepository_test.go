@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkAsProcessed_FirstDelivery(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProcessedEventsRepository(db)
+
+	mock.ExpectExec("INSERT INTO processedevents \\(EventId, Type, ProcessedAt\\) VALUES \\(\\$1, \\$2, \\$3\\) ON CONFLICT \\(EventId\\) DO NOTHING").
+		WithArgs("c7a3f1de-5a34-4a8e-9f4b-0d1f3a6b2e10", "FundsWithdrawn", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	processed, err := repo.MarkAsProcessed("c7a3f1de-5a34-4a8e-9f4b-0d1f3a6b2e10", "FundsWithdrawn")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, processed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkAsProcessed_Redelivery(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProcessedEventsRepository(db)
+
+	mock.ExpectExec("INSERT INTO processedevents").
+		WithArgs("c7a3f1de-5a34-4a8e-9f4b-0d1f3a6b2e10", "FundsWithdrawn", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	processed, err := repo.MarkAsProcessed("c7a3f1de-5a34-4a8e-9f4b-0d1f3a6b2e10", "FundsWithdrawn")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.False(t, processed)
+}
+
+func TestMarkAsProcessed_DBError(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProcessedEventsRepository(db)
+
+	mock.ExpectExec("INSERT INTO processedevents").
+		WillReturnError(sql.ErrConnDone)
+
+	// Act
+	processed, err := repo.MarkAsProcessed("c7a3f1de-5a34-4a8e-9f4b-0d1f3a6b2e10", "FundsWithdrawn")
+
+	// Assert
+	assert.Equal(t, sql.ErrConnDone, err)
+	assert.False(t, processed)
+}
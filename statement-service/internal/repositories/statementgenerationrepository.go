@@ -16,10 +16,10 @@ type StatementGenerationRepositoryInterface interface {
 }
 
 type StatementGenerationRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewStatementGenerationRepository(db *sql.DB) *StatementGenerationRepository {
+func NewStatementGenerationRepository(db DBTX) *StatementGenerationRepository {
 	return &StatementGenerationRepository{
 		db: db,
 	}
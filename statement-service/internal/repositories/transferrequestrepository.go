@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"database/sql"
+
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/pkg/errors"
+)
+
+type TransferRequestRepositoryInterface interface {
+	CreateTransferRequest(transferRequest *domain.TransferRequest) error
+	UpdateTransferRequestStatus(transferRequest *domain.TransferRequest) error
+	GetUnapprovedTransferRequests(accountNumber string) (*[]domain.TransferRequest, error)
+}
+
+type TransferRequestRepository struct {
+	db DBTX
+}
+
+func NewTransferRequestRepository(db DBTX) *TransferRequestRepository {
+	return &TransferRequestRepository{
+		db: db,
+	}
+}
+
+func (r *TransferRequestRepository) CreateTransferRequest(transferRequest *domain.TransferRequest) error {
+	_, err := r.db.Exec(`
+	INSERT INTO transferrequests (Id, FromNumber, ToNumber, Value, Status, Reason, CreatedAt, UpdatedAt)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, transferRequest.Id, transferRequest.FromNumber, transferRequest.ToNumber, transferRequest.Value, transferRequest.Status,
+		transferRequest.Reason, transferRequest.CreatedAt, transferRequest.UpdatedAt)
+
+	return err
+}
+
+func (r *TransferRequestRepository) UpdateTransferRequestStatus(transferRequest *domain.TransferRequest) error {
+	result, err := r.db.Exec(`UPDATE transferrequests SET Status = $1, Reason = $2, UpdatedAt = $3 WHERE Id = $4`,
+		transferRequest.Status, transferRequest.Reason, transferRequest.UpdatedAt, transferRequest.Id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetUnapprovedTransferRequests returns the transfer requests of the account that did not become
+// a movement, the ones pending approval, rejected or expired.
+func (r *TransferRequestRepository) GetUnapprovedTransferRequests(accountNumber string) (*[]domain.TransferRequest, error) {
+	query := `SELECT Id, FromNumber, ToNumber, Value, Status, COALESCE(Reason, ''), CreatedAt, UpdatedAt
+		FROM transferrequests WHERE FromNumber = $1 AND Status <> $2 ORDER BY CreatedAt`
+	rows, err := r.db.Query(query, accountNumber, domain.TransferRequestApproved)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute query")
+	}
+	defer rows.Close()
+
+	transferRequests := []domain.TransferRequest{}
+
+	for rows.Next() {
+		var tr domain.TransferRequest
+		err := rows.Scan(&tr.Id, &tr.FromNumber, &tr.ToNumber, &tr.Value, &tr.Status, &tr.Reason, &tr.CreatedAt, &tr.UpdatedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan transfer request")
+		}
+		transferRequests = append(transferRequests, tr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "error iterating over result rows")
+	}
+
+	return &transferRequests, nil
+}
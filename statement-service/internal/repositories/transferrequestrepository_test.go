@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTransferRequest_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTransferRequestRepository(db)
+	transferRequest := domain.NewPendingTransferRequest("3", "19", "27", 500000)
+
+	mock.ExpectExec("INSERT INTO transferrequests").
+		WithArgs(transferRequest.Id, transferRequest.FromNumber, transferRequest.ToNumber, transferRequest.Value, transferRequest.Status,
+			transferRequest.Reason, transferRequest.CreatedAt, transferRequest.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Act
+	err = repo.CreateTransferRequest(transferRequest)
+
+	// Assert
+	assert.Nil(t, err)
+}
+
+func TestUpdateTransferRequestStatus_NotFound(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTransferRequestRepository(db)
+	transferRequest := &domain.TransferRequest{Id: "3", Status: domain.TransferRequestExpired, UpdatedAt: time.Now()}
+
+	mock.ExpectExec("UPDATE transferrequests SET Status = \\$1, Reason = \\$2, UpdatedAt = \\$3 WHERE Id = \\$4").
+		WithArgs(transferRequest.Status, transferRequest.Reason, transferRequest.UpdatedAt, transferRequest.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err = repo.UpdateTransferRequestStatus(transferRequest)
+
+	// Assert
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestGetUnapprovedTransferRequests_Success(t *testing.T) {
+	// Arrange
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTransferRequestRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT (.+) FROM transferrequests WHERE FromNumber = \\$1 AND Status <> \\$2").
+		WithArgs("19", domain.TransferRequestApproved).
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "FromNumber", "ToNumber", "Value", "Status", "Reason", "CreatedAt", "UpdatedAt"}).
+			AddRow("3", "19", "27", 500000, "pending_approval", "", now, now).
+			AddRow("4", "19", "35", 700000, "rejected", "unknown payee", now, now))
+
+	// Act
+	transferRequests, err := repo.GetUnapprovedTransferRequests("19")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, *transferRequests, 2)
+	assert.Equal(t, domain.TransferRequestRejected, (*transferRequests)[1].Status)
+}
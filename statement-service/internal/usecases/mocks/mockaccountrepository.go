@@ -24,6 +24,36 @@ func (m *MockAccountRepository) UpdateAccountBalance(account *domain.Account) er
 	return args.Error(0)
 }
 
+func (m *MockAccountRepository) UpdateAccountStatus(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) UpdateAccountOverdraftLimit(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) UpdateAccountName(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) CreateAccountHolder(holder *domain.AccountHolder) error {
+	args := m.Called(holder)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) GetAccountHolders(number string) ([]domain.AccountHolder, error) {
+	args := m.Called(number)
+	return args.Get(0).([]domain.AccountHolder), args.Error(1)
+}
+
+func (m *MockAccountRepository) UpdatePrimaryHolderName(account *domain.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
 type MockMovementRepository struct {
 	mock.Mock
 }
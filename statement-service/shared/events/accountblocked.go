@@ -0,0 +1,7 @@
+package events
+
+const AccountBlockedEventKey = "AccountBlocked"
+
+type AccountBlocked struct {
+	Number string `json:"number"`
+}
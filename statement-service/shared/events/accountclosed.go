@@ -0,0 +1,7 @@
+package events
+
+const AccountClosedEventKey = "AccountClosed"
+
+type AccountClosed struct {
+	Number string `json:"number"`
+}
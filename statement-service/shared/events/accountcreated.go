@@ -3,7 +3,15 @@ package events
 const AccountCreatedEventKey = "AccountCreated"
 
 type AccountCreated struct {
-	Number   string `json:"number"`
-	Name     string `json:"name"`
+	Number   string          `json:"number"`
+	Name     string          `json:"name"`
+	Document string          `json:"document"`
+	Currency string          `json:"currency"`
+	Holders  []AccountHolder `json:"holders"`
+}
+
+type AccountHolder struct {
 	Document string `json:"document"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
 }
@@ -0,0 +1,7 @@
+package events
+
+const AccountUnblockedEventKey = "AccountUnblocked"
+
+type AccountUnblocked struct {
+	Number string `json:"number"`
+}
@@ -0,0 +1,12 @@
+package events
+
+const AccountUpdatedEventKey = "AccountUpdated"
+
+type AccountUpdated struct {
+	Number        string   `json:"number"`
+	Name          string   `json:"name"`
+	Email         string   `json:"email"`
+	Phone         string   `json:"phone"`
+	Address       string   `json:"address"`
+	ChangedFields []string `json:"changedFields"`
+}
@@ -7,6 +7,7 @@ import (
 )
 
 type EventPublish struct {
+	Id   string `json:"id,omitempty"`
 	Type string `json:"type"`
 	Data string `json:"data"`
 }
@@ -0,0 +1,14 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+
+const FeeChargedEventKey = "FeeCharged"
+
+type FeeCharged struct {
+	Number              string       `json:"number"`
+	Operation           string       `json:"operation"`
+	Value               domain.Money `json:"value"`
+	Balance             domain.Money `json:"balance"`
+	TransactionId       string       `json:"transactionId"`
+	OriginTransactionId string       `json:"originTransactionId"`
+}
@@ -1,8 +1,10 @@
 package events
 
+import "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+
 const FundsDepositedEventKey = "FundsDeposited"
 
 type FundsDeposited struct {
-	Number string `json:"number"`
-	Value  int64  `json:"value"`
+	Number string       `json:"number"`
+	Value  domain.Money `json:"value"`
 }
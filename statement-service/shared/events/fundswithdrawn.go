@@ -0,0 +1,10 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+
+const FundsWithdrawnEventKey = "FundsWithdrawn"
+
+type FundsWithdrawn struct {
+	Number string       `json:"number"`
+	Value  domain.Money `json:"value"`
+}
@@ -0,0 +1,12 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+
+const InterestCreditedEventKey = "InterestCredited"
+
+type InterestCredited struct {
+	Number  string       `json:"number"`
+	Value   domain.Money `json:"value"`
+	Balance domain.Money `json:"balance"`
+	Period  string       `json:"period"`
+}
@@ -0,0 +1,8 @@
+package events
+
+const OverdraftLimitChangedEventKey = "OverdraftLimitChanged"
+
+type OverdraftLimitChanged struct {
+	Number         string `json:"number"`
+	OverdraftLimit int64  `json:"overdraftLimit"`
+}
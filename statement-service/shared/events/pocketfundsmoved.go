@@ -0,0 +1,14 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+
+const PocketFundsMovedEventKey = "PocketFundsMoved"
+
+type PocketFundsMoved struct {
+	Number        string       `json:"number"`
+	PocketId      string       `json:"pocketId"`
+	PocketName    string       `json:"pocketName"`
+	Direction     string       `json:"direction"`
+	Value         domain.Money `json:"value"`
+	PocketBalance domain.Money `json:"pocketBalance"`
+}
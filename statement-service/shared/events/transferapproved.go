@@ -0,0 +1,11 @@
+package events
+
+const TransferApprovedEventKey = "TransferApproved"
+
+type TransferApproved struct {
+	TransferRequestId string `json:"transferRequestId"`
+	FromNumber        string `json:"fromNumber"`
+	ToNumber          string `json:"toNumber"`
+	Value             int64  `json:"value"`
+	ApprovedBy        string `json:"approvedBy"`
+}
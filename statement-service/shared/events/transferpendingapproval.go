@@ -0,0 +1,14 @@
+package events
+
+import "time"
+
+const TransferPendingApprovalEventKey = "TransferPendingApproval"
+
+type TransferPendingApproval struct {
+	TransferRequestId string    `json:"transferRequestId"`
+	FromNumber        string    `json:"fromNumber"`
+	ToNumber          string    `json:"toNumber"`
+	Value             int64     `json:"value"`
+	PixKey            string    `json:"pixKey,omitempty"`
+	ExpiresAt         time.Time `json:"expiresAt"`
+}
@@ -1,10 +1,14 @@
 package events
 
+import "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+
 const TransferRealizedEventKey = "TransferRealized"
 
 type TransferRealized struct {
-	FromNumber string `json:"fromNumber"`
-	ToNumber   string `json:"toNumber"`
-	Value      int64  `json:"value"`
-	Balance    int64  `json:"balance"`
+	FromNumber string       `json:"fromNumber"`
+	ToNumber   string       `json:"toNumber"`
+	Value      domain.Money `json:"value"`
+	Balance    domain.Money `json:"balance"`
+	TransferId string       `json:"transferId"`
+	PixKey     string       `json:"pixKey,omitempty"`
 }
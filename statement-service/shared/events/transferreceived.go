@@ -1,10 +1,13 @@
 package events
 
+import "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+
 const TransferReceivedEventKey = "TransferReceived"
 
 type TransferReceived struct {
-	FromNumber string `json:"fromNumber"`
-	ToNumber   string `json:"toNumber"`
-	Value      int64  `json:"value"`
-	Balance    int64  `json:"balance"`
+	FromNumber string       `json:"fromNumber"`
+	ToNumber   string       `json:"toNumber"`
+	Value      domain.Money `json:"value"`
+	Balance    domain.Money `json:"balance"`
+	TransferId string       `json:"transferId"`
 }
@@ -0,0 +1,12 @@
+package events
+
+const TransferRejectedEventKey = "TransferRejected"
+
+type TransferRejected struct {
+	TransferRequestId string `json:"transferRequestId"`
+	FromNumber        string `json:"fromNumber"`
+	ToNumber          string `json:"toNumber"`
+	Value             int64  `json:"value"`
+	RejectedBy        string `json:"rejectedBy"`
+	Reason            string `json:"reason"`
+}
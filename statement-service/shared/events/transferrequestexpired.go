@@ -0,0 +1,10 @@
+package events
+
+const TransferRequestExpiredEventKey = "TransferRequestExpired"
+
+type TransferRequestExpired struct {
+	TransferRequestId string `json:"transferRequestId"`
+	FromNumber        string `json:"fromNumber"`
+	ToNumber          string `json:"toNumber"`
+	Value             int64  `json:"value"`
+}
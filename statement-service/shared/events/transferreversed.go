@@ -0,0 +1,15 @@
+package events
+
+import "github.com/matheus-oliveira-andrade/bank-statement/statement-service/internal/domain"
+
+const TransferReversedEventKey = "TransferReversed"
+
+type TransferReversed struct {
+	TransferId  string       `json:"transferId"`
+	ReversalId  string       `json:"reversalId"`
+	FromNumber  string       `json:"fromNumber"`
+	ToNumber    string       `json:"toNumber"`
+	Value       domain.Money `json:"value"`
+	FromBalance domain.Money `json:"fromBalance"`
+	ToBalance   domain.Money `json:"toBalance"`
+}